@@ -0,0 +1,81 @@
+// Copyright 2015 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+
+package analyzer
+
+import (
+	"fmt"
+	"path/filepath"
+	"sort"
+
+	"github.com/getgauge/common"
+	"github.com/getgauge/gauge/config"
+	"github.com/getgauge/gauge/gauge"
+	"github.com/getgauge/gauge/parser"
+)
+
+type location struct {
+	fileName string
+	lineNo   int
+}
+
+func (l location) String() string {
+	return fmt.Sprintf("%s:%d", l.fileName, l.lineNo)
+}
+
+// parseSpecsAndConcepts parses the concepts of the project and the specs in the given files and directories, the
+// specs directory of the project being used when none are given.
+func parseSpecsAndConcepts(args []string) ([]*gauge.Specification, *gauge.ConceptDictionary) {
+	conceptDictionary, conceptParseResult := parser.CreateConceptsDictionary(false)
+	parser.HandleParseResult(conceptParseResult)
+	if len(args) == 0 {
+		args = []string{filepath.Join(config.ProjectRoot, common.SpecsDirectoryName)}
+	}
+	specs := make([]*gauge.Specification, 0)
+	for _, arg := range args {
+		specsInArg, parseResults := parser.FindSpecs(arg, conceptDictionary)
+		parser.HandleParseResult(parseResults...)
+		specs = append(specs, specsInArg...)
+	}
+	return specs, conceptDictionary
+}
+
+func sortedConcepts(conceptDictionary *gauge.ConceptDictionary) []*gauge.Concept {
+	concepts := make([]*gauge.Concept, 0, len(conceptDictionary.ConceptsMap))
+	for _, concept := range conceptDictionary.ConceptsMap {
+		concepts = append(concepts, concept)
+	}
+	sort.Sort(byFileAndLineNo(concepts))
+	return concepts
+}
+
+type byFileAndLineNo []*gauge.Concept
+
+func (s byFileAndLineNo) Len() int {
+	return len(s)
+}
+
+func (s byFileAndLineNo) Swap(i, j int) {
+	s[i], s[j] = s[j], s[i]
+}
+
+func (s byFileAndLineNo) Less(i, j int) bool {
+	if s[i].FileName != s[j].FileName {
+		return s[i].FileName < s[j].FileName
+	}
+	return s[i].ConceptStep.LineNo < s[j].ConceptStep.LineNo
+}
@@ -0,0 +1,328 @@
+// Copyright 2015 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+
+package analyzer
+
+import (
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/getgauge/gauge/formatter"
+	"github.com/getgauge/gauge/gauge"
+	"github.com/getgauge/gauge/logger"
+	"github.com/getgauge/gauge/parser"
+)
+
+// Sequences shorter than this are too common to be worth extracting into a concept.
+const minSequenceLength = 2
+
+// stepList is a sequence of steps written one after the other, i.e. the steps of a scenario, the context or
+// tear down steps of a spec or the steps of a concept.
+type stepList struct {
+	name     string
+	location location
+	steps    []*gauge.Step
+}
+
+type duplicateScenarios struct {
+	// identical is false when the scenarios only differ in the arguments passed to their steps.
+	identical bool
+	scenarios []*stepList
+}
+
+type repeatedSequence struct {
+	steps       []string
+	occurrences []location
+}
+
+type similarSteps struct {
+	steps       []string
+	occurrences []location
+}
+
+type duplicatesReport struct {
+	scenarios    []*duplicateScenarios
+	sequences    []*repeatedSequence
+	similarSteps []*similarSteps
+}
+
+// AnalyzeDuplicates reports duplicate scenarios, step sequences repeated across specs and concepts and steps which
+// differ only in whitespace, casing or punctuation.
+func AnalyzeDuplicates(args []string) {
+	specs, conceptDictionary := parseSpecsAndConcepts(args)
+	printDuplicatesReport(analyzeDuplicates(specs, conceptDictionary))
+}
+
+func analyzeDuplicates(specs []*gauge.Specification, conceptDictionary *gauge.ConceptDictionary) *duplicatesReport {
+	scenarios := make([]*stepList, 0)
+	lists := make([]*stepList, 0)
+	for _, spec := range specs {
+		lists = append(lists, &stepList{name: "Context steps of " + spec.Heading.Value, location: location{spec.FileName, spec.Heading.LineNo}, steps: spec.Contexts})
+		for _, scenario := range spec.Scenarios {
+			list := &stepList{name: scenario.Heading.Value, location: location{spec.FileName, scenario.Heading.LineNo}, steps: scenario.Steps}
+			scenarios = append(scenarios, list)
+			lists = append(lists, list)
+		}
+		lists = append(lists, &stepList{name: "Tear down steps of " + spec.Heading.Value, location: location{spec.FileName, spec.Heading.LineNo}, steps: spec.TearDownSteps})
+	}
+	for _, concept := range sortedConcepts(conceptDictionary) {
+		lists = append(lists, &stepList{name: concept.ConceptStep.LineText, location: location{concept.FileName, concept.ConceptStep.LineNo}, steps: concept.ConceptStep.ConceptSteps})
+	}
+	return &duplicatesReport{scenarios: findDuplicateScenarios(scenarios), sequences: findRepeatedSequences(lists), similarSteps: findSimilarSteps(lists)}
+}
+
+// findDuplicateScenarios groups scenarios having the same sequence of step values.
+func findDuplicateScenarios(scenarios []*stepList) []*duplicateScenarios {
+	groups := make(map[string]*duplicateScenarios)
+	keys := make([]string, 0)
+	for _, scenario := range scenarios {
+		if len(scenario.steps) == 0 {
+			continue
+		}
+		key := sequenceKey(scenario.steps)
+		if _, ok := groups[key]; !ok {
+			groups[key] = &duplicateScenarios{identical: true}
+			keys = append(keys, key)
+		}
+		group := groups[key]
+		if len(group.scenarios) > 0 && formatSteps(group.scenarios[0].steps) != formatSteps(scenario.steps) {
+			group.identical = false
+		}
+		group.scenarios = append(group.scenarios, scenario)
+	}
+	duplicates := make([]*duplicateScenarios, 0)
+	for _, key := range keys {
+		if len(groups[key].scenarios) > 1 {
+			duplicates = append(duplicates, groups[key])
+		}
+	}
+	return duplicates
+}
+
+type sequenceOccurrence struct {
+	list  *stepList
+	order int
+	start int
+}
+
+// findRepeatedSequences finds sequences of steps which are used in more than one place, longest first. A sequence
+// is left out when every one of its occurrences is part of the same longer repeated sequence. Sequences are grown
+// one step at a time from the steps used in more than one place, so only sequences which repeat are ever built.
+func findRepeatedSequences(lists []*stepList) []*repeatedSequence {
+	ids := make(map[string]int)
+	stepIds := make(map[*stepList][]int)
+	single := make([]sequenceOccurrence, 0)
+	for order, list := range lists {
+		for start, step := range list.steps {
+			key := parser.CreateStepValue(step).StepValue
+			if _, ok := ids[key]; !ok {
+				ids[key] = len(ids)
+			}
+			stepIds[list] = append(stepIds[list], ids[key])
+			single = append(single, sequenceOccurrence{list, order, start})
+		}
+	}
+	groups := splitByStepAt([][]sequenceOccurrence{single}, 0, stepIds)
+	repeated := make([]*occurrenceGroup, 0)
+	for length := 1; len(groups) > 0; length++ {
+		for _, group := range groups {
+			if length >= minSequenceLength && !isPartOfLongerSequence(group, length) {
+				repeated = append(repeated, &occurrenceGroup{group, length})
+			}
+		}
+		groups = splitByStepAt(groups, length, stepIds)
+	}
+	sort.Sort(byLengthAndPosition(repeated))
+	sequences := make([]*repeatedSequence, 0, len(repeated))
+	for _, group := range repeated {
+		first := group.occurrences[0]
+		sequence := &repeatedSequence{}
+		for _, step := range first.list.steps[first.start : first.start+group.length] {
+			sequence.steps = append(sequence.steps, step.LineText)
+		}
+		for _, occurrence := range group.occurrences {
+			sequence.occurrences = append(sequence.occurrences, location{occurrence.list.location.fileName, occurrence.list.steps[occurrence.start].LineNo})
+		}
+		sequences = append(sequences, sequence)
+	}
+	return sequences
+}
+
+// splitByStepAt splits each group of occurrences by the step at the given offset from their start, keeping the
+// groups which still have more than one occurrence.
+func splitByStepAt(groups [][]sequenceOccurrence, offset int, stepIds map[*stepList][]int) [][]sequenceOccurrence {
+	split := make([][]sequenceOccurrence, 0)
+	for _, group := range groups {
+		byStep := make(map[int][]sequenceOccurrence)
+		order := make([]int, 0)
+		for _, occurrence := range group {
+			ids := stepIds[occurrence.list]
+			if occurrence.start+offset >= len(ids) {
+				continue
+			}
+			id := ids[occurrence.start+offset]
+			if _, ok := byStep[id]; !ok {
+				order = append(order, id)
+			}
+			byStep[id] = append(byStep[id], occurrence)
+		}
+		for _, id := range order {
+			if len(byStep[id]) > 1 {
+				split = append(split, byStep[id])
+			}
+		}
+	}
+	return split
+}
+
+type occurrenceGroup struct {
+	occurrences []sequenceOccurrence
+	length      int
+}
+
+// byLengthAndPosition sorts the longest sequences first, and sequences of the same length by where they first occur.
+type byLengthAndPosition []*occurrenceGroup
+
+func (s byLengthAndPosition) Len() int {
+	return len(s)
+}
+
+func (s byLengthAndPosition) Swap(i, j int) {
+	s[i], s[j] = s[j], s[i]
+}
+
+func (s byLengthAndPosition) Less(i, j int) bool {
+	if s[i].length != s[j].length {
+		return s[i].length > s[j].length
+	}
+	first, other := s[i].occurrences[0], s[j].occurrences[0]
+	return first.order < other.order || (first.order == other.order && first.start < other.start)
+}
+
+func isPartOfLongerSequence(occurrences []sequenceOccurrence, length int) bool {
+	extendsWithSameStep := func(stepAt func(sequenceOccurrence) int) bool {
+		value := ""
+		for i, occurrence := range occurrences {
+			index := stepAt(occurrence)
+			if index < 0 || index >= len(occurrence.list.steps) {
+				return false
+			}
+			if i > 0 && occurrence.list.steps[index].Value != value {
+				return false
+			}
+			value = occurrence.list.steps[index].Value
+		}
+		return true
+	}
+	return extendsWithSameStep(func(o sequenceOccurrence) int { return o.start - 1 }) ||
+		extendsWithSameStep(func(o sequenceOccurrence) int { return o.start + length })
+}
+
+// findSimilarSteps groups steps whose values are the same after ignoring whitespace, casing and punctuation.
+func findSimilarSteps(lists []*stepList) []*similarSteps {
+	groups := make(map[string]*similarSteps)
+	keys := make([]string, 0)
+	for _, list := range lists {
+		for _, step := range list.steps {
+			key := normaliseStepValue(step.Value)
+			if _, ok := groups[key]; !ok {
+				groups[key] = &similarSteps{}
+				keys = append(keys, key)
+			}
+			group := groups[key]
+			if !containsStep(group.steps, step.Value) {
+				group.steps = append(group.steps, step.Value)
+				group.occurrences = append(group.occurrences, location{list.location.fileName, step.LineNo})
+			}
+		}
+	}
+	similar := make([]*similarSteps, 0)
+	for _, key := range keys {
+		if len(groups[key].steps) > 1 {
+			similar = append(similar, groups[key])
+		}
+	}
+	return similar
+}
+
+func normaliseStepValue(stepValue string) string {
+	normalised := strings.Map(func(r rune) rune {
+		if unicode.IsPunct(r) && r != '{' && r != '}' {
+			return -1
+		}
+		return unicode.ToLower(r)
+	}, stepValue)
+	return strings.Join(strings.Fields(normalised), " ")
+}
+
+func sequenceKey(steps []*gauge.Step) string {
+	values := make([]string, 0, len(steps))
+	for _, step := range steps {
+		values = append(values, parser.CreateStepValue(step).StepValue)
+	}
+	return strings.Join(values, "\n")
+}
+
+func formatSteps(steps []*gauge.Step) string {
+	formatted := ""
+	for _, step := range steps {
+		formatted += formatter.FormatStep(step)
+	}
+	return formatted
+}
+
+func containsStep(steps []string, step string) bool {
+	for _, s := range steps {
+		if s == step {
+			return true
+		}
+	}
+	return false
+}
+
+func printDuplicatesReport(report *duplicatesReport) {
+	if len(report.scenarios) == 0 && len(report.sequences) == 0 && len(report.similarSteps) == 0 {
+		logger.Info("No duplicates found.")
+		return
+	}
+	for _, duplicate := range report.scenarios {
+		if duplicate.identical {
+			logger.Info("Identical scenarios:")
+		} else {
+			logger.Info("Scenarios differing only in arguments:")
+		}
+		for _, scenario := range duplicate.scenarios {
+			logger.Info("  %s (%s)", scenario.name, scenario.location)
+		}
+	}
+	for _, sequence := range report.sequences {
+		logger.Info("Steps repeated in %d places, consider extracting a concept:", len(sequence.occurrences))
+		for _, step := range sequence.steps {
+			logger.Info("  * %s", step)
+		}
+		for _, occurrence := range sequence.occurrences {
+			logger.Info("    at %s", occurrence)
+		}
+	}
+	for _, similar := range report.similarSteps {
+		logger.Info("Steps differing only in whitespace, casing or punctuation:")
+		for i, step := range similar.steps {
+			logger.Info("  %s (%s)", step, similar.occurrences[i])
+		}
+	}
+}
@@ -0,0 +1,91 @@
+// Copyright 2015 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+
+package analyzer
+
+import (
+	"testing"
+
+	"github.com/getgauge/gauge/gauge"
+	"github.com/getgauge/gauge/parser"
+	. "gopkg.in/check.v1"
+)
+
+func Test(t *testing.T) { TestingT(t) }
+
+type MySuite struct{}
+
+var _ = Suite(&MySuite{})
+
+func parseSpec(c *C, fileName, text string, conceptDictionary *gauge.ConceptDictionary) *gauge.Specification {
+	spec, result := new(parser.SpecParser).Parse(text, conceptDictionary)
+	c.Assert(result.Ok, Equals, true)
+	spec.FileName = fileName
+	return spec
+}
+
+func (s *MySuite) TestFindsIdenticalScenarios(c *C) {
+	conceptDictionary := gauge.NewConceptDictionary()
+	spec := parseSpec(c, "a.spec", "Spec\n====\nScenario 1\n----------\n* first step\n* second step\nScenario 2\n----------\n* first step\n* second step\n", conceptDictionary)
+
+	report := analyzeDuplicates([]*gauge.Specification{spec}, conceptDictionary)
+
+	c.Assert(len(report.scenarios), Equals, 1)
+	c.Assert(report.scenarios[0].identical, Equals, true)
+	c.Assert(report.scenarios[0].scenarios[0].name, Equals, "Scenario 1")
+	c.Assert(report.scenarios[0].scenarios[1].location.String(), Equals, "a.spec:7")
+}
+
+func (s *MySuite) TestFindsScenariosDifferingOnlyInArguments(c *C) {
+	conceptDictionary := gauge.NewConceptDictionary()
+	spec1 := parseSpec(c, "a.spec", "Spec\n====\nScenario 1\n----------\n* login as \"admin\"\n", conceptDictionary)
+	spec2 := parseSpec(c, "b.spec", "Spec\n====\nScenario 2\n----------\n* login as \"guest\"\n", conceptDictionary)
+
+	report := analyzeDuplicates([]*gauge.Specification{spec1, spec2}, conceptDictionary)
+
+	c.Assert(len(report.scenarios), Equals, 1)
+	c.Assert(report.scenarios[0].identical, Equals, false)
+}
+
+func (s *MySuite) TestFindsLongestRepeatedSequences(c *C) {
+	conceptDictionary := gauge.NewConceptDictionary()
+	c.Assert(parser.AddConceptsFromText("# concept\n* first step\n* second step\n* third step\n", "a.cpt", conceptDictionary), IsNil)
+	spec := parseSpec(c, "a.spec", "Spec\n====\nScenario 1\n----------\n* start\n* first step\n* second step\n* third step\nScenario 2\n----------\n* first step\n* second step\n", conceptDictionary)
+
+	report := analyzeDuplicates([]*gauge.Specification{spec}, conceptDictionary)
+
+	c.Assert(len(report.scenarios), Equals, 0)
+	c.Assert(len(report.sequences), Equals, 2)
+	c.Assert(report.sequences[0].steps, DeepEquals, []string{"first step", "second step", "third step"})
+	c.Assert(len(report.sequences[0].occurrences), Equals, 2)
+	c.Assert(report.sequences[1].steps, DeepEquals, []string{"first step", "second step"})
+	c.Assert(len(report.sequences[1].occurrences), Equals, 3)
+}
+
+func (s *MySuite) TestFindsStepsDifferingInCasingWhitespaceAndPunctuation(c *C) {
+	conceptDictionary := gauge.NewConceptDictionary()
+	spec := parseSpec(c, "a.spec", "Spec\n====\nScenario 1\n----------\n* Login as \"admin\".\n* login  as \"admin\"\n* logout\n", conceptDictionary)
+
+	report := analyzeDuplicates([]*gauge.Specification{spec}, conceptDictionary)
+
+	c.Assert(len(report.similarSteps), Equals, 1)
+	c.Assert(report.similarSteps[0].steps, DeepEquals, []string{"Login as {}.", "login  as {}"})
+}
+
+func (s *MySuite) TestNormaliseStepValue(c *C) {
+	c.Assert(normaliseStepValue("  User's  Login, with {} "), Equals, "users login with {}")
+}
@@ -0,0 +1,216 @@
+// Copyright 2015 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+
+package analyzer
+
+import (
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/getgauge/gauge/api/infoGatherer"
+	"github.com/getgauge/gauge/gauge"
+	"github.com/getgauge/gauge/logger"
+	"github.com/getgauge/gauge/parser"
+	"github.com/getgauge/gauge/runner"
+	"github.com/getgauge/gauge/util"
+)
+
+type unimplementedStep struct {
+	Step      string   `json:"step"`
+	Locations []string `json:"locations"`
+}
+
+type unusedConcept struct {
+	Concept  string `json:"concept"`
+	Location string `json:"location"`
+}
+
+type stepInventory struct {
+	// UnusedSteps are implemented by the runner but not used by any spec or concept.
+	UnusedSteps        []string             `json:"unusedSteps"`
+	UnimplementedSteps []*unimplementedStep `json:"unimplementedSteps"`
+	UnusedConcepts     []*unusedConcept     `json:"unusedConcepts"`
+}
+
+// StepInventory reports implemented steps which are not used, used steps which are not implemented and concepts which
+// are not used, as text or as JSON when machineReadable is set. Usages are collected from the whole project, the
+// unimplemented steps and unused concepts reported are the ones in the given specs.
+func StepInventory(args []string, startChan *runner.StartChannels, machineReadable bool) {
+	specInfoGatherer := new(infoGatherer.SpecInfoGatherer)
+	specInfoGatherer.LoadSpecsAndConcepts()
+	var testRunner *runner.TestRunner
+	select {
+	case testRunner = <-startChan.RunnerChan:
+	case err := <-startChan.ErrorChan:
+		logger.Fatalf("Failed to start gauge API: %s", err.Error())
+	}
+	implementedSteps, err := specInfoGatherer.GetImplementedSteps(testRunner)
+	testRunner.Kill()
+	if err != nil {
+		logger.Fatalf("Failed to get the implemented steps from the runner: %s", err.Error())
+	}
+	concepts := specInfoGatherer.GetAvailableConcepts()
+	sort.Sort(byFileAndLineNo(concepts))
+	inventory := takeStepInventory(specInfoGatherer.GetAvailableSpecs(), concepts, implementedSteps, isInArgs(args))
+	if machineReadable {
+		printStepInventoryJSON(inventory)
+	} else {
+		printStepInventory(inventory)
+	}
+}
+
+// isInArgs tells if a file is one of the given files or in one of the given directories, or is anywhere in the
+// project when there are none.
+func isInArgs(args []string) func(fileName string) bool {
+	paths := make([]string, 0, len(args))
+	for _, arg := range args {
+		if path, err := filepath.Abs(util.GetPathToFile(arg)); err == nil {
+			paths = append(paths, path)
+		}
+	}
+	return func(fileName string) bool {
+		if len(args) == 0 {
+			return true
+		}
+		file, err := filepath.Abs(util.GetPathToFile(fileName))
+		if err != nil {
+			return false
+		}
+		for _, path := range paths {
+			if file == path || strings.HasPrefix(file, path+string(filepath.Separator)) {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// usedConcepts finds the concepts used by the specs, directly or through other used concepts
+func usedConcepts(specs []*gauge.Specification, concepts []*gauge.Concept) map[string]bool {
+	conceptsByValue := make(map[string]*gauge.Concept)
+	for _, concept := range concepts {
+		conceptsByValue[concept.ConceptStep.Value] = concept
+	}
+	used := make(map[string]bool)
+	var use func(steps []*gauge.Step)
+	use = func(steps []*gauge.Step) {
+		for _, step := range steps {
+			if !step.IsConcept || used[step.Value] {
+				continue
+			}
+			used[step.Value] = true
+			if concept, ok := conceptsByValue[step.Value]; ok {
+				use(concept.ConceptStep.ConceptSteps)
+			}
+		}
+	}
+	for _, spec := range specs {
+		use(spec.Contexts)
+		for _, scenario := range spec.Scenarios {
+			use(scenario.Steps)
+		}
+		use(spec.TearDownSteps)
+	}
+	return used
+}
+
+// takeStepInventory diffs the steps used by the specs and the concepts they use with the implemented steps. Only
+// the locations and concepts for which inScope is true are reported.
+func takeStepInventory(specs []*gauge.Specification, concepts []*gauge.Concept, implementedSteps []*gauge.StepValue, inScope func(fileName string) bool) *stepInventory {
+	inventory := &stepInventory{UnusedSteps: make([]string, 0), UnimplementedSteps: make([]*unimplementedStep, 0), UnusedConcepts: make([]*unusedConcept, 0)}
+	usedSteps := make(map[string]*unimplementedStep)
+	usedStepValues := make([]string, 0)
+	used := usedConcepts(specs, concepts)
+	addSteps := func(fileName string, steps []*gauge.Step) {
+		for _, step := range steps {
+			if step.IsConcept {
+				continue
+			}
+			stepValue := parser.CreateStepValue(step)
+			if _, ok := usedSteps[stepValue.StepValue]; !ok {
+				usedSteps[stepValue.StepValue] = &unimplementedStep{Step: stepValue.ParameterizedStepValue, Locations: make([]string, 0)}
+				usedStepValues = append(usedStepValues, stepValue.StepValue)
+			}
+			if inScope(fileName) {
+				usedSteps[stepValue.StepValue].Locations = append(usedSteps[stepValue.StepValue].Locations, location{fileName, step.LineNo}.String())
+			}
+		}
+	}
+	for _, spec := range specs {
+		addSteps(spec.FileName, spec.Contexts)
+		for _, scenario := range spec.Scenarios {
+			addSteps(spec.FileName, scenario.Steps)
+		}
+		addSteps(spec.FileName, spec.TearDownSteps)
+	}
+	for _, concept := range concepts {
+		if used[concept.ConceptStep.Value] {
+			addSteps(concept.FileName, concept.ConceptStep.ConceptSteps)
+		}
+	}
+
+	implemented := make(map[string]bool)
+	for _, stepValue := range implementedSteps {
+		if implemented[stepValue.StepValue] {
+			continue
+		}
+		implemented[stepValue.StepValue] = true
+		if _, ok := usedSteps[stepValue.StepValue]; !ok {
+			inventory.UnusedSteps = append(inventory.UnusedSteps, stepValue.ParameterizedStepValue)
+		}
+	}
+	for _, stepValue := range usedStepValues {
+		if !implemented[stepValue] && len(usedSteps[stepValue].Locations) > 0 {
+			inventory.UnimplementedSteps = append(inventory.UnimplementedSteps, usedSteps[stepValue])
+		}
+	}
+	for _, concept := range concepts {
+		if !used[concept.ConceptStep.Value] && inScope(concept.FileName) {
+			inventory.UnusedConcepts = append(inventory.UnusedConcepts, &unusedConcept{Concept: concept.ConceptStep.LineText, Location: location{concept.FileName, concept.ConceptStep.LineNo}.String()})
+		}
+	}
+	return inventory
+}
+
+func printStepInventory(inventory *stepInventory) {
+	logger.Info("%d implemented steps are not used:", len(inventory.UnusedSteps))
+	for _, step := range inventory.UnusedSteps {
+		logger.Info("  %s", step)
+	}
+	logger.Info("%d steps are not implemented:", len(inventory.UnimplementedSteps))
+	for _, step := range inventory.UnimplementedSteps {
+		logger.Info("  %s", step.Step)
+		for _, location := range step.Locations {
+			logger.Info("    at %s", location)
+		}
+	}
+	logger.Info("%d concepts are not used:", len(inventory.UnusedConcepts))
+	for _, concept := range inventory.UnusedConcepts {
+		logger.Info("  %s (%s)", concept.Concept, concept.Location)
+	}
+}
+
+func printStepInventoryJSON(inventory *stepInventory) {
+	b, err := json.MarshalIndent(inventory, "", "    ")
+	if err != nil {
+		logger.Fatalf("Failed to create JSON report: %s", err.Error())
+	}
+	fmt.Println(string(b))
+}
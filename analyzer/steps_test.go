@@ -0,0 +1,93 @@
+// Copyright 2015 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+
+package analyzer
+
+import (
+	"encoding/json"
+
+	"github.com/getgauge/gauge/gauge"
+	"github.com/getgauge/gauge/parser"
+	. "gopkg.in/check.v1"
+)
+
+func implemented(c *C, steps ...string) []*gauge.StepValue {
+	stepValues := make([]*gauge.StepValue, 0)
+	for _, step := range steps {
+		stepValue, err := parser.ExtractStepValueAndParams(step, false)
+		c.Assert(err, IsNil)
+		stepValues = append(stepValues, stepValue)
+	}
+	return stepValues
+}
+
+func anyFile(string) bool {
+	return true
+}
+
+func (s *MySuite) TestStepInventory(c *C) {
+	conceptDictionary := gauge.NewConceptDictionary()
+	c.Assert(parser.AddConceptsFromText("# login as <user>\n* enter <user>\n* submit\n\n# unused concept\n* submit\n", "a.cpt", conceptDictionary), IsNil)
+	spec := parseSpec(c, "a.spec", "Spec\n====\nScenario\n--------\n* login as \"admin\"\n* open \"home\" page\n* open \"about\" page\n", conceptDictionary)
+
+	inventory := takeStepInventory([]*gauge.Specification{spec}, sortedConcepts(conceptDictionary), implemented(c, "enter <name>", "submit", "logout"), anyFile)
+
+	c.Assert(inventory.UnusedSteps, DeepEquals, []string{"logout"})
+	c.Assert(len(inventory.UnimplementedSteps), Equals, 1)
+	c.Assert(inventory.UnimplementedSteps[0].Step, Equals, "open <home> page")
+	c.Assert(inventory.UnimplementedSteps[0].Locations, DeepEquals, []string{"a.spec:6", "a.spec:7"})
+	c.Assert(len(inventory.UnusedConcepts), Equals, 1)
+	c.Assert(inventory.UnusedConcepts[0].Concept, Equals, "unused concept")
+	c.Assert(inventory.UnusedConcepts[0].Location, Equals, "a.cpt:5")
+}
+
+func (s *MySuite) TestStepInventoryJSON(c *C) {
+	inventory := takeStepInventory([]*gauge.Specification{}, []*gauge.Concept{}, implemented(c, "logout"), anyFile)
+
+	b, err := json.Marshal(inventory)
+
+	c.Assert(err, IsNil)
+	c.Assert(string(b), Equals, `{"unusedSteps":["logout"],"unimplementedSteps":[],"unusedConcepts":[]}`)
+}
+
+func (s *MySuite) TestStepsOfUnusedConceptsAreNotUsed(c *C) {
+	conceptDictionary := gauge.NewConceptDictionary()
+	c.Assert(parser.AddConceptsFromText("# login\n* enter name\n\n# checkout\n* login\n* pay\n\n# unused\n* logout\n* go back\n", "a.cpt", conceptDictionary), IsNil)
+	spec := parseSpec(c, "a.spec", "Spec\n====\nScenario\n--------\n* checkout\n", conceptDictionary)
+
+	inventory := takeStepInventory([]*gauge.Specification{spec}, sortedConcepts(conceptDictionary), implemented(c, "enter name", "pay", "logout"), anyFile)
+
+	c.Assert(inventory.UnusedSteps, DeepEquals, []string{"logout"})
+	c.Assert(len(inventory.UnimplementedSteps), Equals, 0)
+	c.Assert(len(inventory.UnusedConcepts), Equals, 1)
+	c.Assert(inventory.UnusedConcepts[0].Concept, Equals, "unused")
+}
+
+func (s *MySuite) TestStepInventoryReportsOnlyFilesInScope(c *C) {
+	conceptDictionary := gauge.NewConceptDictionary()
+	c.Assert(parser.AddConceptsFromText("# unused\n* submit\n", "other/a.cpt", conceptDictionary), IsNil)
+	first := parseSpec(c, "specs/first.spec", "Spec\n====\nScenario\n--------\n* open page\n* submit\n", conceptDictionary)
+	other := parseSpec(c, "other/other.spec", "Spec\n====\nScenario\n--------\n* open page\n* login\n", conceptDictionary)
+	inSpecs := func(fileName string) bool { return fileName == "specs/first.spec" }
+
+	inventory := takeStepInventory([]*gauge.Specification{first, other}, sortedConcepts(conceptDictionary), implemented(c, "submit", "login", "logout"), inSpecs)
+
+	c.Assert(inventory.UnusedSteps, DeepEquals, []string{"logout"})
+	c.Assert(len(inventory.UnimplementedSteps), Equals, 1)
+	c.Assert(inventory.UnimplementedSteps[0].Locations, DeepEquals, []string{"specs/first.spec:5"})
+	c.Assert(len(inventory.UnusedConcepts), Equals, 0)
+}
@@ -23,6 +23,7 @@ import (
 	"os"
 	"path"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/getgauge/common"
@@ -53,14 +54,20 @@ func StartAPI() *runner.StartChannels {
 
 // StartAPIService starts the Gauge API service
 func StartAPIService(port int, startChannels *runner.StartChannels) {
+	startAPIService(port, startChannels, nil)
+}
+
+// startAPIService starts the Gauge API service, which requires clients to authenticate if an authenticator is given.
+func startAPIService(port int, startChannels *runner.StartChannels, auth *authenticator) {
 	specInfoGatherer := new(infoGatherer.SpecInfoGatherer)
-	apiHandler := &gaugeAPIMessageHandler{specInfoGatherer: specInfoGatherer}
-	gaugeConnectionHandler, err := conn.NewGaugeConnectionHandler(port, apiHandler)
+	apiHandler := &gaugeAPIMessageHandler{specInfoGatherer: specInfoGatherer, auth: auth}
+	gaugeConnectionHandler, err := newConnectionHandler(port, apiHandler)
 	if err != nil {
 		startChannels.ErrorChan <- fmt.Errorf("Connection error. %s", err.Error())
 		return
 	}
-	if port == 0 {
+	gaugeConnectionHandler.UseFraming(conn.Negotiate(conn.OfferedFraming(), strings.Split(os.Getenv(conn.APIFramingEnv), ",")))
+	if port == 0 && gaugeConnectionHandler.SocketPath() == "" {
 		if err := common.SetEnvVariable(common.APIPortEnvVariableName, strconv.Itoa(gaugeConnectionHandler.ConnectionPortNumber())); err != nil {
 			startChannels.ErrorChan <- fmt.Errorf("Failed to set Env variable %s. %s", common.APIPortEnvVariableName, err.Error())
 			return
@@ -76,13 +83,22 @@ func StartAPIService(port int, startChannels *runner.StartChannels) {
 	startChannels.RunnerChan <- runner
 }
 
+// newConnectionHandler listens on the unix domain socket at the path set in conn.APISocketEnv by the process starting
+// the API, if any, else on the given port.
+func newConnectionHandler(port int, apiHandler *gaugeAPIMessageHandler) (*conn.GaugeConnectionHandler, error) {
+	if socketPath := strings.TrimSpace(os.Getenv(conn.APISocketEnv)); socketPath != "" {
+		return conn.NewGaugeSocketConnectionHandler(socketPath, apiHandler)
+	}
+	return conn.NewGaugeConnectionHandlerOn(config.APIBindAddress(), port, apiHandler)
+}
+
 func connectToRunner(killChannel chan bool) (*runner.TestRunner, error) {
 	manifest, err := manifest.ProjectManifest()
 	if err != nil {
 		return nil, err
 	}
 
-	runner, connErr := runner.StartRunnerAndMakeConnection(manifest, reporter.Current(), killChannel)
+	runner, connErr := runner.StartRunnerAndMakeConnection(manifest, reporter.Current(), 0, killChannel)
 	if connErr != nil {
 		return nil, connErr
 	}
@@ -91,9 +107,14 @@ func connectToRunner(killChannel chan bool) (*runner.TestRunner, error) {
 }
 
 func runAPIServiceIndefinitely(port int) {
+	auth, err := newAuthenticator()
+	if err != nil {
+		logger.Fatalf("Failed to create the API token. %s", err.Error())
+	}
 	startChan := &runner.StartChannels{RunnerChan: make(chan *runner.TestRunner), ErrorChan: make(chan error), KillChan: make(chan bool)}
-	go StartAPIService(port, startChan)
+	go startAPIService(port, startChan, auth)
 	go checkParentIsAlive(startChan)
+	go auth.removeTokenOnSignal()
 
 	for {
 		select {
@@ -101,6 +122,7 @@ func runAPIServiceIndefinitely(port int) {
 			logger.Info("Got a kill message. Killing runner.")
 			runner.Kill()
 		case err := <-startChan.ErrorChan:
+			auth.removeToken()
 			logger.Fatalf("Killing Gauge daemon. %v", err.Error())
 		}
 	}
@@ -117,10 +139,17 @@ func checkParentIsAlive(startChannels *runner.StartChannels) {
 	}
 }
 
-// RunInBackground runs Gauge in daemonized mode on the given apiPort
-func RunInBackground(apiPort string) {
+// RunInBackground runs Gauge in daemonized mode on the given apiPort, or on the given unix domain socket
+func RunInBackground(apiPort, apiSocket string) {
 	var port int
 	var err error
+	if apiSocket != "" {
+		os.Setenv(conn.APISocketEnv, apiSocket)
+	}
+	if os.Getenv(conn.APISocketEnv) != "" {
+		runAPIServiceIndefinitely(0)
+		return
+	}
 	if apiPort != "" {
 		port, err = strconv.Atoi(apiPort)
 		if err != nil {
@@ -139,6 +168,7 @@ func RunInBackground(apiPort string) {
 type gaugeAPIMessageHandler struct {
 	specInfoGatherer *infoGatherer.SpecInfoGatherer
 	Runner           *runner.TestRunner
+	auth             *authenticator
 }
 
 func (handler *gaugeAPIMessageHandler) MessageBytesReceived(bytesRead []byte, connection net.Conn) {
@@ -148,6 +178,11 @@ func (handler *gaugeAPIMessageHandler) MessageBytesReceived(bytesRead []byte, co
 	if err != nil {
 		logger.APILog.Error("Failed to read API proto message: %s\n", err.Error())
 		responseMessage = handler.getErrorMessage(err)
+	} else if handler.auth != nil && !handler.auth.authenticate(apiMessage, connection) {
+		logger.APILog.Error("Rejecting unauthenticated API client %s", connection.RemoteAddr())
+		handler.sendMessage(handler.getErrorResponse(apiMessage, errUnauthenticated), connection)
+		connection.Close()
+		return
 	} else {
 		logger.APILog.Debug("Api Request Received: %s", apiMessage)
 		messageType := apiMessage.GetMessageType()
@@ -173,9 +208,21 @@ func (handler *gaugeAPIMessageHandler) MessageBytesReceived(bytesRead []byte, co
 		case gauge_messages.APIMessage_GetAllConceptsRequest:
 			responseMessage = handler.getAllConceptsRequestResponse(apiMessage)
 			break
+		case gauge_messages.APIMessage_SearchStepsRequest:
+			responseMessage = handler.searchStepsRequestResponse(apiMessage)
+			break
 		case gauge_messages.APIMessage_PerformRefactoringRequest:
 			responseMessage = handler.performRefactoring(apiMessage)
 			break
+		case gauge_messages.APIMessage_RenameConceptRequest:
+			responseMessage = handler.renameConcept(apiMessage)
+			break
+		case gauge_messages.APIMessage_InlineConceptRequest:
+			responseMessage = handler.inlineConcept(apiMessage)
+			break
+		case gauge_messages.APIMessage_ConvertToTableDrivenScenarioRequest:
+			responseMessage = handler.convertToTableDrivenScenario(apiMessage)
+			break
 		case gauge_messages.APIMessage_ExtractConceptRequest:
 			responseMessage = handler.extractConcept(apiMessage)
 			break
@@ -189,6 +236,12 @@ func (handler *gaugeAPIMessageHandler) MessageBytesReceived(bytesRead []byte, co
 	handler.sendMessage(responseMessage, connection)
 }
 
+func (handler *gaugeAPIMessageHandler) ConnectionClosed(connection net.Conn) {
+	if handler.auth != nil {
+		handler.auth.forget(connection)
+	}
+}
+
 func (handler *gaugeAPIMessageHandler) sendMessage(message *gauge_messages.APIMessage, connection net.Conn) {
 	logger.APILog.Debug("Sending API response: %s", message)
 	dataBytes, err := proto.Marshal(message)
@@ -249,6 +302,13 @@ func (handler *gaugeAPIMessageHandler) getAllConceptsRequestResponse(message *ga
 	return &gauge_messages.APIMessage{MessageType: gauge_messages.APIMessage_GetAllConceptsResponse.Enum(), MessageId: message.MessageId, AllConceptsResponse: allConceptsResponse}
 }
 
+func (handler *gaugeAPIMessageHandler) searchStepsRequestResponse(message *gauge_messages.APIMessage) *gauge_messages.APIMessage {
+	request := message.GetSearchStepsRequest()
+	steps := handler.specInfoGatherer.SearchSteps(request.GetQuery(), int(request.GetLimit()))
+	searchStepsResponse := &gauge_messages.SearchStepsResponse{Steps: steps}
+	return &gauge_messages.APIMessage{MessageType: gauge_messages.APIMessage_SearchStepsResponse.Enum(), MessageId: message.MessageId, SearchStepsResponse: searchStepsResponse}
+}
+
 func (handler *gaugeAPIMessageHandler) getLanguagePluginLibPath(message *gauge_messages.APIMessage) *gauge_messages.APIMessage {
 	libPathRequest := message.GetLibPathRequest()
 	language := libPathRequest.GetLanguage()
@@ -294,12 +354,32 @@ func (handler *gaugeAPIMessageHandler) performRefactoring(message *gauge_message
 	refactoringRequest := message.PerformRefactoringRequest
 	startChan := StartAPI()
 	refactoringResult := refactor.PerformRephraseRefactoring(refactoringRequest.GetOldStep(), refactoringRequest.GetNewStep(), startChan)
-	if refactoringResult.Success {
-		logger.APILog.Info("%s", refactoringResult.String())
+	return handler.createPerformRefactoringResponse(message, refactoringResult.Success, refactoringResult.Errors, refactoringResult.AllFilesChanges(), refactoringResult.String())
+}
+
+func (handler *gaugeAPIMessageHandler) renameConcept(message *gauge_messages.APIMessage) *gauge_messages.APIMessage {
+	request := message.GetRenameConceptRequest()
+	refactoringResult := refactor.PerformRenameConceptRefactoring(request.GetOldConcept(), request.GetNewConcept())
+	return handler.createPerformRefactoringResponse(message, refactoringResult.Success, refactoringResult.Errors, refactoringResult.AllFilesChanges(), refactoringResult.String())
+}
+
+func (handler *gaugeAPIMessageHandler) inlineConcept(message *gauge_messages.APIMessage) *gauge_messages.APIMessage {
+	refactoringResult := refactor.PerformInlineConceptRefactoring(message.GetInlineConceptRequest().GetConcept())
+	return handler.createPerformRefactoringResponse(message, refactoringResult.Success, refactoringResult.Errors, refactoringResult.AllFilesChanges(), refactoringResult.String())
+}
+
+func (handler *gaugeAPIMessageHandler) convertToTableDrivenScenario(message *gauge_messages.APIMessage) *gauge_messages.APIMessage {
+	refactoringResult := refactor.PerformTableDrivenRefactoring(message.GetConvertToTableDrivenScenarioRequest().GetSpecFile())
+	return handler.createPerformRefactoringResponse(message, refactoringResult.Success, refactoringResult.Errors, refactoringResult.AllFilesChanges(), refactoringResult.String())
+}
+
+func (handler *gaugeAPIMessageHandler) createPerformRefactoringResponse(message *gauge_messages.APIMessage, success bool, errors []string, filesChanged []string, summary string) *gauge_messages.APIMessage {
+	if success {
+		logger.APILog.Info("%s", summary)
 	} else {
-		logger.APILog.Error("Refactoring response from gauge. Errors : %s", refactoringResult.Errors)
+		logger.APILog.Error("Refactoring response from gauge. Errors : %s", errors)
 	}
-	response := &gauge_messages.PerformRefactoringResponse{Success: proto.Bool(refactoringResult.Success), Errors: refactoringResult.Errors, FilesChanged: refactoringResult.AllFilesChanges()}
+	response := &gauge_messages.PerformRefactoringResponse{Success: proto.Bool(success), Errors: errors, FilesChanged: filesChanged}
 	return &gauge_messages.APIMessage{MessageId: message.MessageId, MessageType: gauge_messages.APIMessage_PerformRefactoringResponse.Enum(), PerformRefactoringResponse: response}
 }
 
@@ -312,7 +392,12 @@ func (handler *gaugeAPIMessageHandler) extractConcept(message *gauge_messages.AP
 
 func (handler *gaugeAPIMessageHandler) formatSpecs(message *gauge_messages.APIMessage) *gauge_messages.APIMessage {
 	request := message.GetFormatSpecsRequest()
-	results := formatter.FormatSpecFiles(request.GetSpecs()...)
+	c, err := formatter.ProjectConfig()
+	if err != nil {
+		formatResponse := &gauge_messages.FormatSpecsResponse{Errors: []string{fmt.Sprintf("Failed to read the formatting rules. %s", err.Error())}}
+		return &gauge_messages.APIMessage{MessageId: message.MessageId, MessageType: gauge_messages.APIMessage_FormatSpecsResponse.Enum(), FormatSpecsResponse: formatResponse}
+	}
+	results := formatter.FormatSpecFiles(c, request.GetSpecs()...)
 	var warnings []string
 	var errors []string
 	for _, result := range results {
@@ -0,0 +1,111 @@
+// Copyright 2015 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+
+package api
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/hex"
+	"errors"
+	"io/ioutil"
+	"net"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"sync"
+	"syscall"
+
+	"github.com/getgauge/gauge/config"
+	"github.com/getgauge/gauge/gauge_messages"
+	"github.com/getgauge/gauge/logger"
+)
+
+const (
+	// APITokenEnv holds the token of the daemon for the runner and plugins it starts
+	APITokenEnv  = "GAUGE_API_TOKEN"
+	apiTokenFile = "api_token"
+	dotGauge     = ".gauge"
+)
+
+var errUnauthenticated = errors.New("Unauthenticated API client. The first message of a connection should have the token in .gauge/api_token of the project.")
+
+// authenticator checks that the first message of each connection has the token of the daemon.
+type authenticator struct {
+	token         []byte
+	tokenFile     string
+	mutex         sync.Mutex
+	authenticated map[net.Conn]bool
+}
+
+// newAuthenticator generates the token of the daemon and writes it in the .gauge directory of the project, readable
+// only by the user running Gauge.
+func newAuthenticator() (*authenticator, error) {
+	randomBytes := make([]byte, 32)
+	if _, err := rand.Read(randomBytes); err != nil {
+		return nil, err
+	}
+	token := hex.EncodeToString(randomBytes)
+	dir := filepath.Join(config.ProjectRoot, dotGauge)
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return nil, err
+	}
+	tokenFile := filepath.Join(dir, apiTokenFile)
+	os.Remove(tokenFile)
+	if err := ioutil.WriteFile(tokenFile, []byte(token), 0600); err != nil {
+		return nil, err
+	}
+	if err := os.Setenv(APITokenEnv, token); err != nil {
+		return nil, err
+	}
+	return &authenticator{token: []byte(token), tokenFile: tokenFile, authenticated: make(map[net.Conn]bool)}, nil
+}
+
+// removeToken removes the token file of the daemon, which is no use to clients once the daemon exits.
+func (a *authenticator) removeToken() {
+	os.Remove(a.tokenFile)
+}
+
+// removeTokenOnSignal removes the token file when the daemon is interrupted or terminated, and exits.
+func (a *authenticator) removeTokenOnSignal() {
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, os.Interrupt, syscall.SIGTERM)
+	received := <-signals
+	a.removeToken()
+	logger.Fatalf("Killing Gauge daemon. Received %s.", received)
+}
+
+// authenticate tells if the message comes from an authenticated client. The first message of a connection
+// authenticates it if it has the token.
+func (a *authenticator) authenticate(message *gauge_messages.APIMessage, connection net.Conn) bool {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+	if a.authenticated[connection] {
+		return true
+	}
+	if subtle.ConstantTimeCompare([]byte(message.GetToken()), a.token) == 1 {
+		a.authenticated[connection] = true
+		return true
+	}
+	return false
+}
+
+func (a *authenticator) forget(connection net.Conn) {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+	delete(a.authenticated, connection)
+}
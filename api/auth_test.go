@@ -0,0 +1,163 @@
+// Copyright 2015 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+
+package api
+
+import (
+	"io"
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/getgauge/gauge/config"
+	"github.com/getgauge/gauge/gauge_messages"
+	"github.com/golang/protobuf/proto"
+	. "gopkg.in/check.v1"
+)
+
+func Test(t *testing.T) { TestingT(t) }
+
+type MySuite struct{}
+
+var _ = Suite(&MySuite{})
+
+func newTestAuthenticator(c *C) (*authenticator, func()) {
+	projectRoot, err := ioutil.TempDir("", "gaugeProject")
+	c.Assert(err, IsNil)
+	oldProjectRoot := config.ProjectRoot
+	config.ProjectRoot = projectRoot
+	auth, err := newAuthenticator()
+	c.Assert(err, IsNil)
+	return auth, func() {
+		config.ProjectRoot = oldProjectRoot
+		os.Unsetenv(APITokenEnv)
+		os.RemoveAll(projectRoot)
+	}
+}
+
+func projectRootRequest(token string) *gauge_messages.APIMessage {
+	message := &gauge_messages.APIMessage{
+		MessageType:        gauge_messages.APIMessage_GetProjectRootRequest.Enum(),
+		MessageId:          proto.Int64(1),
+		ProjectRootRequest: &gauge_messages.GetProjectRootRequest{},
+	}
+	if token != "" {
+		message.Token = proto.String(token)
+	}
+	return message
+}
+
+// sendToHandler passes the message to the handler as received on the connection, and reads the response the handler
+// writes back to the client
+func sendToHandler(c *C, handler *gaugeAPIMessageHandler, message *gauge_messages.APIMessage, serverEnd, clientEnd net.Conn) *gauge_messages.APIMessage {
+	data, err := proto.Marshal(message)
+	c.Assert(err, IsNil)
+	go handler.MessageBytesReceived(data, serverEnd)
+	var received []byte
+	buffer := make([]byte, 8192)
+	for {
+		length, n := proto.DecodeVarint(received)
+		if n > 0 && len(received) >= n+int(length) {
+			response := &gauge_messages.APIMessage{}
+			c.Assert(proto.Unmarshal(received[n:n+int(length)], response), IsNil)
+			return response
+		}
+		read, err := clientEnd.Read(buffer)
+		c.Assert(err, IsNil)
+		received = append(received, buffer[:read]...)
+	}
+}
+
+func (s *MySuite) TestTokenIsWrittenForTheUserOnly(c *C) {
+	auth, cleanUp := newTestAuthenticator(c)
+	defer cleanUp()
+
+	tokenFile := filepath.Join(config.ProjectRoot, dotGauge, apiTokenFile)
+	token, err := ioutil.ReadFile(tokenFile)
+	c.Assert(err, IsNil)
+	c.Assert(token, DeepEquals, auth.token)
+	c.Assert(os.Getenv(APITokenEnv), Equals, string(auth.token))
+	info, err := os.Stat(tokenFile)
+	c.Assert(err, IsNil)
+	c.Assert(info.Mode().Perm(), Equals, os.FileMode(0600))
+
+	auth.removeToken()
+
+	_, err = os.Stat(tokenFile)
+	c.Assert(os.IsNotExist(err), Equals, true)
+}
+
+func (s *MySuite) TestFirstMessageWithTheTokenAuthenticatesTheConnection(c *C) {
+	auth, cleanUp := newTestAuthenticator(c)
+	defer cleanUp()
+	connection, other := net.Pipe()
+	defer connection.Close()
+	defer other.Close()
+
+	c.Assert(auth.authenticate(projectRootRequest(string(auth.token)), connection), Equals, true)
+	c.Assert(auth.authenticate(projectRootRequest(""), connection), Equals, true)
+	c.Assert(auth.authenticate(projectRootRequest(""), other), Equals, false)
+	c.Assert(auth.authenticate(projectRootRequest("wrong token"), other), Equals, false)
+}
+
+func (s *MySuite) TestClosedConnectionIsForgotten(c *C) {
+	auth, cleanUp := newTestAuthenticator(c)
+	defer cleanUp()
+	handler := &gaugeAPIMessageHandler{auth: auth}
+	connection, other := net.Pipe()
+	defer other.Close()
+	c.Assert(auth.authenticate(projectRootRequest(string(auth.token)), connection), Equals, true)
+
+	handler.ConnectionClosed(connection)
+
+	c.Assert(len(auth.authenticated), Equals, 0)
+	c.Assert(auth.authenticate(projectRootRequest(""), connection), Equals, false)
+}
+
+func (s *MySuite) TestHandlerAnswersAuthenticatedClients(c *C) {
+	auth, cleanUp := newTestAuthenticator(c)
+	defer cleanUp()
+	handler := &gaugeAPIMessageHandler{auth: auth}
+	serverEnd, clientEnd := net.Pipe()
+	defer serverEnd.Close()
+	defer clientEnd.Close()
+
+	response := sendToHandler(c, handler, projectRootRequest(string(auth.token)), serverEnd, clientEnd)
+
+	c.Assert(response.GetMessageType(), Equals, gauge_messages.APIMessage_GetProjectRootResponse)
+	c.Assert(response.GetProjectRootResponse().GetProjectRoot(), Equals, config.ProjectRoot)
+}
+
+func (s *MySuite) TestHandlerRejectsAndDisconnectsClientsWithoutTheToken(c *C) {
+	auth, cleanUp := newTestAuthenticator(c)
+	defer cleanUp()
+	handler := &gaugeAPIMessageHandler{auth: auth}
+
+	for _, token := range []string{"", "wrong token"} {
+		serverEnd, clientEnd := net.Pipe()
+
+		response := sendToHandler(c, handler, projectRootRequest(token), serverEnd, clientEnd)
+
+		c.Assert(response.GetMessageType(), Equals, gauge_messages.APIMessage_ErrorResponse)
+		c.Assert(response.GetError().GetError(), Equals, errUnauthenticated.Error())
+		_, err := clientEnd.Read(make([]byte, 1))
+		c.Assert(err, Equals, io.EOF)
+		clientEnd.Close()
+	}
+}
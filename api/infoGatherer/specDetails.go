@@ -24,7 +24,6 @@ import (
 
 	"github.com/getgauge/common"
 	"github.com/getgauge/gauge/config"
-	"github.com/getgauge/gauge/conn"
 	"github.com/getgauge/gauge/gauge"
 	"github.com/getgauge/gauge/gauge_messages"
 	"github.com/getgauge/gauge/logger"
@@ -42,9 +41,11 @@ type SpecInfoGatherer struct {
 	specsCache        map[string][]*gauge.Specification
 	conceptsCache     map[string][]*gauge.Concept
 	stepsCache        map[string]*gauge.StepValue
+	runner            *runner.TestRunner
 }
 
 func (s *SpecInfoGatherer) MakeListOfAvailableSteps(runner *runner.TestRunner) {
+	s.runner = runner
 	go s.watchForFileChanges()
 	s.waitGroup.Wait()
 
@@ -55,6 +56,14 @@ func (s *SpecInfoGatherer) MakeListOfAvailableSteps(runner *runner.TestRunner) {
 	s.initStepsCache(runner)
 }
 
+// LoadSpecsAndConcepts parses the specs and concepts of the project once, without asking the runner for its steps or
+// watching the files for changes.
+func (s *SpecInfoGatherer) LoadSpecsAndConcepts() {
+	s.waitGroup.Add(2)
+	s.initConceptsCache()
+	s.initSpecsCache()
+}
+
 func (s *SpecInfoGatherer) initSpecsCache() {
 	defer s.waitGroup.Done()
 
@@ -88,7 +97,10 @@ func (s *SpecInfoGatherer) initStepsCache(runner *runner.TestRunner) {
 	s.stepsCache = make(map[string]*gauge.StepValue, 0)
 	stepsFromSpecs := s.getStepsFromCachedSpecs()
 	stepsFromConcepts := s.getStepsFromCachedConcepts()
-	implementedSteps := s.getImplementedSteps(runner)
+	implementedSteps, err := s.GetImplementedSteps(runner)
+	if err != nil {
+		logger.APILog.Error("Error response from runner on getStepNamesRequest: %s", err)
+	}
 
 	allSteps := append(implementedSteps, stepsFromConcepts...)
 	allSteps = append(allSteps, stepsFromSpecs...)
@@ -202,16 +214,15 @@ func (s *SpecInfoGatherer) getStepsFromConcept(concept *gauge.Concept) []*gauge.
 	return stepValues
 }
 
-func (s *SpecInfoGatherer) getImplementedSteps(runner *runner.TestRunner) []*gauge.StepValue {
-	stepValues := make([]*gauge.StepValue, 0)
-	message, err := conn.GetResponseForMessageWithTimeout(createGetStepNamesRequest(), runner.Connection, config.RunnerRequestTimeout())
+// GetImplementedSteps asks the runner for the steps it implements.
+func (s *SpecInfoGatherer) GetImplementedSteps(runner *runner.TestRunner) ([]*gauge.StepValue, error) {
+	message, err := runner.Connection.GetResponseWithTimeout(createGetStepNamesRequest(), config.RunnerRequestTimeout())
 	if err != nil {
-		logger.APILog.Error("Error response from runner on getStepNamesRequest: %s", err)
-		return stepValues
+		return make([]*gauge.StepValue, 0), err
 	}
 
 	allSteps := message.GetStepNamesResponse().GetSteps()
-	return s.getParsedStepValues(allSteps)
+	return s.getParsedStepValues(allSteps), nil
 }
 
 func (s *SpecInfoGatherer) onSpecFileModify(file string) {
@@ -395,6 +406,18 @@ func (s *SpecInfoGatherer) GetAvailableSpecs() []*gauge.Specification {
 	return allSpecs
 }
 
+func (s *SpecInfoGatherer) GetAvailableConcepts() []*gauge.Concept {
+	s.waitGroup.Wait()
+
+	allConcepts := make([]*gauge.Concept, 0)
+	s.mutex.Lock()
+	for _, concepts := range s.conceptsCache {
+		allConcepts = append(allConcepts, concepts...)
+	}
+	s.mutex.Unlock()
+	return allConcepts
+}
+
 func (s *SpecInfoGatherer) GetAvailableSteps() []*gauge.StepValue {
 	s.waitGroup.Wait()
 
@@ -2,9 +2,12 @@ package infoGatherer
 
 import (
 	"github.com/getgauge/gauge/config"
+	"github.com/getgauge/gauge/conn"
+	"github.com/getgauge/gauge/runner"
 	"github.com/getgauge/gauge/util"
 	. "gopkg.in/check.v1"
 	"io/ioutil"
+	"net"
 	"os"
 	"testing"
 )
@@ -118,3 +121,15 @@ func (s *MySuite) TestInitConceptsCache(c *C) {
 
 	c.Assert(len(specInfoGatherer.conceptsCache), Equals, 2)
 }
+
+func (s *MySuite) TestGetImplementedStepsFailsWhenTheRunnerDoesNotRespond(c *C) {
+	gaugeEnd, runnerEnd := net.Pipe()
+	runnerEnd.Close()
+	testRunner := &runner.TestRunner{Connection: conn.NewMessageConnection(gaugeEnd)}
+	defer testRunner.Connection.Close()
+
+	steps, err := new(SpecInfoGatherer).GetImplementedSteps(testRunner)
+
+	c.Assert(err, NotNil)
+	c.Assert(len(steps), Equals, 0)
+}
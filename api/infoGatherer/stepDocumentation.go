@@ -0,0 +1,238 @@
+// Copyright 2015 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+
+package infoGatherer
+
+import (
+	"sort"
+	"strings"
+	"sync"
+	"unicode"
+
+	"github.com/getgauge/gauge/config"
+	"github.com/getgauge/gauge/gauge"
+	"github.com/getgauge/gauge/gauge_messages"
+	"github.com/getgauge/gauge/logger"
+	"github.com/getgauge/gauge/parser"
+	"github.com/golang/protobuf/proto"
+)
+
+// maxImplementationRequests is the number of step name requests sent to the runner at the same time while searching
+const maxImplementationRequests = 8
+
+type stepMatch struct {
+	stepValue *gauge.StepValue
+	concept   *gauge.Concept
+	score     int
+}
+
+// SearchSteps returns the documentation of the steps and concepts whose text matches the query, the best matches
+// first. At most limit steps are returned, all matching ones if limit is not positive.
+func (s *SpecInfoGatherer) SearchSteps(query string, limit int) []*gauge_messages.StepDocumentation {
+	concepts := s.conceptsByValue()
+	var matches []*stepMatch
+	for _, stepValue := range s.GetAvailableSteps() {
+		if _, ok := concepts[stepValue.StepValue]; ok {
+			continue
+		}
+		if score, ok := fuzzyScore(query, stepValue.ParameterizedStepValue); ok {
+			matches = append(matches, &stepMatch{stepValue: stepValue, score: score})
+		}
+	}
+	for _, concept := range concepts {
+		stepValue := parser.CreateStepValue(concept.ConceptStep)
+		if score, ok := fuzzyScore(query, stepValue.ParameterizedStepValue); ok {
+			matches = append(matches, &stepMatch{stepValue: &stepValue, concept: concept, score: score})
+		}
+	}
+	sort.Sort(byScore(matches))
+	if limit > 0 && len(matches) > limit {
+		matches = matches[:limit]
+	}
+
+	usages := s.stepUsages()
+	documentation := make([]*gauge_messages.StepDocumentation, 0, len(matches))
+	var implemented []*gauge_messages.StepDocumentation
+	for _, match := range matches {
+		doc := &gauge_messages.StepDocumentation{StepValue: gauge.ConvertToProtoStepValue(match.stepValue),
+			IsConcept: proto.Bool(match.concept != nil), Usages: usages[match.stepValue.StepValue]}
+		if match.concept != nil {
+			doc.Definition = stepLocation(match.concept.FileName, match.concept.ConceptStep.LineNo)
+			doc.ExpandedSteps = expandConcept(match.concept.ConceptStep, concepts, 1, map[string]bool{match.stepValue.StepValue: true})
+		} else {
+			implemented = append(implemented, doc)
+		}
+		documentation = append(documentation, doc)
+	}
+	s.addImplementations(implemented)
+	return documentation
+}
+
+// addImplementations asks the runner where the steps are implemented, a few steps at a time rather than one after the
+// other, since every request is a round trip to the runner
+func (s *SpecInfoGatherer) addImplementations(docs []*gauge_messages.StepDocumentation) {
+	if s.runner == nil {
+		return
+	}
+	var wg sync.WaitGroup
+	requests := make(chan bool, maxImplementationRequests)
+	for _, doc := range docs {
+		wg.Add(1)
+		requests <- true
+		go func(doc *gauge_messages.StepDocumentation) {
+			defer wg.Done()
+			doc.Implementation = s.getImplementation(doc.GetStepValue().GetStepValue())
+			<-requests
+		}(doc)
+	}
+	wg.Wait()
+}
+
+func (s *SpecInfoGatherer) conceptsByValue() map[string]*gauge.Concept {
+	s.waitGroup.Wait()
+
+	concepts := make(map[string]*gauge.Concept)
+	s.mutex.Lock()
+	for _, conceptList := range s.conceptsCache {
+		for _, concept := range conceptList {
+			concepts[concept.ConceptStep.Value] = concept
+		}
+	}
+	s.mutex.Unlock()
+	return concepts
+}
+
+// stepUsages maps the value of every step used in the specs and concepts to the places it is used in
+func (s *SpecInfoGatherer) stepUsages() map[string][]*gauge_messages.StepLocation {
+	usages := make(map[string][]*gauge_messages.StepLocation)
+	addUsages := func(fileName string, steps []*gauge.Step) {
+		for _, step := range steps {
+			usages[step.Value] = append(usages[step.Value], stepLocation(fileName, step.LineNo))
+		}
+	}
+	s.mutex.Lock()
+	for _, specList := range s.specsCache {
+		for _, spec := range specList {
+			addUsages(spec.FileName, spec.Contexts)
+			for _, scenario := range spec.Scenarios {
+				addUsages(spec.FileName, scenario.Steps)
+			}
+			addUsages(spec.FileName, spec.TearDownSteps)
+		}
+	}
+	for _, conceptList := range s.conceptsCache {
+		for _, concept := range conceptList {
+			addUsages(concept.FileName, concept.ConceptStep.ConceptSteps)
+		}
+	}
+	s.mutex.Unlock()
+	for _, locations := range usages {
+		sort.Sort(byLocation(locations))
+	}
+	return usages
+}
+
+// getImplementation asks the runner where the step is implemented
+func (s *SpecInfoGatherer) getImplementation(stepValue string) *gauge_messages.StepLocation {
+	message := &gauge_messages.Message{MessageType: gauge_messages.Message_StepNameRequest.Enum(), StepNameRequest: &gauge_messages.StepNameRequest{StepValue: proto.String(stepValue)}}
+	response, err := s.runner.Connection.GetResponseWithTimeout(message, config.RunnerRequestTimeout())
+	if err != nil {
+		logger.APILog.Error("Error response from runner on stepNameRequest: %s", err)
+		return nil
+	}
+	stepName := response.GetStepNameResponse()
+	if !stepName.GetIsStepPresent() || stepName.GetFileName() == "" {
+		return nil
+	}
+	return stepLocation(stepName.GetFileName(), int(stepName.GetLineNumber()))
+}
+
+// expandConcept lists the steps of the concept, each nested concept followed by its own steps
+func expandConcept(conceptStep *gauge.Step, concepts map[string]*gauge.Concept, depth int, expanding map[string]bool) []*gauge_messages.ExpandedStep {
+	var expandedSteps []*gauge_messages.ExpandedStep
+	for _, step := range conceptStep.ConceptSteps {
+		stepValue := parser.CreateStepValue(step)
+		nested, isConcept := concepts[step.Value]
+		expandedSteps = append(expandedSteps, &gauge_messages.ExpandedStep{StepValue: gauge.ConvertToProtoStepValue(&stepValue),
+			Depth: proto.Int32(int32(depth)), IsConcept: proto.Bool(isConcept), LineNumber: proto.Int32(int32(step.LineNo))})
+		if isConcept && !expanding[step.Value] {
+			expanding[step.Value] = true
+			expandedSteps = append(expandedSteps, expandConcept(nested.ConceptStep, concepts, depth+1, expanding)...)
+			delete(expanding, step.Value)
+		}
+	}
+	return expandedSteps
+}
+
+func stepLocation(fileName string, lineNumber int) *gauge_messages.StepLocation {
+	return &gauge_messages.StepLocation{FileName: proto.String(fileName), LineNumber: proto.Int32(int32(lineNumber))}
+}
+
+// fuzzyScore tells if the characters of the query appear in the text in the same order, ignoring case, and how well
+// they match. Characters following each other or starting words score higher, and so does the query as a whole.
+func fuzzyScore(query, text string) (int, bool) {
+	q := []rune(strings.ToLower(strings.TrimSpace(query)))
+	t := []rune(strings.ToLower(text))
+	score, qi, previous := 0, 0, -2
+	for ti := 0; ti < len(t) && qi < len(q); ti++ {
+		if t[ti] != q[qi] {
+			continue
+		}
+		score++
+		if ti == previous+1 {
+			score += 2
+		}
+		if ti == 0 || !unicode.IsLetter(t[ti-1]) && !unicode.IsDigit(t[ti-1]) {
+			score += 3
+		}
+		previous = ti
+		qi++
+	}
+	if qi < len(q) {
+		return 0, false
+	}
+	if strings.Contains(string(t), string(q)) {
+		score += 2 * len(q)
+	}
+	return score, true
+}
+
+type byScore []*stepMatch
+
+func (m byScore) Len() int      { return len(m) }
+func (m byScore) Swap(i, j int) { m[i], m[j] = m[j], m[i] }
+func (m byScore) Less(i, j int) bool {
+	if m[i].score != m[j].score {
+		return m[i].score > m[j].score
+	}
+	a, b := m[i].stepValue.ParameterizedStepValue, m[j].stepValue.ParameterizedStepValue
+	if len(a) != len(b) {
+		return len(a) < len(b)
+	}
+	return a < b
+}
+
+type byLocation []*gauge_messages.StepLocation
+
+func (l byLocation) Len() int      { return len(l) }
+func (l byLocation) Swap(i, j int) { l[i], l[j] = l[j], l[i] }
+func (l byLocation) Less(i, j int) bool {
+	if l[i].GetFileName() != l[j].GetFileName() {
+		return l[i].GetFileName() < l[j].GetFileName()
+	}
+	return l[i].GetLineNumber() < l[j].GetLineNumber()
+}
@@ -0,0 +1,161 @@
+// Copyright 2015 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+
+package infoGatherer
+
+import (
+	"net"
+	"path/filepath"
+
+	"github.com/getgauge/gauge/conn"
+	"github.com/getgauge/gauge/gauge"
+	"github.com/getgauge/gauge/gauge_messages"
+	"github.com/getgauge/gauge/runner"
+	"github.com/getgauge/gauge/util"
+	"github.com/golang/protobuf/proto"
+	. "gopkg.in/check.v1"
+)
+
+func (s *MySuite) searchGatherer(c *C) *SpecInfoGatherer {
+	_, err := util.CreateFileIn(s.specsDir, "login.cpt", []byte(`# login as <user>
+* open app
+* enter <user>
+
+# checkout
+* login as "admin"
+* pay
+`))
+	c.Assert(err, IsNil)
+	_, err = util.CreateFileIn(s.specsDir, "shop.spec", []byte(`Shop
+====
+Buy
+---
+* checkout
+* pay
+`))
+	c.Assert(err, IsNil)
+	specInfoGatherer := new(SpecInfoGatherer)
+	specInfoGatherer.waitGroup.Add(2)
+	specInfoGatherer.initConceptsCache()
+	specInfoGatherer.initSpecsCache()
+	specInfoGatherer.stepsCache = make(map[string]*gauge.StepValue)
+	specInfoGatherer.addToStepsCache(append(specInfoGatherer.getStepsFromCachedSpecs(), specInfoGatherer.getStepsFromCachedConcepts()...))
+	return specInfoGatherer
+}
+
+func (s *MySuite) TestFuzzyScore(c *C) {
+	_, ok := fuzzyScore("pay", "open app")
+	c.Assert(ok, Equals, false)
+
+	exact, ok := fuzzyScore("open", "open app")
+	c.Assert(ok, Equals, true)
+	scattered, ok := fuzzyScore("opn", "open app")
+	c.Assert(ok, Equals, true)
+	c.Assert(exact > scattered, Equals, true)
+
+	_, ok = fuzzyScore("", "anything")
+	c.Assert(ok, Equals, true)
+}
+
+func (s *MySuite) TestSearchStepsRanksBestMatchesFirst(c *C) {
+	specInfoGatherer := s.searchGatherer(c)
+
+	steps := specInfoGatherer.SearchSteps("pay", 0)
+
+	c.Assert(len(steps), Equals, 1)
+	c.Assert(steps[0].GetStepValue().GetStepValue(), Equals, "pay")
+	c.Assert(steps[0].GetIsConcept(), Equals, false)
+	c.Assert(steps[0].GetImplementation(), IsNil)
+	c.Assert(len(steps[0].GetUsages()), Equals, 2)
+	c.Assert(steps[0].GetUsages()[0].GetFileName(), Equals, filepath.Join(s.specsDir, "login.cpt"))
+	c.Assert(steps[0].GetUsages()[0].GetLineNumber(), Equals, int32(7))
+	c.Assert(steps[0].GetUsages()[1].GetFileName(), Equals, filepath.Join(s.specsDir, "shop.spec"))
+
+	c.Assert(len(specInfoGatherer.SearchSteps("", 2)), Equals, 2)
+}
+
+func (s *MySuite) TestSearchStepsDocumentsConcepts(c *C) {
+	specInfoGatherer := s.searchGatherer(c)
+
+	steps := specInfoGatherer.SearchSteps("checkout", 1)
+
+	c.Assert(len(steps), Equals, 1)
+	concept := steps[0]
+	c.Assert(concept.GetIsConcept(), Equals, true)
+	c.Assert(concept.GetDefinition().GetLineNumber(), Equals, int32(5))
+	c.Assert(len(concept.GetUsages()), Equals, 1)
+	expanded := concept.GetExpandedSteps()
+	c.Assert(len(expanded), Equals, 4)
+	c.Assert(expanded[0].GetStepValue().GetStepValue(), Equals, "login as {}")
+	c.Assert(expanded[0].GetIsConcept(), Equals, true)
+	c.Assert(expanded[1].GetStepValue().GetStepValue(), Equals, "open app")
+	c.Assert(expanded[1].GetDepth(), Equals, int32(2))
+	c.Assert(expanded[2].GetStepValue().GetStepValue(), Equals, "enter {}")
+	c.Assert(expanded[3].GetStepValue().GetStepValue(), Equals, "pay")
+	c.Assert(expanded[3].GetDepth(), Equals, int32(1))
+}
+
+// readRequests reads the given number of messages sent to the runner end of the connection
+func readRequests(c *C, runnerEnd net.Conn, count int) []*gauge_messages.Message {
+	var requests []*gauge_messages.Message
+	var received []byte
+	buffer := make([]byte, 8192)
+	for len(requests) < count {
+		length, n := proto.DecodeVarint(received)
+		if n > 0 && len(received) >= n+int(length) {
+			request := &gauge_messages.Message{}
+			c.Assert(proto.Unmarshal(received[n:n+int(length)], request), IsNil)
+			requests = append(requests, request)
+			received = received[n+int(length):]
+			continue
+		}
+		read, err := runnerEnd.Read(buffer)
+		c.Assert(err, IsNil)
+		received = append(received, buffer[:read]...)
+	}
+	return requests
+}
+
+func (s *MySuite) TestSearchStepsAsksTheRunnerForImplementationsConcurrently(c *C) {
+	specInfoGatherer := s.searchGatherer(c)
+	gaugeEnd, runnerEnd := net.Pipe()
+	defer runnerEnd.Close()
+	specInfoGatherer.runner = &runner.TestRunner{Connection: conn.NewMessageConnection(gaugeEnd)}
+	defer specInfoGatherer.runner.Connection.Close()
+	go func() {
+		// Responds only once all the requests are sent, which would time out if they were sent one after the other
+		for _, request := range readRequests(c, runnerEnd, 3) {
+			response := &gauge_messages.Message{MessageType: gauge_messages.Message_StepNameResponse.Enum(), MessageId: request.MessageId,
+				StepNameResponse: &gauge_messages.StepNameResponse{IsStepPresent: proto.Bool(true), HasAlias: proto.Bool(false),
+					StepName: []string{request.GetStepNameRequest().GetStepValue()}, FileName: proto.String("steps.go"), LineNumber: proto.Int32(3)}}
+			data, err := proto.Marshal(response)
+			c.Assert(err, IsNil)
+			c.Assert(conn.Write(runnerEnd, data), IsNil)
+		}
+	}()
+
+	steps := specInfoGatherer.SearchSteps("", 0)
+
+	c.Assert(len(steps), Equals, 5)
+	for _, step := range steps {
+		if step.GetIsConcept() {
+			c.Assert(step.GetImplementation(), IsNil)
+		} else {
+			c.Assert(step.GetImplementation().GetFileName(), Equals, "steps.go")
+		}
+	}
+}
@@ -20,7 +20,6 @@ package conceptExtractor
 import (
 	"errors"
 	"fmt"
-	"os"
 	"regexp"
 	"strings"
 
@@ -29,6 +28,7 @@ import (
 	"github.com/getgauge/gauge/gauge"
 	"github.com/getgauge/gauge/gauge_messages"
 	"github.com/getgauge/gauge/parser"
+	"github.com/getgauge/gauge/refactor"
 	"github.com/getgauge/gauge/util"
 )
 
@@ -41,7 +41,8 @@ type extractor struct {
 	conceptName    string
 	conceptStep    *gauge.Step
 	stepsToExtract []*gauge_messages.Step
-	stepsInConcept string
+	conceptSteps   []*gauge.Step
+	selectedSteps  []*gauge.Step
 	table          *gauge.Table
 	fileContent    string
 	dynamicArgs    []string
@@ -53,12 +54,30 @@ func ExtractConcept(conceptName *gauge_messages.Step, steps []*gauge_messages.St
 	if util.IsSpec(selectedTextInfo.GetFileName()) {
 		content, _ = common.ReadFileContents(selectedTextInfo.GetFileName())
 	}
-	concept, conceptUsageText, err := getExtractedConcept(conceptName, steps, content)
+	extractor, err := newExtractor(conceptName, steps, content)
 	if err != nil {
 		return false, err, []string{}
 	}
-	writeConceptToFile(concept, conceptUsageText, conceptFileName, selectedTextInfo.GetFileName(), selectedTextInfo)
-	return true, errors.New(""), []string{conceptFileName, selectedTextInfo.GetFileName()}
+	if changeAcrossProject {
+		extractor.parameterise(extractor.findDifferingArgs(selectedTextInfo))
+	}
+	conceptFileContent, _ := common.ReadFileContents(conceptFileName)
+	changes := map[string]string{
+		conceptFileName:                conceptFileContent + "\n" + extractor.conceptText(),
+		selectedTextInfo.GetFileName(): ReplaceExtractedStepsWithConcept(selectedTextInfo, extractor.usageText()),
+	}
+	filesChanged := []string{conceptFileName, selectedTextInfo.GetFileName()}
+	if changeAcrossProject {
+		for _, file := range extractor.replaceOccurrencesInProject(changes) {
+			if !util.ContainsFile(filesChanged, file) {
+				filesChanged = append(filesChanged, file)
+			}
+		}
+	}
+	if err := refactor.SaveFiles(changes); err != nil {
+		return false, err, []string{}
+	}
+	return true, errors.New(""), filesChanged
 }
 
 func ReplaceExtractedStepsWithConcept(selectedTextInfo *gauge_messages.TextInfo, conceptText string) string {
@@ -75,34 +94,44 @@ func replaceText(content string, info *gauge_messages.TextInfo, replacement stri
 	return strings.Join(parts, "\n")
 }
 
-func writeConceptToFile(concept string, conceptUsageText string, conceptFileName string, fileName string, info *gauge_messages.TextInfo) {
-	if _, err := os.Stat(conceptFileName); os.IsNotExist(err) {
-		os.Create(conceptFileName)
+func getExtractedConcept(conceptName *gauge_messages.Step, steps []*gauge_messages.Step, content string) (string, string, error) {
+	extractor, err := newExtractor(conceptName, steps, content)
+	if err != nil {
+		return "", "", err
 	}
-	content, _ := common.ReadFileContents(conceptFileName)
-	util.SaveFile(conceptFileName, content+"\n"+concept, true)
-	text := ReplaceExtractedStepsWithConcept(info, conceptUsageText)
-	util.SaveFile(fileName, text, true)
+	return extractor.conceptText(), extractor.usageText(), nil
 }
 
-func getExtractedConcept(conceptName *gauge_messages.Step, steps []*gauge_messages.Step, content string) (string, string, error) {
+func newExtractor(conceptName *gauge_messages.Step, steps []*gauge_messages.Step, content string) (*extractor, error) {
 	tokens, _ := new(parser.SpecParser).GenerateTokens("* " + conceptName.GetName())
 	conceptStep, _ := parser.CreateStepUsingLookup(tokens[0], nil)
 	specText, err := getContentWithDataTable(content)
 	if err != nil {
-		return "", "", err
+		return nil, err
 	}
-	extractor := &extractor{conceptName: "* " + conceptName.GetName(), stepsInConcept: "", stepsToExtract: steps, conceptStep: conceptStep, table: &gauge.Table{}, fileContent: specText, errors: make([]error, 0)}
+	extractor := &extractor{conceptName: "* " + conceptName.GetName(), stepsToExtract: steps, conceptStep: conceptStep, table: &gauge.Table{}, fileContent: specText, errors: make([]error, 0)}
 	extractor.extractSteps()
 	if len(extractor.errors) != 0 {
-		return "", "", err
+		return nil, extractor.errors[0]
 	}
 	conceptStep.ReplaceArgsWithDynamic(conceptStep.Args)
 	addArgsFromTable(conceptStep, &extractor.conceptName, extractor.dynamicArgs)
-	if extractor.table.IsInitialized() {
-		extractor.conceptName += "\n" + formatter.FormatTable(extractor.table)
+	return extractor, nil
+}
+
+func (self *extractor) conceptText() string {
+	stepsInConcept := ""
+	for _, step := range self.conceptSteps {
+		stepsInConcept += formatter.FormatStep(step)
+	}
+	return strings.Replace(formatter.FormatStep(self.conceptStep), "* ", "# ", 1) + stepsInConcept
+}
+
+func (self *extractor) usageText() string {
+	if self.table.IsInitialized() {
+		return self.conceptName + "\n" + formatter.FormatTable(self.table)
 	}
-	return strings.Replace(formatter.FormatStep(conceptStep), "* ", "# ", 1) + (extractor.stepsInConcept), extractor.conceptName, nil
+	return self.conceptName
 }
 
 func addArgsFromTable(concept *gauge.Step, conceptName *string, args []string) {
@@ -122,32 +151,39 @@ func getContentWithDataTable(content string) (string, error) {
 	if spec.DataTable.IsInitialized() {
 		newSpec = &gauge.Specification{Items: []gauge.Item{&spec.DataTable}, Heading: &gauge.Heading{Value: "SPECHEADING"}}
 	}
-	return formatter.FormatSpecification(newSpec) + "\n##hello \n* step \n", nil
+	return formatter.FormatSpecification(newSpec, formatter.DefaultConfig()) + "\n##hello \n* step \n", nil
 }
 
 func (self *extractor) extractSteps() {
 	for _, step := range self.stepsToExtract {
 		tokens, _ := new(parser.SpecParser).GenerateTokens("*" + step.GetName())
 		stepInConcept, _ := parser.CreateStepUsingLookup(tokens[0], nil)
+		selectedArgs := append([]*gauge.StepArg{}, stepInConcept.Args...)
 		if step.GetTable() != "" {
-			self.handleTable(stepInConcept, step)
+			if tableArg := self.handleTable(stepInConcept, step); tableArg != nil {
+				selectedArgs = append(selectedArgs, tableArg)
+			}
 		}
 		stepInConcept.ReplaceArgsWithDynamic(self.conceptStep.Args)
-		self.stepsInConcept += formatter.FormatStep(stepInConcept)
+		self.selectedSteps = append(self.selectedSteps, &gauge.Step{Value: stepInConcept.Value, Args: selectedArgs})
+		self.conceptSteps = append(self.conceptSteps, stepInConcept)
 	}
 }
 
-func (self *extractor) handleTable(stepInConcept *gauge.Step, step *gauge_messages.Step) {
+// handleTable adds the table of the step as an argument and returns the table as it was selected.
+func (self *extractor) handleTable(stepInConcept *gauge.Step, step *gauge_messages.Step) *gauge.StepArg {
 	stepInConcept.Value += " {}"
 	specText := self.fileContent + step.GetTable()
 	spec, result := new(parser.SpecParser).Parse(specText, &gauge.ConceptDictionary{})
 	if !result.Ok {
 		self.errors = append(self.errors, result.ParseError)
-		return
+		return nil
 	}
-	stepArgs := []*gauge.StepArg{spec.Scenarios[0].Steps[0].Args[0]}
+	tableArg := spec.Scenarios[0].Steps[0].Args[0]
+	stepArgs := []*gauge.StepArg{tableArg}
 	self.addTableAsParam(step, stepArgs)
 	stepInConcept.Args = append(stepInConcept.Args, stepArgs[0])
+	return tableArg
 }
 
 func (self *extractor) addTableAsParam(step *gauge_messages.Step, args []*gauge.StepArg) {
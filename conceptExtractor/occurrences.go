@@ -0,0 +1,288 @@
+// Copyright 2015 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+
+package conceptExtractor
+
+import (
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/getgauge/common"
+	"github.com/getgauge/gauge/config"
+	"github.com/getgauge/gauge/formatter"
+	"github.com/getgauge/gauge/gauge"
+	"github.com/getgauge/gauge/gauge_messages"
+	"github.com/getgauge/gauge/logger"
+	"github.com/getgauge/gauge/parser"
+	"github.com/getgauge/gauge/util"
+)
+
+// argPosition is the position of an argument among the extracted steps.
+type argPosition struct {
+	step int
+	arg  int
+}
+
+type byPosition []argPosition
+
+func (s byPosition) Len() int {
+	return len(s)
+}
+
+func (s byPosition) Swap(i, j int) {
+	s[i], s[j] = s[j], s[i]
+}
+
+func (s byPosition) Less(i, j int) bool {
+	return s[i].step < s[j].step || (s[i].step == s[j].step && s[i].arg < s[j].arg)
+}
+
+// findDifferingArgs looks for the extracted steps across the project, leaving out the selected text, and returns
+// the positions of static arguments whose values differ from the selected ones in any of the occurrences.
+func (self *extractor) findDifferingArgs(selectedTextInfo *gauge_messages.TextInfo) []argPosition {
+	isSelected := func(fileName string, step *gauge.Step) bool {
+		return fileName == selectedTextInfo.GetFileName() && step.LineNo >= int(selectedTextInfo.GetStartingLineNo()) && step.LineNo <= int(selectedTextInfo.GetEndLineNo())
+	}
+	positions := make([]argPosition, 0)
+	for _, spec := range findSpecs(nil) {
+		differing, _ := self.replaceOccurrencesInSpec(spec, isSelected)
+		positions = append(positions, differing...)
+	}
+	for _, concept := range findConcepts(nil).ConceptsMap {
+		_, differing, _ := self.replaceOccurrences(concept.ConceptStep.Items[1:], func(step *gauge.Step) bool { return isSelected(concept.FileName, step) })
+		positions = append(positions, differing...)
+	}
+	return positions
+}
+
+// parameterise adds a concept parameter for each of the given static arguments. The selected value is passed as the
+// argument in the usage of the concept.
+func (self *extractor) parameterise(positions []argPosition) {
+	sort.Sort(byPosition(positions))
+	for _, position := range positions {
+		if self.conceptSteps[position.step].Args[position.arg].ArgType == gauge.Dynamic {
+			continue
+		}
+		value := self.selectedSteps[position.step].Args[position.arg].Value
+		name := self.newParamName(value)
+		self.conceptSteps[position.step].Args[position.arg] = &gauge.StepArg{Value: name, ArgType: gauge.Dynamic}
+		self.conceptStep.Value += " {}"
+		self.conceptStep.Args = append(self.conceptStep.Args, &gauge.StepArg{Value: name, ArgType: gauge.Dynamic, Name: name})
+		self.conceptName += fmt.Sprintf(" \"%s\"", strings.Replace(value, "\"", "\\\"", -1))
+	}
+}
+
+func (self *extractor) newParamName(value string) string {
+	base := strings.Replace(strings.Replace(value, "<", "{", -1), ">", "}", -1)
+	if strings.TrimSpace(base) == "" {
+		base = "arg"
+	}
+	name := base
+	for i := 1; self.isParam(name); i++ {
+		name = fmt.Sprintf("%s%d", base, i)
+	}
+	return name
+}
+
+func (self *extractor) isParam(name string) bool {
+	for _, arg := range self.conceptStep.Args {
+		if arg.ArgType == gauge.Dynamic && arg.Value == name {
+			return true
+		}
+	}
+	return false
+}
+
+// replaceOccurrencesInProject replaces the extracted steps with the concept in all specs and concepts of the project.
+// Files already changed by the extraction are read from changes, and the new contents are added to it. It returns the
+// files which are changed.
+func (self *extractor) replaceOccurrencesInProject(changes map[string]string) []string {
+	filesChanged := make([]string, 0)
+	noneSelected := func(*gauge.Step) bool { return false }
+	c := formatter.ProjectConfigOrDefault()
+	for _, spec := range findSpecs(changes) {
+		if _, replaced := self.replaceOccurrencesInSpec(spec, func(string, *gauge.Step) bool { return false }); replaced {
+			changes[spec.FileName] = formatter.FormatSpecification(spec, c)
+			filesChanged = append(filesChanged, spec.FileName)
+		}
+	}
+	conceptDictionary := findConcepts(changes)
+	conceptFilesChanged := make(map[string]bool)
+	for _, concept := range conceptDictionary.ConceptsMap {
+		if concept.ConceptStep.Value == self.conceptStep.Value {
+			continue
+		}
+		items, _, replaced := self.replaceOccurrences(concept.ConceptStep.Items[1:], noneSelected)
+		if replaced {
+			concept.ConceptStep.Items = append([]gauge.Item{concept.ConceptStep}, items...)
+			conceptFilesChanged[concept.FileName] = true
+		}
+	}
+	for fileName, content := range formatter.FormatConcepts(conceptDictionary, c) {
+		if conceptFilesChanged[fileName] {
+			changes[fileName] = content
+			filesChanged = append(filesChanged, fileName)
+		}
+	}
+	return filesChanged
+}
+
+// replaceOccurrencesInSpec replaces the extracted steps in the spec and returns the differing static arguments.
+func (self *extractor) replaceOccurrencesInSpec(spec *gauge.Specification, isSelected func(string, *gauge.Step) bool) ([]argPosition, bool) {
+	skip := func(step *gauge.Step) bool { return isSelected(spec.FileName, step) }
+	items, positions, replaced := self.replaceOccurrences(spec.Items, skip)
+	spec.Items = items
+	for _, scenario := range spec.Scenarios {
+		scenarioItems, differing, scenarioReplaced := self.replaceOccurrences(scenario.Items, skip)
+		scenario.Items = scenarioItems
+		positions = append(positions, differing...)
+		replaced = replaced || scenarioReplaced
+	}
+	return positions, replaced
+}
+
+// replaceOccurrences replaces every sequence of consecutive steps which matches the extracted steps with a usage
+// of the concept. Occurrences starting at a step for which skip returns true are left as they are.
+func (self *extractor) replaceOccurrences(items []gauge.Item, skip func(*gauge.Step) bool) ([]gauge.Item, []argPosition, bool) {
+	replacedItems := make([]gauge.Item, 0, len(items))
+	positions := make([]argPosition, 0)
+	replaced := false
+	for i := 0; i < len(items); {
+		if steps := consecutiveSteps(items[i:], len(self.conceptSteps)); steps != nil && !skip(steps[0]) {
+			if params, differing, ok := self.match(steps); ok {
+				replacedItems = append(replacedItems, self.usage(params))
+				positions = append(positions, differing...)
+				replaced = true
+				i += len(steps)
+				continue
+			}
+		}
+		replacedItems = append(replacedItems, items[i])
+		i++
+	}
+	return replacedItems, positions, replaced
+}
+
+func consecutiveSteps(items []gauge.Item, count int) []*gauge.Step {
+	if count == 0 || len(items) < count {
+		return nil
+	}
+	steps := make([]*gauge.Step, 0, count)
+	for _, item := range items[:count] {
+		if item.Kind() != gauge.StepKind {
+			return nil
+		}
+		steps = append(steps, item.(*gauge.Step))
+	}
+	return steps
+}
+
+// match checks whether the steps are an occurrence of the extracted steps. It returns the values of the concept
+// parameters and the positions of static arguments which differ from the selected steps.
+func (self *extractor) match(steps []*gauge.Step) (map[string]*gauge.StepArg, []argPosition, bool) {
+	params := make(map[string]*gauge.StepArg)
+	differing := make([]argPosition, 0)
+	for i, step := range steps {
+		conceptStep, selectedStep := self.conceptSteps[i], self.selectedSteps[i]
+		if strings.TrimSpace(step.Value) != strings.TrimSpace(conceptStep.Value) || len(step.Args) != len(conceptStep.Args) || len(selectedStep.Args) != len(conceptStep.Args) {
+			return nil, nil, false
+		}
+		for j, arg := range step.Args {
+			param := conceptStep.Args[j]
+			if param.ArgType == gauge.Dynamic && self.isParam(param.Value) {
+				if value, ok := params[param.Value]; ok && !value.IsSameAs(arg) {
+					return nil, nil, false
+				}
+				params[param.Value] = arg
+			} else if selectedStep.Args[j].ArgType == gauge.Static && arg.ArgType != gauge.TableArg {
+				if !selectedStep.Args[j].IsSameAs(arg) {
+					differing = append(differing, argPosition{step: i, arg: j})
+				}
+			} else if !selectedStep.Args[j].IsSameAs(arg) {
+				return nil, nil, false
+			}
+		}
+	}
+	return params, differing, true
+}
+
+// usage creates a step which uses the concept with the given parameter values.
+func (self *extractor) usage(params map[string]*gauge.StepArg) *gauge.Step {
+	step := &gauge.Step{Value: self.conceptStep.Value, IsConcept: true}
+	for _, param := range self.conceptStep.Args {
+		arg := &gauge.StepArg{Value: param.Value, ArgType: gauge.Dynamic}
+		if value, ok := params[param.Value]; ok {
+			*arg = *value
+		}
+		step.Args = append(step.Args, arg)
+	}
+	step.PopulateFragments()
+	return step
+}
+
+// findSpecs parses the specs of the project, taking the content of the files in changes instead of the one on disk
+func findSpecs(changes map[string]string) []*gauge.Specification {
+	specs, parseResults := parser.FindSpecs(filepath.Join(config.ProjectRoot, common.SpecsDirectoryName), &gauge.ConceptDictionary{})
+	for _, parseResult := range parseResults {
+		if !parseResult.Ok {
+			logger.APILog.Warning("Skipping %s while extracting concept: %s", parseResult.FileName, parseResult.Error())
+		}
+	}
+	for i, spec := range specs {
+		content, ok := changes[spec.FileName]
+		if !ok {
+			continue
+		}
+		changed, parseResult := new(parser.SpecParser).Parse(content, &gauge.ConceptDictionary{})
+		if !parseResult.Ok {
+			logger.APILog.Warning("Skipping %s while extracting concept: %s", spec.FileName, parseResult.Error())
+			continue
+		}
+		changed.FileName = spec.FileName
+		specs[i] = changed
+	}
+	return specs
+}
+
+// findConcepts parses the concepts of the project, taking the content of the files in changes instead of the one on
+// disk. Concept files which do not parse are left out.
+func findConcepts(changes map[string]string) *gauge.ConceptDictionary {
+	conceptDictionary := gauge.NewConceptDictionary()
+	conceptFiles := util.FindConceptFilesIn(filepath.Join(config.ProjectRoot, common.SpecsDirectoryName))
+	for fileName := range changes {
+		if util.IsConcept(fileName) && !util.ContainsFile(conceptFiles, fileName) {
+			conceptFiles = append(conceptFiles, fileName)
+		}
+	}
+	sort.Strings(conceptFiles)
+	for _, conceptFile := range conceptFiles {
+		content, ok := changes[conceptFile]
+		if !ok {
+			var err error
+			if content, err = common.ReadFileContents(conceptFile); err != nil {
+				logger.APILog.Warning("Skipping %s while extracting concept: %s", conceptFile, err.Error())
+				continue
+			}
+		}
+		if err := parser.AddConceptsFromText(content, conceptFile, conceptDictionary); err != nil {
+			logger.APILog.Warning("Skipping %s while extracting concept: %s", conceptFile, err.Error())
+		}
+	}
+	return conceptDictionary
+}
@@ -0,0 +1,109 @@
+// Copyright 2015 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+
+package conceptExtractor
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/getgauge/gauge/config"
+	"github.com/getgauge/gauge/gauge_messages"
+	"github.com/getgauge/gauge/util"
+	"github.com/golang/protobuf/proto"
+	. "gopkg.in/check.v1"
+)
+
+func createSpecsDir(c *C, files map[string]string) string {
+	projectDir, err := ioutil.TempDir("", "gaugeTest")
+	c.Assert(err, Equals, nil)
+	config.ProjectRoot = projectDir
+	for name, content := range files {
+		_, err := util.CreateFileIn(filepath.Join(projectDir, "specs"), name, []byte(content))
+		c.Assert(err, Equals, nil)
+	}
+	return filepath.Join(projectDir, "specs")
+}
+
+func readFile(c *C, fileName string) string {
+	content, err := ioutil.ReadFile(fileName)
+	c.Assert(err, Equals, nil)
+	return string(content)
+}
+
+func extractAcrossProject(c *C, specsDir string, name string, steps []string, startLine, endLine int32) []string {
+	stepMessages := make([]*gauge_messages.Step, 0)
+	for _, step := range steps {
+		stepMessages = append(stepMessages, &gauge_messages.Step{Name: proto.String(step)})
+	}
+	textInfo := &gauge_messages.TextInfo{FileName: proto.String(filepath.Join(specsDir, "spec1.spec")), StartingLineNo: proto.Int32(startLine), EndLineNo: proto.Int32(endLine)}
+	success, _, filesChanged := ExtractConcept(&gauge_messages.Step{Name: proto.String(name)}, stepMessages, filepath.Join(specsDir, "extracted.cpt"), true, textInfo)
+	c.Assert(success, Equals, true)
+	return filesChanged
+}
+
+func (s *MySuite) TestExtractConceptAcrossProjectReplacesAllOccurrences(c *C) {
+	specsDir := createSpecsDir(c, map[string]string{
+		"spec1.spec":  "Spec 1\n======\nScenario 1\n----------\n* login as \"admin\"\n* open dashboard\n",
+		"spec2.spec":  "Spec 2\n======\nScenario 2\n----------\n* start\n* login as \"admin\"\n* open dashboard\n* stop\n",
+		"other.cpt":   "# other concept\n* login as \"admin\"\n* open dashboard\n",
+		"unused.spec": "Spec 3\n======\nScenario 3\n----------\n* open dashboard\n",
+	})
+	defer os.RemoveAll(filepath.Dir(specsDir))
+
+	filesChanged := extractAcrossProject(c, specsDir, "login to dashboard", []string{"login as \"admin\"", "open dashboard"}, 5, 6)
+
+	c.Assert(len(filesChanged), Equals, 4)
+	c.Assert(readFile(c, filepath.Join(specsDir, "spec1.spec")), Equals, "Spec 1\n======\nScenario 1\n----------\n* login to dashboard\n")
+	c.Assert(readFile(c, filepath.Join(specsDir, "spec2.spec")), Equals, "Spec 2\n======\nScenario 2\n----------\n* start\n* login to dashboard\n* stop\n")
+	c.Assert(readFile(c, filepath.Join(specsDir, "other.cpt")), Equals, "# other concept\n* login to dashboard\n")
+	c.Assert(readFile(c, filepath.Join(specsDir, "unused.spec")), Equals, "Spec 3\n======\nScenario 3\n----------\n* open dashboard\n")
+}
+
+func (s *MySuite) TestExtractConceptAcrossProjectParameterisesDifferingArgs(c *C) {
+	specsDir := createSpecsDir(c, map[string]string{
+		"spec1.spec": "Spec 1\n======\nScenario 1\n----------\n* login as \"admin\"\n* open \"dashboard\"\n",
+		"spec2.spec": "Spec 2\n======\nScenario 2\n----------\n* login as \"guest\"\n* open \"dashboard\"\n",
+	})
+	defer os.RemoveAll(filepath.Dir(specsDir))
+
+	filesChanged := extractAcrossProject(c, specsDir, "login", []string{"login as \"admin\"", "open \"dashboard\""}, 5, 6)
+
+	c.Assert(len(filesChanged), Equals, 3)
+	c.Assert(readFile(c, filepath.Join(specsDir, "extracted.cpt")), Equals, "\n# login <admin>\n* login as <admin>\n* open \"dashboard\"\n")
+	c.Assert(readFile(c, filepath.Join(specsDir, "spec1.spec")), Equals, "Spec 1\n======\nScenario 1\n----------\n* login \"admin\"\n")
+	c.Assert(readFile(c, filepath.Join(specsDir, "spec2.spec")), Equals, "Spec 2\n======\nScenario 2\n----------\n* login \"guest\"\n")
+}
+
+func (s *MySuite) TestExtractConceptWritesNothingWhenChangesDoNotParse(c *C) {
+	spec := "Spec 1\n======\nScenario 1\n----------\n* login as \"admin\"\n* open dashboard\n"
+	specsDir := createSpecsDir(c, map[string]string{
+		"spec1.spec":    spec,
+		"extracted.cpt": "# login to dashboard\n* open dashboard\n",
+	})
+	defer os.RemoveAll(filepath.Dir(specsDir))
+	textInfo := &gauge_messages.TextInfo{FileName: proto.String(filepath.Join(specsDir, "spec1.spec")), StartingLineNo: proto.Int32(5), EndLineNo: proto.Int32(6)}
+	steps := []*gauge_messages.Step{{Name: proto.String("login as \"admin\"")}, {Name: proto.String("open dashboard")}}
+
+	success, err, _ := ExtractConcept(&gauge_messages.Step{Name: proto.String("login to dashboard")}, steps, filepath.Join(specsDir, "extracted.cpt"), true, textInfo)
+
+	c.Assert(success, Equals, false)
+	c.Assert(err, NotNil)
+	c.Assert(readFile(c, filepath.Join(specsDir, "spec1.spec")), Equals, spec)
+	c.Assert(readFile(c, filepath.Join(specsDir, "extracted.cpt")), Equals, "# login to dashboard\n* open dashboard\n")
+}
@@ -18,6 +18,7 @@
 package config
 
 import (
+	"net"
 	"os"
 	"strconv"
 	"strings"
@@ -35,13 +36,24 @@ const (
 	pluginConnectionTimeout = "plugin_connection_timeout"
 	pluginKillTimeOut       = "plugin_kill_timeout"
 	runnerRequestTimeout    = "runner_request_timeout"
+	runnerRequestsInFlight  = "runner_requests_in_flight"
 	checkUpdates            = "check_updates"
+	requirePluginChecksum   = "require_plugin_checksum"
+	pluginPublicKey         = "plugin_public_key"
+	maxFrameSize            = "max_frame_size"
+	frameCompression        = "frame_compression"
+	frameChunking           = "frame_chunking"
+	connectionTransport     = "connection_transport"
+	apiBindAddress          = "api_bind_address"
 
 	defaultRunnerConnectionTimeout = time.Second * 25
 	defaultPluginConnectionTimeout = time.Second * 10
 	defaultPluginKillTimeout       = time.Second * 4
 	defaultRefactorTimeout         = time.Second * 10
 	defaultRunnerRequestTimeout    = time.Second * 3
+	defaultRunnerRequestsInFlight  = 16
+	defaultMaxFrameSize            = 64 * 1024 * 1024
+	defaultAPIBindAddress          = "127.0.0.1"
 	LayoutForTimeStamp             = "Jan 2, 2006 at 3:04pm"
 )
 
@@ -71,6 +83,75 @@ func CheckUpdates() bool {
 	return convertToBool(allow, checkUpdates, true)
 }
 
+// Refuse to install downloaded plugins which do not have a checksum in their install description. Off by default until
+// the plugin repository publishes checksums.
+func RequirePluginChecksum() bool {
+	return optionalBool(requirePluginChecksum, false)
+}
+
+// Path to the PEM encoded public key used to verify signatures of downloaded plugins
+func PluginPublicKeyFile() string {
+	return strings.TrimSpace(getFromConfig(pluginPublicKey))
+}
+
+// Maximum size in bytes of a message exchanged with runners, plugins and API clients
+func MaxFrameSize() int {
+	size := strings.TrimSpace(getFromConfig(maxFrameSize))
+	if size == "" {
+		return defaultMaxFrameSize
+	}
+	intValue, err := strconv.Atoi(size)
+	if err != nil || intValue <= 0 {
+		APILog.Warning("Incorrect value for %s in property file. Cannot convert %s to a size in bytes", maxFrameSize, size)
+		return defaultMaxFrameSize
+	}
+	return intValue
+}
+
+// Compress large messages on connections to runners and plugins which support it
+func FrameCompression() bool {
+	return optionalBool(frameCompression, true)
+}
+
+// Split large messages, such as those carrying screenshots, into chunks on connections to runners and plugins which support it
+func FrameChunking() bool {
+	return optionalBool(frameChunking, true)
+}
+
+// Transport of the connections to runners and plugins, tcp or unix for unix domain sockets with those supporting them
+func ConnectionTransport() string {
+	transport := strings.ToLower(strings.TrimSpace(getFromConfig(connectionTransport)))
+	switch transport {
+	case "":
+		return "tcp"
+	case "tcp", "unix":
+		return transport
+	}
+	APILog.Warning("Incorrect value for %s in property file. %s should be tcp or unix", connectionTransport, transport)
+	return "tcp"
+}
+
+// Address the API listens on, the loopback address unless another address is configured
+func APIBindAddress() string {
+	address := strings.TrimSpace(getFromConfig(apiBindAddress))
+	if address == "" {
+		return defaultAPIBindAddress
+	}
+	if net.ParseIP(address) == nil {
+		APILog.Warning("Incorrect value for %s in property file. %s is not an IP address", apiBindAddress, address)
+		return defaultAPIBindAddress
+	}
+	return address
+}
+
+func optionalBool(property string, defaultValue bool) bool {
+	value := getFromConfig(property)
+	if strings.TrimSpace(value) == "" {
+		return defaultValue
+	}
+	return convertToBool(value, property, defaultValue)
+}
+
 func convertToBool(value string, property string, defaultValue bool) bool {
 	boolValue, err := strconv.ParseBool(strings.TrimSpace(value))
 	if err != nil {
@@ -93,6 +174,20 @@ func RunnerRequestTimeout() time.Duration {
 	return convertToTime(intervalString, defaultRunnerRequestTimeout, runnerRequestTimeout)
 }
 
+// Number of requests, such as step validations, sent to the runner without waiting for the previous ones to be answered
+func RunnerRequestsInFlight() int {
+	count := strings.TrimSpace(getFromConfig(runnerRequestsInFlight))
+	if count == "" {
+		return defaultRunnerRequestsInFlight
+	}
+	intValue, err := strconv.Atoi(count)
+	if err != nil || intValue <= 0 {
+		APILog.Warning("Incorrect value for %s in property file. Cannot convert %s to a number of requests", runnerRequestsInFlight, count)
+		return defaultRunnerRequestsInFlight
+	}
+	return intValue
+}
+
 func GaugeRepositoryUrl() string {
 	return getFromConfig(gaugeRepositoryUrl)
 }
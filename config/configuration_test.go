@@ -70,3 +70,68 @@ func (s *MySuite) TestAllowUpdates(c *C) {
 	getFromConfig = stub4GetFromConfig
 	c.Assert(CheckUpdates(), Equals, true)
 }
+
+func (s *MySuite) TestMaxFrameSize(c *C) {
+	getFromConfig = stubGetFromConfig
+	c.Assert(MaxFrameSize(), Equals, defaultMaxFrameSize)
+
+	getFromConfig = stub2GetFromConfig
+	c.Assert(MaxFrameSize(), Equals, 10000)
+
+	getFromConfig = stub3GetFromConfig
+	c.Assert(MaxFrameSize(), Equals, defaultMaxFrameSize)
+}
+
+func (s *MySuite) TestFrameCompression(c *C) {
+	getFromConfig = stubGetFromConfig
+	c.Assert(FrameCompression(), Equals, true)
+
+	getFromConfig = stub3GetFromConfig
+	c.Assert(FrameCompression(), Equals, false)
+}
+
+func (s *MySuite) TestConnectionTransport(c *C) {
+	getFromConfig = stubGetFromConfig
+	c.Assert(ConnectionTransport(), Equals, "tcp")
+
+	getFromConfig = func(propertyName string) string { return " Unix " }
+	c.Assert(ConnectionTransport(), Equals, "unix")
+
+	getFromConfig = stub3GetFromConfig
+	c.Assert(ConnectionTransport(), Equals, "tcp")
+}
+
+func (s *MySuite) TestAPIBindAddress(c *C) {
+	getFromConfig = stubGetFromConfig
+	c.Assert(APIBindAddress(), Equals, "127.0.0.1")
+
+	getFromConfig = func(propertyName string) string { return "0.0.0.0" }
+	c.Assert(APIBindAddress(), Equals, "0.0.0.0")
+
+	getFromConfig = stub3GetFromConfig
+	c.Assert(APIBindAddress(), Equals, "127.0.0.1")
+}
+
+func (s *MySuite) TestChecksumsAreNotRequiredByDefault(c *C) {
+	properties := map[string]string{gaugeRepositoryUrl: "http://raw.github.com/getgauge/gauge-repository/master"}
+	getFromConfig = func(propertyName string) string { return properties[propertyName] }
+	c.Assert(RequirePluginChecksum(), Equals, false)
+
+	properties[requirePluginChecksum] = "true"
+	c.Assert(RequirePluginChecksum(), Equals, true)
+
+	properties[requirePluginChecksum] = "false"
+	c.Assert(RequirePluginChecksum(), Equals, false)
+}
+
+func (s *MySuite) TestRunnerRequestsInFlight(c *C) {
+	value := ""
+	getFromConfig = func(propertyName string) string { return value }
+	c.Assert(RunnerRequestsInFlight(), Equals, defaultRunnerRequestsInFlight)
+
+	value = "1"
+	c.Assert(RunnerRequestsInFlight(), Equals, 1)
+
+	value = "0"
+	c.Assert(RunnerRequestsInFlight(), Equals, defaultRunnerRequestsInFlight)
+}
@@ -20,38 +20,145 @@ package conn
 import (
 	"bytes"
 	"fmt"
-	"github.com/golang/protobuf/proto"
+	"io/ioutil"
 	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"syscall"
 	"time"
+
+	"github.com/getgauge/gauge/config"
+)
+
+const (
+	// InternalSocketEnv holds the path of the socket a runner connects to, in place of common.GaugeInternalPortEnvName
+	InternalSocketEnv = "GAUGE_INTERNAL_SOCKET"
+	// APISocketEnv holds the path of the socket the API listens on, in place of common.APIPortEnvVariableName
+	APISocketEnv = "GAUGE_API_SOCKET"
+
+	// Transports a runner or plugin can list in the transports field of its json descriptor
+	TCPTransport  = "tcp"
+	UnixTransport = "unix"
 )
 
 type messageHandler interface {
 	MessageBytesReceived([]byte, net.Conn)
 }
 
+// closeHandler is implemented by message handlers which keep state for each connection.
+type closeHandler interface {
+	ConnectionClosed(net.Conn)
+}
+
 type dataHandlerFn func(*GaugeConnectionHandler, []byte)
 
 type GaugeConnectionHandler struct {
-	tcpListener    *net.TCPListener
+	listener       net.Listener
+	socketPath     string
+	socketDir      string
 	messageHandler messageHandler
+	framing        *Framing
 }
 
 func NewGaugeConnectionHandler(port int, messageHandler messageHandler) (*GaugeConnectionHandler, error) {
+	return NewGaugeConnectionHandlerOn("", port, messageHandler)
+}
+
+// NewGaugeConnectionHandlerOn listens on the given port of the given address, or of all the addresses of the machine
+// if the address is empty.
+func NewGaugeConnectionHandlerOn(address string, port int, messageHandler messageHandler) (*GaugeConnectionHandler, error) {
 	// port = 0 means GO will find a unused port
 
-	listener, err := net.ListenTCP("tcp", &net.TCPAddr{Port: port})
+	listener, err := net.ListenTCP("tcp", &net.TCPAddr{IP: net.ParseIP(address), Port: port})
+	if err != nil {
+		return nil, err
+	}
+
+	return &GaugeConnectionHandler{listener: listener, messageHandler: messageHandler}, nil
+}
+
+// NewGaugeSocketConnectionHandler listens on a unix domain socket at the given path, or at a path in a new temporary
+// directory if the path is empty. A socket left at the path by a gauge process which did not exit cleanly is removed,
+// but not one another process still listens on.
+func NewGaugeSocketConnectionHandler(socketPath string, messageHandler messageHandler) (*GaugeConnectionHandler, error) {
+	socketDir := ""
+	if socketPath == "" {
+		dir, err := ioutil.TempDir("", "gauge")
+		if err != nil {
+			return nil, err
+		}
+		socketDir = dir
+		socketPath = filepath.Join(dir, "gauge.sock")
+	} else if info, err := os.Lstat(socketPath); err == nil && info.Mode()&os.ModeSocket != 0 {
+		if err := removeStaleSocket(socketPath); err != nil {
+			return nil, err
+		}
+	}
+	listener, err := net.Listen("unix", socketPath)
 	if err != nil {
+		if socketDir != "" {
+			os.RemoveAll(socketDir)
+		}
 		return nil, err
 	}
+	return &GaugeConnectionHandler{listener: listener, socketPath: socketPath, socketDir: socketDir, messageHandler: messageHandler}, nil
+}
+
+// removeStaleSocket removes the socket at the path if nothing listens on it, which is when connecting to it is refused.
+func removeStaleSocket(socketPath string) error {
+	connection, err := net.Dial("unix", socketPath)
+	if err == nil {
+		connection.Close()
+		return fmt.Errorf("Socket %s is in use by another process", socketPath)
+	}
+	if !isConnectionRefused(err) {
+		return fmt.Errorf("Failed to check if socket %s is in use. %s", socketPath, err.Error())
+	}
+	return os.Remove(socketPath)
+}
+
+func isConnectionRefused(err error) bool {
+	if opErr, ok := err.(*net.OpError); ok {
+		err = opErr.Err
+	}
+	if syscallErr, ok := err.(*os.SyscallError); ok {
+		err = syscallErr.Err
+	}
+	return err == syscall.ECONNREFUSED
+}
+
+// UseUnixSocket tells if the connection to a runner or plugin supporting the given transports should be made over a
+// unix domain socket, which is the case when the transport configured in gauge.properties is unix and the peer supports it.
+func UseUnixSocket(supportedTransports []string) bool {
+	if config.ConnectionTransport() != UnixTransport {
+		return false
+	}
+	for _, transport := range supportedTransports {
+		if strings.ToLower(strings.TrimSpace(transport)) == UnixTransport {
+			return true
+		}
+	}
+	return false
+}
 
-	return &GaugeConnectionHandler{tcpListener: listener, messageHandler: messageHandler}, nil
+// UseFraming makes the connections accepted from now on use the given framing.
+func (connectionHandler *GaugeConnectionHandler) UseFraming(framing *Framing) {
+	connectionHandler.framing = framing
+}
+
+func (connectionHandler *GaugeConnectionHandler) framed(conn net.Conn) net.Conn {
+	if connectionHandler.framing == nil {
+		return conn
+	}
+	return NewFramedConnection(conn, connectionHandler.framing)
 }
 
 func (connectionHandler *GaugeConnectionHandler) AcceptConnection(connectionTimeOut time.Duration, errChannel chan error) (net.Conn, error) {
 	connectionChannel := make(chan net.Conn)
 
 	go func() {
-		connection, err := connectionHandler.tcpListener.Accept()
+		connection, err := connectionHandler.listener.Accept()
 		if err != nil {
 			errChannel <- err
 		}
@@ -64,12 +171,13 @@ func (connectionHandler *GaugeConnectionHandler) AcceptConnection(connectionTime
 	case err := <-errChannel:
 		return nil, err
 	case conn := <-connectionChannel:
+		conn = connectionHandler.framed(conn)
 		if connectionHandler.messageHandler != nil {
 			go connectionHandler.handleConnectionMessages(conn)
 		}
 		return conn, nil
 	case <-time.After(connectionTimeOut):
-		return nil, fmt.Errorf("Timed out connecting to %v", connectionHandler.tcpListener.Addr())
+		return nil, fmt.Errorf("Timed out connecting to %v", connectionHandler.listener.Addr())
 	}
 }
 
@@ -78,7 +186,7 @@ func (connectionHandler *GaugeConnectionHandler) acceptConnectionWithoutTimeout(
 	connectionChannel := make(chan net.Conn)
 
 	go func() {
-		connection, err := connectionHandler.tcpListener.Accept()
+		connection, err := connectionHandler.listener.Accept()
 		if err != nil {
 			errChannel <- err
 		}
@@ -91,6 +199,7 @@ func (connectionHandler *GaugeConnectionHandler) acceptConnectionWithoutTimeout(
 	case err := <-errChannel:
 		return nil, err
 	case conn := <-connectionChannel:
+		conn = connectionHandler.framed(conn)
 		if connectionHandler.messageHandler != nil {
 			go connectionHandler.handleConnectionMessages(conn)
 		}
@@ -104,31 +213,34 @@ func (connectionHandler *GaugeConnectionHandler) handleConnectionMessages(conn n
 	for {
 		n, err := conn.Read(data)
 		if err != nil {
-			conn.Close()
+			connectionHandler.closeConnection(conn)
 			//TODO: Move to file
 			//			logger.Log.Println(fmt.Sprintf("Closing connection [%s] cause: %s", connectionHandler.conn.RemoteAddr(), err.Error()))
 			return
 		}
 
 		buffer.Write(data[0:n])
-		connectionHandler.processMessage(buffer, conn)
+		if err := connectionHandler.processMessage(buffer, conn); err != nil {
+			connectionHandler.closeConnection(conn)
+			return
+		}
+	}
+}
+
+func (connectionHandler *GaugeConnectionHandler) closeConnection(conn net.Conn) {
+	conn.Close()
+	if handler, ok := connectionHandler.messageHandler.(closeHandler); ok {
+		handler.ConnectionClosed(conn)
 	}
 }
 
-func (connectionHandler *GaugeConnectionHandler) processMessage(buffer *bytes.Buffer, conn net.Conn) {
+func (connectionHandler *GaugeConnectionHandler) processMessage(buffer *bytes.Buffer, conn net.Conn) error {
 	for {
-		messageLength, bytesRead := proto.DecodeVarint(buffer.Bytes())
-		if messageLength > 0 && messageLength < uint64(buffer.Len()) {
-			messageBoundary := int(messageLength) + bytesRead
-			receivedBytes := buffer.Bytes()[bytesRead : messageLength+uint64(bytesRead)]
-			connectionHandler.messageHandler.MessageBytesReceived(receivedBytes, conn)
-			buffer.Next(messageBoundary)
-			if buffer.Len() == 0 {
-				return
-			}
-		} else {
-			return
+		message, err := decode(buffer, framingOf(conn))
+		if err != nil || message == nil {
+			return err
 		}
+		connectionHandler.messageHandler.MessageBytesReceived(message, conn)
 	}
 }
 
@@ -141,9 +253,27 @@ func (connectionHandler *GaugeConnectionHandler) HandleMultipleConnections() {
 }
 
 func (connectionHandler *GaugeConnectionHandler) ConnectionPortNumber() int {
-	if connectionHandler.tcpListener != nil {
-		return connectionHandler.tcpListener.Addr().(*net.TCPAddr).Port
-	} else {
-		return 0
+	if addr, ok := connectionHandler.listener.Addr().(*net.TCPAddr); ok {
+		return addr.Port
+	}
+	return 0
+}
+
+// SocketPath returns the path of the unix domain socket the handler listens on, or an empty string for a TCP port.
+func (connectionHandler *GaugeConnectionHandler) SocketPath() string {
+	if addr, ok := connectionHandler.listener.Addr().(*net.UnixAddr); ok {
+		return addr.Name
+	}
+	return ""
+}
+
+// Close stops listening for connections, removing the socket the handler listens on. Accepted connections stay open.
+func (connectionHandler *GaugeConnectionHandler) Close() error {
+	err := connectionHandler.listener.Close()
+	if connectionHandler.socketDir != "" {
+		os.RemoveAll(connectionHandler.socketDir)
+	} else if connectionHandler.socketPath != "" {
+		os.Remove(connectionHandler.socketPath)
 	}
+	return err
 }
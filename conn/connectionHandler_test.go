@@ -0,0 +1,69 @@
+// Copyright 2015 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+
+//go:build !windows
+// +build !windows
+
+package conn
+
+import (
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"syscall"
+
+	. "gopkg.in/check.v1"
+)
+
+func (s *MySuite) TestSocketInUseIsNotRemoved(c *C) {
+	dir, err := ioutil.TempDir("", "gauge")
+	c.Assert(err, IsNil)
+	defer os.RemoveAll(dir)
+	socketPath := filepath.Join(dir, "api.sock")
+	handler, err := NewGaugeSocketConnectionHandler(socketPath, nil)
+	c.Assert(err, IsNil)
+
+	_, err = NewGaugeSocketConnectionHandler(socketPath, nil)
+
+	c.Assert(err, ErrorMatches, "Socket .* is in use by another process")
+	connection, err := net.Dial("unix", socketPath)
+	c.Assert(err, IsNil)
+	connection.Close()
+
+	c.Assert(handler.Close(), IsNil)
+	_, err = os.Lstat(socketPath)
+	c.Assert(os.IsNotExist(err), Equals, true)
+}
+
+func (s *MySuite) TestStaleSocketIsReplaced(c *C) {
+	dir, err := ioutil.TempDir("", "gauge")
+	c.Assert(err, IsNil)
+	defer os.RemoveAll(dir)
+	socketPath := filepath.Join(dir, "api.sock")
+	// A socket file which nothing listens on, as left by a process which did not exit cleanly
+	fd, err := syscall.Socket(syscall.AF_UNIX, syscall.SOCK_STREAM, 0)
+	c.Assert(err, IsNil)
+	c.Assert(syscall.Bind(fd, &syscall.SockaddrUnix{Name: socketPath}), IsNil)
+	syscall.Close(fd)
+
+	handler, err := NewGaugeSocketConnectionHandler(socketPath, nil)
+
+	c.Assert(err, IsNil)
+	c.Assert(handler.SocketPath(), Equals, socketPath)
+	handler.Close()
+}
@@ -0,0 +1,241 @@
+// Copyright 2015 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+
+package conn
+
+import (
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net"
+	"strconv"
+	"strings"
+
+	"github.com/getgauge/gauge/config"
+	"github.com/golang/protobuf/proto"
+)
+
+const (
+	// FramingEnv and MaxFrameSizeEnv tell a runner or plugin the framing of its connection to Gauge
+	FramingEnv      = "GAUGE_FRAMING"
+	MaxFrameSizeEnv = "GAUGE_MAX_FRAME_SIZE"
+	// APIFramingEnv is set by the process starting the API to the framing features its clients support
+	APIFramingEnv = "GAUGE_API_FRAMING"
+
+	// Framing features a runner or plugin can list in the framing field of its json descriptor
+	CompressionFeature = "compression"
+	ChunkingFeature    = "chunking"
+
+	compressionThreshold = 16 * 1024
+	chunkSize            = 64 * 1024
+
+	compressedFlag byte = 1
+	moreChunksFlag byte = 2
+)
+
+// Framing describes how messages are framed on a connection. Without any feature, a message is framed as its varint
+// encoded length followed by the message, which is what runners and plugins that negotiate nothing expect. With
+// compression or chunking, every frame has a flags byte after the length telling if the message is gzip compressed
+// and if more chunks of the message follow.
+type Framing struct {
+	MaxFrameSize int
+	Compression  bool
+	Chunking     bool
+}
+
+var defaultFraming = &Framing{MaxFrameSize: 64 * 1024 * 1024}
+
+// OfferedFraming returns the framing Gauge is configured to use with peers supporting all its features.
+func OfferedFraming() *Framing {
+	return &Framing{MaxFrameSize: config.MaxFrameSize(), Compression: config.FrameCompression(), Chunking: config.FrameChunking()}
+}
+
+// Negotiate returns the offered framing restricted to the features the peer supports.
+func Negotiate(offered *Framing, supported []string) *Framing {
+	framing := &Framing{MaxFrameSize: offered.MaxFrameSize}
+	for _, feature := range supported {
+		switch strings.ToLower(strings.TrimSpace(feature)) {
+		case CompressionFeature:
+			framing.Compression = offered.Compression
+		case ChunkingFeature:
+			framing.Chunking = offered.Chunking
+		}
+	}
+	return framing
+}
+
+// Env returns the environment variables telling the peer the framing of its connection.
+func (f *Framing) Env() map[string]string {
+	var features []string
+	if f.Compression {
+		features = append(features, CompressionFeature)
+	}
+	if f.Chunking {
+		features = append(features, ChunkingFeature)
+	}
+	return map[string]string{FramingEnv: strings.Join(features, ","), MaxFrameSizeEnv: strconv.Itoa(f.MaxFrameSize)}
+}
+
+func (f *Framing) hasFlags() bool {
+	return f.Compression || f.Chunking
+}
+
+type framedConnection struct {
+	net.Conn
+	framing *Framing
+}
+
+// NewFramedConnection returns the connection framing the messages written and read through this package with the given framing.
+func NewFramedConnection(connection net.Conn, framing *Framing) net.Conn {
+	return &framedConnection{Conn: connection, framing: framing}
+}
+
+func framingOf(connection net.Conn) *Framing {
+	if c, ok := connection.(*framedConnection); ok {
+		return c.framing
+	}
+	return defaultFraming
+}
+
+func frameTooLargeError(size, maxFrameSize int) error {
+	return fmt.Errorf("Message of %d bytes exceeds the maximum frame size of %d bytes. Set max_frame_size in gauge.properties to allow larger messages.", size, maxFrameSize)
+}
+
+// encode frames the message, compressing it and splitting it into chunks if the framing allows it.
+func encode(message []byte, f *Framing) ([]byte, error) {
+	if len(message) > f.MaxFrameSize {
+		return nil, frameTooLargeError(len(message), f.MaxFrameSize)
+	}
+	if !f.hasFlags() {
+		return append(proto.EncodeVarint(uint64(len(message))), message...), nil
+	}
+	var flags byte
+	if f.Compression && len(message) > compressionThreshold {
+		compressed, err := compress(message)
+		if err != nil {
+			return nil, err
+		}
+		if len(compressed) < len(message) {
+			message = compressed
+			flags |= compressedFlag
+		}
+	}
+	size := len(message)
+	if f.Chunking {
+		size = chunkSize
+	}
+	buffer := new(bytes.Buffer)
+	for {
+		chunk, chunkFlags := message, flags
+		if len(chunk) > size {
+			chunk = chunk[:size]
+			chunkFlags |= moreChunksFlag
+		}
+		buffer.Write(proto.EncodeVarint(uint64(len(chunk) + 1)))
+		buffer.WriteByte(chunkFlags)
+		buffer.Write(chunk)
+		message = message[len(chunk):]
+		if len(message) == 0 {
+			return buffer.Bytes(), nil
+		}
+	}
+}
+
+// decode returns the first message in the buffer and consumes its frames. It returns nil if the buffer does not hold
+// all the frames of the message yet.
+func decode(buffer *bytes.Buffer, f *Framing) ([]byte, error) {
+	data := buffer.Bytes()
+	var frames [][]byte
+	size, offset := 0, 0
+	for {
+		length, n := proto.DecodeVarint(data[offset:])
+		if n == 0 {
+			return nil, nil
+		}
+		if length > uint64(f.MaxFrameSize)+1 || size+payloadLength(int(length), f) > f.MaxFrameSize {
+			return nil, receivedTooLargeError(f.MaxFrameSize)
+		}
+		if uint64(len(data)-offset-n) < length {
+			return nil, nil
+		}
+		frame := data[offset+n : offset+n+int(length)]
+		offset += n + int(length)
+		if !f.hasFlags() {
+			message := append([]byte(nil), frame...)
+			buffer.Next(offset)
+			return message, nil
+		}
+		if len(frame) == 0 {
+			return nil, fmt.Errorf("Received a frame without flags")
+		}
+		frames = append(frames, frame)
+		size += len(frame) - 1
+		if frame[0]&moreChunksFlag == 0 {
+			break
+		}
+	}
+	message := make([]byte, 0, size)
+	for _, frame := range frames {
+		message = append(message, frame[1:]...)
+	}
+	buffer.Next(offset)
+	if frames[0][0]&compressedFlag != 0 {
+		return decompress(message, f.MaxFrameSize)
+	}
+	return message, nil
+}
+
+func payloadLength(frameLength int, f *Framing) int {
+	if f.hasFlags() && frameLength > 0 {
+		return frameLength - 1
+	}
+	return frameLength
+}
+
+func receivedTooLargeError(maxFrameSize int) error {
+	return fmt.Errorf("Received a message larger than the maximum frame size of %d bytes. Set max_frame_size in gauge.properties to allow larger messages.", maxFrameSize)
+}
+
+func compress(message []byte) ([]byte, error) {
+	buffer := new(bytes.Buffer)
+	writer := gzip.NewWriter(buffer)
+	if _, err := writer.Write(message); err != nil {
+		return nil, err
+	}
+	if err := writer.Close(); err != nil {
+		return nil, err
+	}
+	return buffer.Bytes(), nil
+}
+
+func decompress(message []byte, maxFrameSize int) ([]byte, error) {
+	reader, err := gzip.NewReader(bytes.NewReader(message))
+	if err != nil {
+		return nil, fmt.Errorf("Failed to decompress message. %s", err.Error())
+	}
+	defer reader.Close()
+	decompressed, err := ioutil.ReadAll(io.LimitReader(reader, int64(maxFrameSize)+1))
+	if err != nil {
+		return nil, fmt.Errorf("Failed to decompress message. %s", err.Error())
+	}
+	if len(decompressed) > maxFrameSize {
+		return nil, receivedTooLargeError(maxFrameSize)
+	}
+	return decompressed, nil
+}
@@ -0,0 +1,190 @@
+// Copyright 2015 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+
+package conn
+
+import (
+	"bytes"
+	"math/rand"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	. "gopkg.in/check.v1"
+)
+
+func Test(t *testing.T) { TestingT(t) }
+
+type MySuite struct{}
+
+var _ = Suite(&MySuite{})
+
+// randomBytes returns bytes which gzip cannot compress
+func randomBytes(size int) []byte {
+	random := rand.New(rand.NewSource(1))
+	message := make([]byte, size)
+	for i := range message {
+		message[i] = byte(random.Intn(256))
+	}
+	return message
+}
+
+func (s *MySuite) TestPlainFramingIsVarintLengthFollowedByMessage(c *C) {
+	framing := &Framing{MaxFrameSize: 1024}
+
+	encoded, err := encode([]byte("hello"), framing)
+
+	c.Assert(err, IsNil)
+	c.Assert(encoded, DeepEquals, append(proto.EncodeVarint(5), []byte("hello")...))
+
+	buffer := bytes.NewBuffer(encoded)
+	decoded, err := decode(buffer, framing)
+	c.Assert(err, IsNil)
+	c.Assert(string(decoded), Equals, "hello")
+	c.Assert(buffer.Len(), Equals, 0)
+}
+
+func (s *MySuite) TestDecodeKeepsFollowingMessagesInTheBuffer(c *C) {
+	framing := &Framing{MaxFrameSize: 1024, Compression: true}
+	first, _ := encode([]byte("first"), framing)
+	second, _ := encode([]byte("second"), framing)
+	buffer := bytes.NewBuffer(append(first, second...))
+
+	decoded, err := decode(buffer, framing)
+	c.Assert(err, IsNil)
+	c.Assert(string(decoded), Equals, "first")
+
+	decoded, err = decode(buffer, framing)
+	c.Assert(err, IsNil)
+	c.Assert(string(decoded), Equals, "second")
+	c.Assert(buffer.Len(), Equals, 0)
+}
+
+func (s *MySuite) TestEncodeRejectsMessagesLargerThanTheMaxFrameSize(c *C) {
+	_, err := encode([]byte("hello"), &Framing{MaxFrameSize: 4})
+
+	c.Assert(err, ErrorMatches, "Message of 5 bytes exceeds the maximum frame size of 4 bytes.*")
+}
+
+func (s *MySuite) TestDecodeRejectsFramesLargerThanTheMaxFrameSize(c *C) {
+	framing := &Framing{MaxFrameSize: 4}
+	// Only the length has been received, the frame is rejected without waiting for it
+	buffer := bytes.NewBuffer(proto.EncodeVarint(5))
+
+	_, err := decode(buffer, framing)
+
+	c.Assert(err, ErrorMatches, "Received a message larger than the maximum frame size of 4 bytes.*")
+}
+
+func (s *MySuite) TestDecodeRejectsChunksAddingUpToMoreThanTheMaxFrameSize(c *C) {
+	encoded, err := encode(randomBytes(2*chunkSize+100), &Framing{MaxFrameSize: 4 * chunkSize, Chunking: true})
+	c.Assert(err, IsNil)
+
+	_, err = decode(bytes.NewBuffer(encoded), &Framing{MaxFrameSize: 2 * chunkSize, Chunking: true})
+
+	c.Assert(err, ErrorMatches, "Received a message larger than the maximum frame size of 131072 bytes.*")
+}
+
+func (s *MySuite) TestMessagesAreCompressedOnlyAboveTheThreshold(c *C) {
+	framing := &Framing{MaxFrameSize: 1024 * 1024, Compression: true}
+	small := bytes.Repeat([]byte("a"), compressionThreshold)
+	large := bytes.Repeat([]byte("a"), compressionThreshold+1)
+
+	encoded, err := encode(small, framing)
+	c.Assert(err, IsNil)
+	c.Assert(len(encoded), Equals, len(proto.EncodeVarint(uint64(len(small)+1)))+1+len(small))
+	c.Assert(encoded[len(encoded)-len(small)-1], Equals, byte(0))
+
+	encoded, err = encode(large, framing)
+	c.Assert(err, IsNil)
+	c.Assert(len(encoded) < len(large), Equals, true)
+	_, n := proto.DecodeVarint(encoded)
+	c.Assert(encoded[n], Equals, compressedFlag)
+
+	decoded, err := decode(bytes.NewBuffer(encoded), framing)
+	c.Assert(err, IsNil)
+	c.Assert(decoded, DeepEquals, large)
+}
+
+func (s *MySuite) TestIncompressibleMessagesAreSentUncompressed(c *C) {
+	framing := &Framing{MaxFrameSize: 1024 * 1024, Compression: true}
+	message := randomBytes(compressionThreshold + 1)
+
+	encoded, err := encode(message, framing)
+	c.Assert(err, IsNil)
+	_, n := proto.DecodeVarint(encoded)
+	c.Assert(encoded[n], Equals, byte(0))
+
+	decoded, err := decode(bytes.NewBuffer(encoded), framing)
+	c.Assert(err, IsNil)
+	c.Assert(decoded, DeepEquals, message)
+}
+
+func (s *MySuite) TestChunksAreReassembledAcrossPartialReads(c *C) {
+	framing := &Framing{MaxFrameSize: 1024 * 1024, Chunking: true}
+	message := randomBytes(2*chunkSize + 100)
+	encoded, err := encode(message, framing)
+	c.Assert(err, IsNil)
+
+	buffer := new(bytes.Buffer)
+	var decoded []byte
+	for len(encoded) > 0 {
+		c.Assert(decoded, IsNil)
+		n := 1000
+		if n > len(encoded) {
+			n = len(encoded)
+		}
+		buffer.Write(encoded[:n])
+		encoded = encoded[n:]
+		decoded, err = decode(buffer, framing)
+		c.Assert(err, IsNil)
+	}
+
+	c.Assert(decoded, DeepEquals, message)
+	c.Assert(buffer.Len(), Equals, 0)
+}
+
+func (s *MySuite) TestCompressedMessagesAreChunked(c *C) {
+	framing := &Framing{MaxFrameSize: 1024 * 1024, Compression: true, Chunking: true}
+	message := append(randomBytes(chunkSize*2), bytes.Repeat([]byte("a"), chunkSize)...)
+
+	encoded, err := encode(message, framing)
+	c.Assert(err, IsNil)
+	_, n := proto.DecodeVarint(encoded)
+	c.Assert(encoded[n], Equals, compressedFlag|moreChunksFlag)
+
+	decoded, err := decode(bytes.NewBuffer(encoded), framing)
+	c.Assert(err, IsNil)
+	c.Assert(decoded, DeepEquals, message)
+}
+
+func (s *MySuite) TestDecompressedSizeIsLimitedToTheMaxFrameSize(c *C) {
+	framing := &Framing{MaxFrameSize: 64 * 1024, Compression: true}
+	bomb, err := compress(make([]byte, 1024*1024))
+	c.Assert(err, IsNil)
+	c.Assert(len(bomb) < framing.MaxFrameSize, Equals, true)
+	frame := append(proto.EncodeVarint(uint64(len(bomb)+1)), compressedFlag)
+
+	_, err = decode(bytes.NewBuffer(append(frame, bomb...)), framing)
+
+	c.Assert(err, ErrorMatches, "Received a message larger than the maximum frame size of 65536 bytes.*")
+}
+
+func (s *MySuite) TestFrameWithoutFlagsIsRejected(c *C) {
+	_, err := decode(bytes.NewBuffer(proto.EncodeVarint(0)), &Framing{MaxFrameSize: 1024, Chunking: true})
+
+	c.Assert(err, ErrorMatches, "Received a frame without flags")
+}
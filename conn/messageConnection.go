@@ -0,0 +1,209 @@
+// Copyright 2015 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+
+package conn
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/getgauge/common"
+	"github.com/getgauge/gauge/gauge_messages"
+	"github.com/getgauge/gauge/logger"
+	"github.com/golang/protobuf/proto"
+)
+
+// MessageConnection owns the reading of a connection and correlates the responses read to the requests sent by their
+// MessageId, so that several requests can be in flight at the same time. A request which times out is cancelled and
+// its response, if it arrives later, is dropped instead of being taken as the response to the next request.
+type MessageConnection struct {
+	lastID     int64
+	connection net.Conn
+	writeMutex sync.Mutex
+	mutex      sync.Mutex
+	pending    map[int64]chan *gauge_messages.Message
+	err        error
+}
+
+// NewMessageConnection starts reading the messages of the connection.
+func NewMessageConnection(connection net.Conn) *MessageConnection {
+	c := &MessageConnection{lastID: common.GetUniqueID(), connection: connection, pending: make(map[int64]chan *gauge_messages.Message)}
+	go c.readMessages()
+	return c
+}
+
+// Send sends a message which has no response.
+func (c *MessageConnection) Send(message *gauge_messages.Message) error {
+	messageID := c.nextID()
+	message.MessageId = &messageID
+	return c.write(message)
+}
+
+// GetResponse sends the message and waits for its response.
+func (c *MessageConnection) GetResponse(message *gauge_messages.Message) (*gauge_messages.Message, error) {
+	return c.getResponse(message, nil)
+}
+
+// GetResponseWithTimeout sends the message and waits for its response, cancelling the request if there is no
+// response within the timeout.
+func (c *MessageConnection) GetResponseWithTimeout(message *gauge_messages.Message, t time.Duration) (*gauge_messages.Message, error) {
+	timer := time.NewTimer(t)
+	defer timer.Stop()
+	return c.getResponse(message, timer.C)
+}
+
+// GetResponseUntil sends the message and waits for its response, cancelling the request once the timeout channel
+// delivers or is closed. Closing the channel times out all the requests waiting on it.
+func (c *MessageConnection) GetResponseUntil(message *gauge_messages.Message, timeout <-chan time.Time) (*gauge_messages.Message, error) {
+	return c.getResponse(message, timeout)
+}
+
+// SendProcessKillMessage sends a KillProcessRequest message through the connection.
+func (c *MessageConnection) SendProcessKillMessage() error {
+	return c.Send(processKillMessage())
+}
+
+// Close closes the connection, failing the requests waiting for a response.
+func (c *MessageConnection) Close() error {
+	return c.connection.Close()
+}
+
+func (c *MessageConnection) getResponse(message *gauge_messages.Message, timeout <-chan time.Time) (*gauge_messages.Message, error) {
+	messageID := c.nextID()
+	message.MessageId = &messageID
+	responseChan, err := c.addPending(messageID)
+	if err != nil {
+		return nil, err
+	}
+	if err := c.write(message); err != nil {
+		c.removePending(messageID)
+		return nil, err
+	}
+	select {
+	case response, ok := <-responseChan:
+		if !ok {
+			return nil, c.closedError()
+		}
+		if err := checkUnsupportedResponseMessage(response); err != nil {
+			return response, err
+		}
+		return response, nil
+	case <-timeout:
+		c.removePending(messageID)
+		return nil, errors.New("Request Timeout")
+	}
+}
+
+// nextID returns an id unique on the connection, which ids based on the time are not for concurrent requests.
+func (c *MessageConnection) nextID() int64 {
+	return atomic.AddInt64(&c.lastID, 1)
+}
+
+func (c *MessageConnection) write(message *gauge_messages.Message) error {
+	data, err := proto.Marshal(message)
+	if err != nil {
+		return err
+	}
+	c.writeMutex.Lock()
+	defer c.writeMutex.Unlock()
+	return Write(c.connection, data)
+}
+
+func (c *MessageConnection) addPending(messageID int64) (chan *gauge_messages.Message, error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	if c.err != nil {
+		return nil, c.err
+	}
+	responseChan := make(chan *gauge_messages.Message, 1)
+	c.pending[messageID] = responseChan
+	return responseChan, nil
+}
+
+func (c *MessageConnection) removePending(messageID int64) chan *gauge_messages.Message {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	responseChan := c.pending[messageID]
+	delete(c.pending, messageID)
+	return responseChan
+}
+
+func (c *MessageConnection) closedError() error {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	return c.err
+}
+
+func (c *MessageConnection) readMessages() {
+	buffer := new(bytes.Buffer)
+	data := make([]byte, 8192)
+	for {
+		n, err := c.connection.Read(data)
+		if err != nil {
+			c.fail(fmt.Errorf("Connection closed [%s] cause: %s", c.connection.RemoteAddr(), err.Error()))
+			return
+		}
+		buffer.Write(data[0:n])
+		for {
+			messageBytes, err := decode(buffer, framingOf(c.connection))
+			if err != nil {
+				c.fail(fmt.Errorf("Connection closed [%s] cause: %s", c.connection.RemoteAddr(), err.Error()))
+				return
+			}
+			if messageBytes == nil {
+				break
+			}
+			if err := c.dispatch(messageBytes); err != nil {
+				c.fail(fmt.Errorf("Connection closed [%s] cause: %s", c.connection.RemoteAddr(), err.Error()))
+				return
+			}
+		}
+	}
+}
+
+// dispatch hands a response to the request waiting for it. A message which cannot be read is an error, as the request
+// it answers cannot be told.
+func (c *MessageConnection) dispatch(messageBytes []byte) error {
+	message := &gauge_messages.Message{}
+	if err := proto.Unmarshal(messageBytes, message); err != nil {
+		return fmt.Errorf("Failed to read message. %s", err.Error())
+	}
+	responseChan := c.removePending(message.GetMessageId())
+	if responseChan == nil {
+		logger.Debug("Dropping response to message %d, the request was cancelled or timed out", message.GetMessageId())
+		return nil
+	}
+	responseChan <- message
+	return nil
+}
+
+// fail closes the connection and fails the requests waiting for a response and the requests made from now on.
+func (c *MessageConnection) fail(err error) {
+	c.connection.Close()
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.err = err
+	for messageID, responseChan := range c.pending {
+		close(responseChan)
+		delete(c.pending, messageID)
+	}
+}
@@ -0,0 +1,167 @@
+// Copyright 2015 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+
+package conn
+
+import (
+	"bytes"
+	"net"
+	"time"
+
+	"github.com/getgauge/gauge/gauge_messages"
+	"github.com/golang/protobuf/proto"
+	. "gopkg.in/check.v1"
+)
+
+// fakeRunner is the other end of a MessageConnection, answering its requests as a runner would
+type fakeRunner struct {
+	connection net.Conn
+	buffer     *bytes.Buffer
+}
+
+func newConnectionToFakeRunner() (*MessageConnection, *fakeRunner) {
+	gaugeEnd, runnerEnd := net.Pipe()
+	return NewMessageConnection(gaugeEnd), &fakeRunner{connection: runnerEnd, buffer: new(bytes.Buffer)}
+}
+
+func (r *fakeRunner) readRequest(c *C) *gauge_messages.Message {
+	data := make([]byte, 8192)
+	for {
+		messageBytes, err := decode(r.buffer, defaultFraming)
+		c.Assert(err, IsNil)
+		if messageBytes != nil {
+			message := &gauge_messages.Message{}
+			c.Assert(proto.Unmarshal(messageBytes, message), IsNil)
+			return message
+		}
+		n, err := r.connection.Read(data)
+		c.Assert(err, IsNil)
+		r.buffer.Write(data[:n])
+	}
+}
+
+// respond answers a step name request with the step value of the request as the step name
+func (r *fakeRunner) respond(c *C, request *gauge_messages.Message) {
+	response := &gauge_messages.Message{
+		MessageType: gauge_messages.Message_StepNameResponse.Enum(),
+		MessageId:   request.MessageId,
+		StepNameResponse: &gauge_messages.StepNameResponse{
+			IsStepPresent: proto.Bool(true),
+			HasAlias:      proto.Bool(false),
+			StepName:      []string{request.GetStepNameRequest().GetStepValue()},
+		},
+	}
+	data, err := proto.Marshal(response)
+	c.Assert(err, IsNil)
+	c.Assert(Write(r.connection, data), IsNil)
+}
+
+func stepNameRequest(stepValue string) *gauge_messages.Message {
+	return &gauge_messages.Message{
+		MessageType:     gauge_messages.Message_StepNameRequest.Enum(),
+		StepNameRequest: &gauge_messages.StepNameRequest{StepValue: proto.String(stepValue)},
+	}
+}
+
+type result struct {
+	response *gauge_messages.Message
+	err      error
+}
+
+func getResponseAsync(connection *MessageConnection, request *gauge_messages.Message) chan result {
+	results := make(chan result, 1)
+	go func() {
+		response, err := connection.GetResponse(request)
+		results <- result{response, err}
+	}()
+	return results
+}
+
+func (s *MySuite) TestConcurrentRequestsGetTheirOwnResponses(c *C) {
+	connection, runner := newConnectionToFakeRunner()
+	defer connection.Close()
+
+	first := getResponseAsync(connection, stepNameRequest("first step"))
+	second := getResponseAsync(connection, stepNameRequest("second step"))
+	requests := []*gauge_messages.Message{runner.readRequest(c), runner.readRequest(c)}
+	c.Assert(requests[0].GetMessageId(), Not(Equals), requests[1].GetMessageId())
+	// Responses are sent in the reverse order of the requests
+	runner.respond(c, requests[1])
+	runner.respond(c, requests[0])
+
+	for stepValue, results := range map[string]chan result{"first step": first, "second step": second} {
+		r := <-results
+		c.Assert(r.err, IsNil)
+		c.Assert(r.response.GetStepNameResponse().GetStepName(), DeepEquals, []string{stepValue})
+	}
+}
+
+func (s *MySuite) TestLateResponseOfTimedOutRequestIsDropped(c *C) {
+	connection, runner := newConnectionToFakeRunner()
+	defer connection.Close()
+
+	timedOut := make(chan result, 1)
+	go func() {
+		response, err := connection.GetResponseWithTimeout(stepNameRequest("slow step"), 10*time.Millisecond)
+		timedOut <- result{response, err}
+	}()
+	slowRequest := runner.readRequest(c)
+	r := <-timedOut
+	c.Assert(r.err, ErrorMatches, "Request Timeout")
+
+	next := getResponseAsync(connection, stepNameRequest("next step"))
+	nextRequest := runner.readRequest(c)
+	runner.respond(c, slowRequest)
+	runner.respond(c, nextRequest)
+
+	r = <-next
+	c.Assert(r.err, IsNil)
+	c.Assert(r.response.GetMessageId(), Equals, nextRequest.GetMessageId())
+	c.Assert(r.response.GetStepNameResponse().GetStepName(), DeepEquals, []string{"next step"})
+}
+
+func (s *MySuite) TestUnreadableResponseFailsTheConnection(c *C) {
+	connection, runner := newConnectionToFakeRunner()
+	defer connection.Close()
+
+	pending := getResponseAsync(connection, stepNameRequest("first step"))
+	runner.readRequest(c)
+	c.Assert(Write(runner.connection, []byte("not a message")), IsNil)
+
+	r := <-pending
+	c.Assert(r.err, ErrorMatches, "Connection closed .* cause: Failed to read message.*")
+	_, err := connection.GetResponse(stepNameRequest("second step"))
+	c.Assert(err, NotNil)
+}
+
+func (s *MySuite) TestClosedConnectionFailsPendingAndLaterRequests(c *C) {
+	connection, runner := newConnectionToFakeRunner()
+
+	first := getResponseAsync(connection, stepNameRequest("first step"))
+	second := getResponseAsync(connection, stepNameRequest("second step"))
+	runner.readRequest(c)
+	runner.readRequest(c)
+	runner.connection.Close()
+
+	for _, results := range []chan result{first, second} {
+		r := <-results
+		c.Assert(r.response, IsNil)
+		c.Assert(r.err, ErrorMatches, "Connection closed .*")
+	}
+	_, err := connection.GetResponse(stepNameRequest("third step"))
+	c.Assert(err, ErrorMatches, "Connection closed .*")
+}
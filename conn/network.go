@@ -18,8 +18,6 @@
 package conn
 
 import (
-	"bytes"
-	"errors"
 	"fmt"
 	"github.com/getgauge/common"
 	"github.com/getgauge/gauge/gauge_messages"
@@ -27,40 +25,14 @@ import (
 	"net"
 	"os"
 	"strconv"
-	"time"
 )
 
-func WriteDataAndGetResponse(conn net.Conn, messageBytes []byte) ([]byte, error) {
-	if err := Write(conn, messageBytes); err != nil {
-		return nil, err
-	}
-
-	return readResponse(conn)
-}
-
-func readResponse(conn net.Conn) ([]byte, error) {
-	buffer := new(bytes.Buffer)
-	data := make([]byte, 8192)
-	for {
-		n, err := conn.Read(data)
-		if err != nil {
-			conn.Close()
-			return nil, fmt.Errorf("Connection closed [%s] cause: %s", conn.RemoteAddr(), err.Error())
-		}
-
-		buffer.Write(data[0:n])
-
-		messageLength, bytesRead := proto.DecodeVarint(buffer.Bytes())
-		if messageLength > 0 && messageLength < uint64(buffer.Len()) {
-			return buffer.Bytes()[bytesRead : messageLength+uint64(bytesRead)], nil
-		}
-	}
-}
-
 func Write(conn net.Conn, messageBytes []byte) error {
-	messageLen := proto.EncodeVarint(uint64(len(messageBytes)))
-	data := append(messageLen, messageBytes...)
-	_, err := conn.Write(data)
+	data, err := encode(messageBytes, framingOf(conn))
+	if err != nil {
+		return err
+	}
+	_, err = conn.Write(data)
 	return err
 }
 
@@ -75,29 +47,6 @@ func WriteGaugeMessage(message *gauge_messages.Message, conn net.Conn) error {
 	return Write(conn, data)
 }
 
-func GetResponseForGaugeMessage(message *gauge_messages.Message, conn net.Conn) (*gauge_messages.Message, error) {
-	messageId := common.GetUniqueID()
-	message.MessageId = &messageId
-
-	data, err := proto.Marshal(message)
-	if err != nil {
-		return nil, err
-	}
-	responseBytes, err := WriteDataAndGetResponse(conn, data)
-	if err != nil {
-		return nil, err
-	}
-	responseMessage := &gauge_messages.Message{}
-	if err := proto.Unmarshal(responseBytes, responseMessage); err != nil {
-		return nil, err
-	}
-
-	if err := checkUnsupportedResponseMessage(responseMessage); err != nil {
-		return responseMessage, err
-	}
-	return responseMessage, err
-}
-
 func checkUnsupportedResponseMessage(message *gauge_messages.Message) error {
 	if message.GetMessageType() == gauge_messages.Message_UnsupportedMessageResponse {
 		return fmt.Errorf("Unsupported Message response received. Message not supported. %s", message.GetUnsupportedMessageResponse().GetMessage())
@@ -105,32 +54,6 @@ func checkUnsupportedResponseMessage(message *gauge_messages.Message) error {
 	return nil
 }
 
-func GetResponseForMessageWithTimeout(message *gauge_messages.Message, conn net.Conn, t time.Duration) (*gauge_messages.Message, error) {
-	responseChan := make(chan bool, 1)
-	errChan := make(chan bool, 1)
-
-	var response *gauge_messages.Message
-	var err error
-	go func() {
-		response, err = GetResponseForGaugeMessage(message, conn)
-		if err != nil {
-			errChan <- true
-			close(errChan)
-		} else {
-			responseChan <- true
-			close(responseChan)
-		}
-	}()
-	select {
-	case <-errChan:
-		return nil, err
-	case <-responseChan:
-		return response, nil
-	case <-time.After(t):
-		return nil, errors.New("Request Timeout")
-	}
-}
-
 func GetPortFromEnvironmentVariable(portEnvVariable string) (int, error) {
 	if port := os.Getenv(portEnvVariable); port != "" {
 		gport, err := strconv.Atoi(port)
@@ -144,9 +67,10 @@ func GetPortFromEnvironmentVariable(portEnvVariable string) (int, error) {
 
 // SendProcessKillMessage sends a KillProcessRequest message through the connection.
 func SendProcessKillMessage(connection net.Conn) {
-	id := common.GetUniqueID()
-	message := &gauge_messages.Message{MessageId: &id, MessageType: gauge_messages.Message_KillProcessRequest.Enum(),
-		KillProcessRequest: &gauge_messages.KillProcessRequest{}}
+	WriteGaugeMessage(processKillMessage(), connection)
+}
 
-	WriteGaugeMessage(message, connection)
+func processKillMessage() *gauge_messages.Message {
+	return &gauge_messages.Message{MessageType: gauge_messages.Message_KillProcessRequest.Enum(),
+		KillProcessRequest: &gauge_messages.KillProcessRequest{}}
 }
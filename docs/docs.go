@@ -0,0 +1,120 @@
+// Copyright 2015 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+
+// Package docs exports the specs of a project as living documentation, a static HTML site and optionally Markdown
+// files, with an index of the tags and a glossary of the concepts.
+package docs
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/getgauge/common"
+	"github.com/getgauge/gauge/config"
+	"github.com/getgauge/gauge/execution/result"
+	"github.com/getgauge/gauge/gauge"
+	"github.com/getgauge/gauge/logger"
+	"github.com/getgauge/gauge/parser"
+	"github.com/getgauge/gauge/util"
+)
+
+// Markdown also writes the documentation as Markdown files next to the HTML site
+var Markdown bool
+
+// WithLastRun shows the status of the specs and scenarios in the last execution of the project
+var WithLastRun bool
+
+const specsDir = "specs"
+
+// Export writes the documentation of the specs in the given files and directories to outDir, the specs directory
+// of the project being used when none are given.
+func Export(outDir string, args []string) {
+	conceptDictionary, conceptParseResult := parser.CreateConceptsDictionary(false)
+	parser.HandleParseResult(conceptParseResult)
+	if len(args) == 0 {
+		args = []string{filepath.Join(config.ProjectRoot, common.SpecsDirectoryName)}
+	}
+	specFiles := make([]string, 0)
+	for _, arg := range args {
+		specFiles = append(specFiles, util.GetSpecFiles(arg)...)
+	}
+	specs, parseResults := parser.ParseSpecFiles(specFiles, conceptDictionary)
+	parser.HandleParseResult(parseResults...)
+
+	var lastRun *runStatus
+	if WithLastRun {
+		suiteResult, err := result.LastRunResult()
+		if err != nil {
+			logger.Warning("No result of a previous run found, the docs will not show the status of specs. %s", err.Error())
+		} else {
+			lastRun = newRunStatus(suiteResult)
+		}
+	}
+
+	s := newSite(filepath.Base(config.ProjectRoot), specs, conceptDictionary, lastRun)
+	if err := writeFiles(outDir, renderHTML(s)); err != nil {
+		logger.Fatalf("Failed to write the docs to %s. %s", outDir, err.Error())
+	}
+	if Markdown {
+		if err := writeFiles(outDir, renderMarkdown(s)); err != nil {
+			logger.Fatalf("Failed to write the docs to %s. %s", outDir, err.Error())
+		}
+	}
+	logger.Info("Docs of %d specs written to %s.", len(s.Specs), outDir)
+}
+
+// writeFiles writes the contents keyed by their path relative to dir
+func writeFiles(dir string, files map[string]string) error {
+	names := make([]string, 0, len(files))
+	for name := range files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		file := filepath.Join(dir, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(file), common.NewDirectoryPermissions); err != nil {
+			return err
+		}
+		if err := ioutil.WriteFile(file, []byte(files[name]), common.NewFilePermissions); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// sortedSpecs sorts the specs by their file so that the docs are the same for the same specs
+func sortedSpecs(specs []*gauge.Specification) []*gauge.Specification {
+	sorted := append([]*gauge.Specification{}, specs...)
+	sort.Sort(byFileName(sorted))
+	return sorted
+}
+
+type byFileName []*gauge.Specification
+
+func (s byFileName) Len() int {
+	return len(s)
+}
+
+func (s byFileName) Swap(i, j int) {
+	s[i], s[j] = s[j], s[i]
+}
+
+func (s byFileName) Less(i, j int) bool {
+	return s[i].FileName < s[j].FileName
+}
@@ -0,0 +1,251 @@
+// Copyright 2015 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+
+package docs
+
+import (
+	"io/ioutil"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/getgauge/gauge/config"
+	"github.com/getgauge/gauge/execution/result"
+	"github.com/getgauge/gauge/gauge"
+	"github.com/getgauge/gauge/gauge_messages"
+	"github.com/getgauge/gauge/parser"
+	"github.com/golang/protobuf/proto"
+	. "gopkg.in/check.v1"
+)
+
+func Test(t *testing.T) { TestingT(t) }
+
+type MySuite struct{}
+
+var _ = Suite(&MySuite{})
+
+const loginConcept = `# login as <user>
+* open the login page
+* type <user>
+
+# checkout as <user>
+* login as <user>
+* pay
+`
+
+const shoppingSpec = `Shopping
+========
+tags: shop, smoke
+
+Buying what is in the cart.
+
+Pay with a card
+---------------
+tags: payment
+* checkout as "bob"
+* check the total
+     |item |price|
+     |-----|-----|
+     |apple|10   |
+
+Pay later
+---------
+* login as "alice"
+`
+
+func newTestSite(c *C, lastRun *runStatus) *site {
+	conceptDictionary := gauge.NewConceptDictionary()
+	c.Assert(parser.AddConceptsFromText(loginConcept, "specs/login.cpt", conceptDictionary), IsNil)
+	spec, result := new(parser.SpecParser).Parse(shoppingSpec, conceptDictionary)
+	c.Assert(result.Ok, Equals, true)
+	spec.FileName = "specs/shopping.spec"
+	other, result := new(parser.SpecParser).Parse("Search\n======\ntags: smoke\nFind\n----\n* search for \"apple\"\n", conceptDictionary)
+	c.Assert(result.Ok, Equals, true)
+	other.FileName = "specs/search.spec"
+	return newSite("shop", []*gauge.Specification{spec, other}, conceptDictionary, lastRun)
+}
+
+func (s *MySuite) TestSpecsAreSortedByFile(c *C) {
+	site := newTestSite(c, nil)
+
+	c.Assert(len(site.Specs), Equals, 2)
+	c.Assert(site.Specs[0].Name, Equals, "specs-search")
+	c.Assert(site.Specs[1].Name, Equals, "specs-shopping")
+	c.Assert(site.Specs[1].Heading, Equals, "Shopping")
+	c.Assert(site.Specs[1].Blocks[0].Text, Equals, "Buying what is in the cart.")
+}
+
+func (s *MySuite) TestScenariosKeepTheirStepsAndTables(c *C) {
+	spec := newTestSite(c, nil).Specs[1]
+
+	c.Assert(len(spec.Scenarios), Equals, 2)
+	scenario := spec.Scenarios[0]
+	c.Assert(scenario.Heading, Equals, "Pay with a card")
+	c.Assert(scenario.Anchor, Equals, "scenario-pay-with-a-card")
+	c.Assert(scenario.Tags, DeepEquals, []string{"payment"})
+	c.Assert(len(scenario.Blocks), Equals, 1)
+	steps := scenario.Blocks[0].Steps
+	c.Assert(len(steps), Equals, 2)
+	c.Assert(steps[0].Parts, DeepEquals, []stepPart{{Text: "checkout as "}, {Text: "\"bob\"", Param: true}})
+	c.Assert(steps[1].Table.Headers, DeepEquals, []string{"item", "price"})
+	c.Assert(steps[1].Table.Rows, DeepEquals, [][]string{{"apple", "10"}})
+}
+
+func (s *MySuite) TestTagIndexListsSpecsAndScenarios(c *C) {
+	site := newTestSite(c, nil)
+
+	c.Assert(len(site.Tags), Equals, 3)
+	c.Assert(site.Tags[0].Name, Equals, "payment")
+	c.Assert(site.Tags[0].Usages[0].Scenario.Heading, Equals, "Pay with a card")
+	c.Assert(site.Tags[2].Name, Equals, "smoke")
+	c.Assert(site.Tags[2].Anchor, Equals, "tag-smoke")
+	c.Assert(len(site.Tags[2].Usages), Equals, 2)
+	c.Assert(site.Tags[2].Usages[0].Scenario, IsNil)
+}
+
+func (s *MySuite) TestConceptGlossaryHasExpansionsAndUsages(c *C) {
+	site := newTestSite(c, nil)
+
+	c.Assert(len(site.Concepts), Equals, 2)
+	checkout, login := site.Concepts[0], site.Concepts[1]
+	c.Assert(checkout.Heading, Equals, "checkout as <user>")
+	c.Assert(checkout.Anchor, Equals, "concept-checkout-as-user")
+	c.Assert(checkout.FileName, Equals, "specs/login.cpt")
+	c.Assert(checkout.Steps[0].Concept, Equals, login)
+	c.Assert(len(checkout.Steps[0].Steps), Equals, 2)
+	c.Assert(login.UsedBy, DeepEquals, []*conceptEntry{checkout})
+	c.Assert(len(login.Usages), Equals, 1)
+	c.Assert(login.Usages[0].Scenario.Heading, Equals, "Pay later")
+}
+
+func (s *MySuite) TestConceptStepsInSpecsLinkToTheGlossary(c *C) {
+	site := newTestSite(c, nil)
+	step := site.Specs[1].Scenarios[0].Blocks[0].Steps[0]
+
+	c.Assert(step.Concept, Equals, site.Concepts[0])
+	c.Assert(len(step.Steps), Equals, 2)
+	c.Assert(step.Steps[0].Concept, Equals, site.Concepts[1])
+
+	html := renderHTML(site)["specs/specs-shopping.html"]
+	c.Assert(strings.Contains(html, `href="../concepts.html#concept-checkout-as-user"`), Equals, true)
+	c.Assert(strings.Contains(html, `href="../tags.html#tag-payment"`), Equals, true)
+	c.Assert(strings.Contains(html, `<section id="scenario-pay-later">`), Equals, true)
+}
+
+func (s *MySuite) TestStatusOfTheLastRun(c *C) {
+	scenario := func(heading string, failed bool) *gauge_messages.ProtoScenario {
+		return &gauge_messages.ProtoScenario{ScenarioHeading: proto.String(heading), Failed: proto.Bool(failed)}
+	}
+	suiteResult := &gauge_messages.ProtoSuiteResult{
+		Timestamp: proto.String("Oct 18, 2026 at 10:00am"),
+		SpecResults: []*gauge_messages.ProtoSpecResult{{
+			Failed: proto.Bool(true),
+			ProtoSpec: &gauge_messages.ProtoSpec{
+				FileName: proto.String("specs/shopping.spec"),
+				Items: []*gauge_messages.ProtoItem{
+					{ItemType: gauge_messages.ProtoItem_Scenario.Enum(), Scenario: scenario("Pay later", false)},
+					{ItemType: gauge_messages.ProtoItem_TableDrivenScenario.Enum(), TableDrivenScenario: &gauge_messages.ProtoTableDrivenScenario{
+						Scenarios: []*gauge_messages.ProtoScenario{scenario("Pay with a card", false), scenario("Pay with a card", true)},
+					}},
+				},
+			},
+		}},
+	}
+
+	site := newTestSite(c, newRunStatus(suiteResult))
+
+	c.Assert(site.LastRun.Failed, Equals, 1)
+	c.Assert(site.Specs[0].Status, Equals, "")
+	c.Assert(site.Specs[1].Status, Equals, failed)
+	c.Assert(site.Specs[1].Scenarios[0].Status, Equals, failed)
+	c.Assert(site.Specs[1].Scenarios[1].Status, Equals, passed)
+	c.Assert(strings.Contains(renderHTML(site)["index.html"], `<span class="status failed">failed</span>`), Equals, true)
+}
+
+func (s *MySuite) TestPartialLastRun(c *C) {
+	suiteResult := &gauge_messages.ProtoSuiteResult{
+		Timestamp:    proto.String("Oct 18, 2026 at 10:00am"),
+		Tags:         proto.String("payment"),
+		IsPartialRun: proto.Bool(true),
+	}
+
+	site := newTestSite(c, newRunStatus(suiteResult))
+
+	c.Assert(strings.Contains(renderHTML(site)["index.html"], "0 skipped. Only some of the specs or scenarios were run for the tags payment.</p>"), Equals, true)
+	c.Assert(strings.Contains(renderMarkdown(site)["index.md"], "0 skipped. Only some of the specs or scenarios were run for the tags payment.\n"), Equals, true)
+}
+
+func (s *MySuite) TestSavedLastRunKeepsOnlyTheStatuses(c *C) {
+	dir, err := ioutil.TempDir("", "gaugeLastRun")
+	c.Assert(err, IsNil)
+	defer os.RemoveAll(dir)
+	oldRoot := config.ProjectRoot
+	config.ProjectRoot = dir
+	defer func() { config.ProjectRoot = oldRoot }()
+	failure := &gauge_messages.ProtoExecutionResult{Failed: proto.Bool(true), ExecutionTime: proto.Int64(1), ScreenShot: []byte("screenshot"), Message: []string{"message"}}
+	step := &gauge_messages.ProtoItem{ItemType: gauge_messages.ProtoItem_Step.Enum(), Step: &gauge_messages.ProtoStep{
+		ActualText: proto.String("step"), ParsedText: proto.String("step"),
+		StepExecutionResult: &gauge_messages.ProtoStepExecutionResult{ExecutionResult: failure, Skipped: proto.Bool(false)},
+	}}
+	scenario := &gauge_messages.ProtoScenario{ScenarioHeading: proto.String("Scenario"), Failed: proto.Bool(true), Skipped: proto.Bool(false), ScenarioItems: []*gauge_messages.ProtoItem{step}, Stdout: proto.String("output")}
+	suiteResult := &gauge_messages.ProtoSuiteResult{
+		Failed: proto.Bool(true), SpecsFailedCount: proto.Int32(1), SuccessRate: proto.Float32(0), ProjectName: proto.String("project"),
+		Timestamp: proto.String("Oct 18, 2026 at 10:00am"), SpecsSkippedCount: proto.Int32(0), IsPartialRun: proto.Bool(true),
+		SpecResults: []*gauge_messages.ProtoSpecResult{{
+			ProtoSpec: &gauge_messages.ProtoSpec{
+				SpecHeading: proto.String("Spec"), IsTableDriven: proto.Bool(false), FileName: proto.String("specs/a.spec"), Stdout: proto.String("output"),
+				Items: []*gauge_messages.ProtoItem{step, {ItemType: gauge_messages.ProtoItem_Scenario.Enum(), Scenario: scenario}},
+			},
+			ScenarioCount: proto.Int32(1), ScenarioFailedCount: proto.Int32(1), Failed: proto.Bool(true), Skipped: proto.Bool(false), ScenarioSkippedCount: proto.Int32(0),
+		}},
+		PreHookFailure: &gauge_messages.ProtoHookFailure{StackTrace: proto.String("trace"), ErrorMessage: proto.String("error"), ScreenShot: []byte("screenshot")},
+	}
+
+	c.Assert(result.SaveLastRunResult(suiteResult), IsNil)
+	lastRun, err := result.LastRunResult()
+
+	c.Assert(err, IsNil)
+	c.Assert(lastRun.GetIsPartialRun(), Equals, true)
+	c.Assert(lastRun.PreHookFailure, IsNil)
+	spec := lastRun.GetSpecResults()[0].GetProtoSpec()
+	c.Assert(spec.GetFileName(), Equals, "specs/a.spec")
+	c.Assert(spec.Stdout, IsNil)
+	c.Assert(len(spec.GetItems()), Equals, 1)
+	c.Assert(spec.GetItems()[0].GetScenario().GetScenarioHeading(), Equals, "Scenario")
+	c.Assert(spec.GetItems()[0].GetScenario().GetFailed(), Equals, true)
+	c.Assert(spec.GetItems()[0].GetScenario().ScenarioItems, IsNil)
+	c.Assert(spec.GetItems()[0].GetScenario().Stdout, IsNil)
+	c.Assert(newRunStatus(lastRun).spec("specs/a.spec").scenarios["Scenario"], Equals, failed)
+}
+
+func (s *MySuite) TestMarkdownExport(c *C) {
+	files := renderMarkdown(newTestSite(c, nil))
+
+	c.Assert(strings.Contains(files["concepts.md"], "## <a id=\"concept-login-as-user\"></a>login as \\<user\\>"), Equals, true)
+	spec := files["specs/specs-shopping.md"]
+	c.Assert(strings.Contains(spec, "* checkout as `\"bob\"` ([concept](../concepts.md#concept-checkout-as-user))\n  * login as `<user>`"), Equals, true)
+	c.Assert(strings.Contains(spec, "| item | price |\n  | --- | --- |\n  | apple | 10 |"), Equals, true)
+	c.Assert(strings.Contains(files["tags.md"], "* [Shopping / Pay with a card](specs/specs-shopping.md#scenario-pay-with-a-card)"), Equals, true)
+}
+
+func (s *MySuite) TestUniqueSlugs(c *C) {
+	taken := make(map[string]bool)
+
+	c.Assert(uniqueName(slug("Pay with a card!"), taken), Equals, "pay-with-a-card")
+	c.Assert(uniqueName(slug("Pay with a card?"), taken), Equals, "pay-with-a-card-2")
+	c.Assert(slug("***"), Equals, "item")
+}
@@ -0,0 +1,189 @@
+// Copyright 2015 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+
+package docs
+
+import (
+	"bytes"
+	"html/template"
+)
+
+// htmlPage is what a page of the site is rendered from. Root is the path from the page to the root of the site.
+type htmlPage struct {
+	Site  *site
+	Root  string
+	Title string
+	Spec  *specPage
+}
+
+var htmlTemplates = template.Must(template.New("docs").Funcs(htmlFuncs).Parse(`
+{{define "header"}}<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>{{.Title}} - {{.Site.Title}}</title>
+<link rel="stylesheet" href="{{.Root}}style.css">
+</head>
+<body>
+<nav><a href="{{.Root}}index.html">Specifications</a> <a href="{{.Root}}tags.html">Tags</a> <a href="{{.Root}}concepts.html">Concepts</a></nav>
+<main>
+{{end}}
+
+{{define "footer"}}</main>
+</body>
+</html>
+{{end}}
+
+{{define "status"}}{{if .}}<span class="status {{.}}">{{.}}</span>{{end}}{{end}}
+
+{{define "tags"}}{{if .Tags}}<p class="tags">{{range .Tags}}<a class="tag" href="{{$.Root}}tags.html#tag-{{slug .}}">{{.}}</a> {{end}}</p>{{end}}{{end}}
+
+{{define "table"}}<table>
+<tr>{{range .Headers}}<th>{{.}}</th>{{end}}</tr>
+{{range .Rows}}<tr>{{range .}}<td>{{.}}</td>{{end}}</tr>
+{{end}}</table>
+{{end}}
+
+{{define "step"}}<li class="step">{{range .Step.Parts}}{{if .Param}}<span class="param">{{.Text}}</span>{{else}}{{.Text}}{{end}}{{end}}{{with .Step.Concept}} <a class="concept" href="{{$.Root}}concepts.html#{{.Anchor}}">concept</a>{{end}}
+{{with .Step.Table}}{{template "table" .}}{{end}}{{if .Step.Steps}}<details><summary>Steps</summary><ul>
+{{range .Step.Steps}}{{template "step" (step $.Root .)}}{{end}}</ul></details>
+{{end}}</li>
+{{end}}
+
+{{define "steps"}}<ul>
+{{range .Steps}}{{template "step" (step $.Root .)}}{{end}}</ul>
+{{end}}
+
+{{define "blocks"}}{{$root := .Root}}{{range .Blocks}}{{if .Text}}<p>{{.Text}}</p>
+{{end}}{{with .Table}}{{template "table" .}}{{end}}{{with .Steps}}{{template "steps" (steps $root .)}}{{end}}{{end}}{{end}}
+
+{{define "usages"}}<ul>{{range .Usages}}<li><a href="{{$.Root}}specs/{{.Spec.Name}}.html{{with .Scenario}}#{{.Anchor}}{{end}}">{{.Spec.Heading}}{{with .Scenario}} / {{.Heading}}{{end}}</a></li>
+{{end}}</ul>
+{{end}}
+
+{{define "index"}}{{template "header" .}}<h1>{{.Site.Title}}</h1>
+{{with .Site.LastRun}}<p class="last-run">Last run {{.Timestamp}}{{if .Environment}} in {{.Environment}}{{end}}: {{.Passed}} passed, {{.Failed}} failed, {{.Skipped}} skipped.{{if .Partial}} Only some of the specs or scenarios were run{{if .Tags}} for the tags {{.Tags}}{{end}}.{{end}}</p>
+{{end}}<table>
+<tr><th>Specification</th><th>File</th><th>Scenarios</th><th>Tags</th>{{if .Site.LastRun}}<th>Status</th>{{end}}</tr>
+{{range .Site.Specs}}<tr><td><a href="specs/{{.Name}}.html">{{.Heading}}</a></td><td>{{.FileName}}</td><td>{{len .Scenarios}}</td><td>{{range .Tags}}<a class="tag" href="tags.html#tag-{{slug .}}">{{.}}</a> {{end}}</td>{{if $.Site.LastRun}}<td>{{template "status" .Status}}</td>{{end}}</tr>
+{{end}}</table>
+{{template "footer" .}}{{end}}
+
+{{define "spec"}}{{template "header" .}}{{with .Spec}}<h1>{{.Heading}} {{template "status" .Status}}</h1>
+<p class="file">{{.FileName}}</p>
+{{template "tags" (tags $.Root .Tags)}}
+{{template "blocks" (blocks $.Root .Blocks)}}
+{{if .Scenarios}}<ul class="toc">{{range .Scenarios}}<li><a href="#{{.Anchor}}">{{.Heading}}</a></li>{{end}}</ul>{{end}}
+{{range .Scenarios}}<section id="{{.Anchor}}">
+<h2>{{.Heading}} {{template "status" .Status}}</h2>
+{{template "tags" (tags $.Root .Tags)}}
+{{template "blocks" (blocks $.Root .Blocks)}}
+</section>
+{{end}}{{if .TearDown}}<section class="teardown">
+<h2>Teardown</h2>
+{{template "blocks" (blocks $.Root .TearDown)}}
+</section>
+{{end}}{{end}}{{template "footer" .}}{{end}}
+
+{{define "tagIndex"}}{{template "header" .}}<h1>Tags</h1>
+{{range .Site.Tags}}<section id="{{.Anchor}}">
+<h2>{{.Name}}</h2>
+{{template "usages" (usages $.Root .Usages)}}</section>
+{{end}}{{template "footer" .}}{{end}}
+
+{{define "glossary"}}{{template "header" .}}<h1>Concepts</h1>
+{{range .Site.Concepts}}<section id="{{.Anchor}}">
+<h2>{{.Heading}}</h2>
+<p class="file">{{.FileName}}</p>
+{{template "steps" (steps $.Root .Steps)}}{{if .UsedBy}}<p>Used by concepts: {{range .UsedBy}}<a href="#{{.Anchor}}">{{.Heading}}</a> {{end}}</p>
+{{end}}{{if .Usages}}<p>Used in:</p>
+{{template "usages" (usages $.Root .Usages)}}{{end}}</section>
+{{end}}{{template "footer" .}}{{end}}
+`))
+
+// The templates call each other with the path to the root of the site and the part of the page they render
+var htmlFuncs = template.FuncMap{
+	"slug": slug,
+	"step": func(root string, step *stepView) interface{} {
+		return struct {
+			Root string
+			Step *stepView
+		}{root, step}
+	},
+	"steps": func(root string, steps []*stepView) interface{} {
+		return struct {
+			Root  string
+			Steps []*stepView
+		}{root, steps}
+	},
+	"blocks": func(root string, blocks []*block) interface{} {
+		return struct {
+			Root   string
+			Blocks []*block
+		}{root, blocks}
+	},
+	"tags": func(root string, tags []string) interface{} {
+		return struct {
+			Root string
+			Tags []string
+		}{root, tags}
+	},
+	"usages": func(root string, usages []*usage) interface{} {
+		return struct {
+			Root   string
+			Usages []*usage
+		}{root, usages}
+	},
+}
+
+const css = `body { font-family: sans-serif; margin: 0; color: #333; }
+nav { background: #f5f5f5; padding: 0.8em 2em; border-bottom: 1px solid #ddd; }
+nav a { margin-right: 1.5em; }
+main { padding: 1em 2em; max-width: 60em; }
+table { border-collapse: collapse; margin: 0.5em 0; }
+th, td { border: 1px solid #ccc; padding: 0.3em 0.6em; text-align: left; }
+.file { color: #888; font-size: 0.9em; }
+.tag { background: #eef; border-radius: 3px; padding: 0 0.4em; text-decoration: none; }
+.param { font-family: monospace; background: #f3f3f3; }
+.concept { font-size: 0.8em; }
+.status { font-size: 0.7em; border-radius: 3px; padding: 0.1em 0.5em; color: #fff; vertical-align: middle; }
+.status.passed { background: #2a2; }
+.status.failed { background: #c22; }
+.status.skipped { background: #999; }
+details { margin: 0.3em 0; }
+`
+
+// renderHTML renders the site as HTML pages keyed by their path in the site
+func renderHTML(s *site) map[string]string {
+	files := map[string]string{"style.css": css}
+	files["index.html"] = executeHTML("index", &htmlPage{Site: s, Title: "Specifications"})
+	files["tags.html"] = executeHTML("tagIndex", &htmlPage{Site: s, Title: "Tags"})
+	files["concepts.html"] = executeHTML("glossary", &htmlPage{Site: s, Title: "Concepts"})
+	for _, spec := range s.Specs {
+		files[specsDir+"/"+spec.Name+".html"] = executeHTML("spec", &htmlPage{Site: s, Root: "../", Title: spec.Heading, Spec: spec})
+	}
+	return files
+}
+
+func executeHTML(name string, page *htmlPage) string {
+	var b bytes.Buffer
+	if err := htmlTemplates.ExecuteTemplate(&b, name, page); err != nil {
+		// The templates are fixed, so failing to render them is a bug
+		panic(err)
+	}
+	return b.String()
+}
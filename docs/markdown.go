@@ -0,0 +1,227 @@
+// Copyright 2015 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+
+package docs
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+)
+
+// renderMarkdown renders the site as Markdown files keyed by their path in the site. Anchors are written as HTML
+// since Markdown has no syntax for them.
+func renderMarkdown(s *site) map[string]string {
+	files := make(map[string]string)
+	files["index.md"] = markdownIndex(s)
+	files["tags.md"] = markdownTags(s)
+	files["concepts.md"] = markdownConcepts(s)
+	for _, spec := range s.Specs {
+		files[specsDir+"/"+spec.Name+".md"] = markdownSpec(spec, "../")
+	}
+	return files
+}
+
+func markdownIndex(s *site) string {
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "# %s\n\n[Tags](tags.md) | [Concepts](concepts.md)\n\n", markdownText(s.Title))
+	if s.LastRun != nil {
+		fmt.Fprintf(&b, "Last run %s", s.LastRun.Timestamp)
+		if s.LastRun.Environment != "" {
+			fmt.Fprintf(&b, " in %s", s.LastRun.Environment)
+		}
+		fmt.Fprintf(&b, ": %d passed, %d failed, %d skipped.", s.LastRun.Passed, s.LastRun.Failed, s.LastRun.Skipped)
+		if s.LastRun.Partial {
+			b.WriteString(" Only some of the specs or scenarios were run")
+			if s.LastRun.Tags != "" {
+				fmt.Fprintf(&b, " for the tags %s", markdownText(s.LastRun.Tags))
+			}
+			b.WriteString(".")
+		}
+		b.WriteString("\n\n")
+	}
+	headers := []string{"Specification", "File", "Scenarios", "Tags"}
+	if s.LastRun != nil {
+		headers = append(headers, "Status")
+	}
+	rows := make([][]string, 0, len(s.Specs))
+	for _, spec := range s.Specs {
+		row := []string{
+			fmt.Sprintf("[%s](%s/%s.md)", markdownText(spec.Heading), specsDir, spec.Name),
+			spec.FileName,
+			fmt.Sprintf("%d", len(spec.Scenarios)),
+			markdownTagLinks(spec.Tags, ""),
+		}
+		if s.LastRun != nil {
+			row = append(row, spec.Status)
+		}
+		rows = append(rows, row)
+	}
+	writeMarkdownTable(&b, &tableView{Headers: headers, Rows: rows}, "")
+	return b.String()
+}
+
+func markdownSpec(spec *specPage, root string) string {
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "# %s%s\n\n", markdownText(spec.Heading), markdownStatus(spec.Status))
+	fmt.Fprintf(&b, "`%s`\n\n", spec.FileName)
+	if len(spec.Tags) > 0 {
+		fmt.Fprintf(&b, "Tags: %s\n\n", markdownTagLinks(spec.Tags, root))
+	}
+	writeMarkdownBlocks(&b, spec.Blocks, root)
+	for _, scenario := range spec.Scenarios {
+		fmt.Fprintf(&b, "## <a id=\"%s\"></a>%s%s\n\n", scenario.Anchor, markdownText(scenario.Heading), markdownStatus(scenario.Status))
+		if len(scenario.Tags) > 0 {
+			fmt.Fprintf(&b, "Tags: %s\n\n", markdownTagLinks(scenario.Tags, root))
+		}
+		writeMarkdownBlocks(&b, scenario.Blocks, root)
+	}
+	if len(spec.TearDown) > 0 {
+		b.WriteString("## Teardown\n\n")
+		writeMarkdownBlocks(&b, spec.TearDown, root)
+	}
+	return b.String()
+}
+
+func markdownTags(s *site) string {
+	var b bytes.Buffer
+	b.WriteString("# Tags\n\n")
+	for _, tag := range s.Tags {
+		fmt.Fprintf(&b, "## <a id=\"%s\"></a>%s\n\n", tag.Anchor, markdownText(tag.Name))
+		writeMarkdownUsages(&b, tag.Usages)
+	}
+	return b.String()
+}
+
+func markdownConcepts(s *site) string {
+	var b bytes.Buffer
+	b.WriteString("# Concepts\n\n")
+	for _, concept := range s.Concepts {
+		fmt.Fprintf(&b, "## <a id=\"%s\"></a>%s\n\n`%s`\n\n", concept.Anchor, markdownText(concept.Heading), concept.FileName)
+		for _, step := range concept.Steps {
+			writeMarkdownStep(&b, step, "", 0)
+		}
+		b.WriteString("\n")
+		if len(concept.UsedBy) > 0 {
+			links := make([]string, 0, len(concept.UsedBy))
+			for _, usedBy := range concept.UsedBy {
+				links = append(links, fmt.Sprintf("[%s](#%s)", markdownText(usedBy.Heading), usedBy.Anchor))
+			}
+			fmt.Fprintf(&b, "Used by concepts: %s\n\n", strings.Join(links, ", "))
+		}
+		if len(concept.Usages) > 0 {
+			b.WriteString("Used in:\n\n")
+			writeMarkdownUsages(&b, concept.Usages)
+		}
+	}
+	return b.String()
+}
+
+func writeMarkdownBlocks(b *bytes.Buffer, blocks []*block, root string) {
+	for _, blk := range blocks {
+		if blk.Text != "" {
+			fmt.Fprintf(b, "%s\n\n", blk.Text)
+		}
+		if blk.Table != nil {
+			writeMarkdownTable(b, blk.Table, "")
+		}
+		if blk.Steps != nil {
+			for _, step := range blk.Steps {
+				writeMarkdownStep(b, step, root, 0)
+			}
+			b.WriteString("\n")
+		}
+	}
+}
+
+func writeMarkdownStep(b *bytes.Buffer, step *stepView, root string, depth int) {
+	indent := strings.Repeat("  ", depth)
+	fmt.Fprintf(b, "%s* ", indent)
+	for _, part := range step.Parts {
+		if part.Param {
+			fmt.Fprintf(b, "`%s`", part.Text)
+		} else {
+			b.WriteString(part.Text)
+		}
+	}
+	if step.Concept != nil {
+		fmt.Fprintf(b, " ([concept](%sconcepts.md#%s))", root, step.Concept.Anchor)
+	}
+	b.WriteString("\n")
+	if step.Table != nil {
+		b.WriteString("\n")
+		writeMarkdownTable(b, step.Table, indent+"  ")
+	}
+	for _, conceptStep := range step.Steps {
+		writeMarkdownStep(b, conceptStep, root, depth+1)
+	}
+}
+
+func writeMarkdownTable(b *bytes.Buffer, table *tableView, indent string) {
+	writeMarkdownRow(b, table.Headers, indent)
+	separators := make([]string, len(table.Headers))
+	for i := range separators {
+		separators[i] = "---"
+	}
+	writeMarkdownRow(b, separators, indent)
+	for _, row := range table.Rows {
+		writeMarkdownRow(b, row, indent)
+	}
+	b.WriteString("\n")
+}
+
+func writeMarkdownRow(b *bytes.Buffer, cells []string, indent string) {
+	escaped := make([]string, len(cells))
+	for i, cell := range cells {
+		escaped[i] = strings.Replace(cell, "|", "\\|", -1)
+	}
+	fmt.Fprintf(b, "%s| %s |\n", indent, strings.Join(escaped, " | "))
+}
+
+func writeMarkdownUsages(b *bytes.Buffer, usages []*usage) {
+	for _, u := range usages {
+		if u.Scenario != nil {
+			fmt.Fprintf(b, "* [%s / %s](%s/%s.md#%s)\n", markdownText(u.Spec.Heading), markdownText(u.Scenario.Heading), specsDir, u.Spec.Name, u.Scenario.Anchor)
+		} else {
+			fmt.Fprintf(b, "* [%s](%s/%s.md)\n", markdownText(u.Spec.Heading), specsDir, u.Spec.Name)
+		}
+	}
+	b.WriteString("\n")
+}
+
+func markdownTagLinks(tags []string, root string) string {
+	links := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		links = append(links, fmt.Sprintf("[%s](%stags.md#tag-%s)", markdownText(tag), root, slug(tag)))
+	}
+	return strings.Join(links, ", ")
+}
+
+func markdownStatus(status string) string {
+	if status == "" {
+		return ""
+	}
+	return fmt.Sprintf(" (%s)", status)
+}
+
+var markdownEscaper = strings.NewReplacer("\\", "\\\\", "<", "\\<", ">", "\\>", "[", "\\[", "]", "\\]", "*", "\\*", "_", "\\_")
+
+// markdownText escapes the characters of headings and link texts which Markdown would take as markup, like the
+// parameters of concept headings
+func markdownText(text string) string {
+	return markdownEscaper.Replace(text)
+}
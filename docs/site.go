@@ -0,0 +1,440 @@
+// Copyright 2015 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+
+package docs
+
+import (
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/getgauge/gauge/config"
+	"github.com/getgauge/gauge/gauge"
+)
+
+// maxExpansionDepth stops expanding concepts deeper than this, the parser already rejects circular concepts
+const maxExpansionDepth = 10
+
+// site is what the docs show, built once from the specs and concepts and rendered as HTML or Markdown
+type site struct {
+	Title    string
+	Specs    []*specPage
+	Tags     []*tagEntry
+	Concepts []*conceptEntry
+	LastRun  *runSummary
+}
+
+type specPage struct {
+	Name      string
+	Heading   string
+	FileName  string
+	Tags      []string
+	Status    string
+	Blocks    []*block
+	Scenarios []*scenarioSection
+	TearDown  []*block
+}
+
+type scenarioSection struct {
+	Heading string
+	Anchor  string
+	Tags    []string
+	Status  string
+	Blocks  []*block
+}
+
+// block is a part of a spec or scenario in the order of the spec: a paragraph of comments, a table or consecutive steps
+type block struct {
+	Text  string
+	Table *tableView
+	Steps []*stepView
+}
+
+type tableView struct {
+	Headers []string
+	Rows    [][]string
+}
+
+type stepView struct {
+	Parts   []stepPart
+	Table   *tableView
+	Concept *conceptEntry
+	Steps   []*stepView
+}
+
+type stepPart struct {
+	Text  string
+	Param bool
+}
+
+type conceptEntry struct {
+	Heading  string
+	Anchor   string
+	FileName string
+	Steps    []*stepView
+	Usages   []*usage
+	UsedBy   []*conceptEntry
+	value    string
+}
+
+type tagEntry struct {
+	Name   string
+	Anchor string
+	Usages []*usage
+}
+
+// usage points to a spec, or to a scenario of it when Scenario is set
+type usage struct {
+	Spec     *specPage
+	Scenario *scenarioSection
+}
+
+type siteBuilder struct {
+	site      *site
+	concepts  map[string]*conceptEntry
+	tags      map[string]*tagEntry
+	pageNames map[string]bool
+	lastRun   *runStatus
+}
+
+func newSite(title string, specs []*gauge.Specification, conceptDictionary *gauge.ConceptDictionary, lastRun *runStatus) *site {
+	b := &siteBuilder{
+		site:      &site{Title: title},
+		concepts:  make(map[string]*conceptEntry),
+		tags:      make(map[string]*tagEntry),
+		pageNames: make(map[string]bool),
+		lastRun:   lastRun,
+	}
+	if lastRun != nil {
+		b.site.LastRun = lastRun.summary
+	}
+	b.addConcepts(conceptDictionary)
+	for _, spec := range sortedSpecs(specs) {
+		b.site.Specs = append(b.site.Specs, b.specPage(spec))
+	}
+	for _, tag := range b.tags {
+		b.site.Tags = append(b.site.Tags, tag)
+	}
+	sort.Sort(byTagName(b.site.Tags))
+	return b.site
+}
+
+// addConcepts creates the glossary entries first, so that concept steps can link to them, and then their expansions
+func (b *siteBuilder) addConcepts(conceptDictionary *gauge.ConceptDictionary) {
+	anchors := make(map[string]bool)
+	concepts := make([]*gauge.Concept, 0, len(conceptDictionary.ConceptsMap))
+	for _, concept := range conceptDictionary.ConceptsMap {
+		concepts = append(concepts, concept)
+	}
+	sort.Sort(byHeading(concepts))
+	for _, concept := range concepts {
+		heading := stepText(concept.ConceptStep)
+		entry := &conceptEntry{
+			Heading:  heading,
+			Anchor:   uniqueName("concept-"+slug(heading), anchors),
+			FileName: relativePath(concept.FileName),
+			value:    concept.ConceptStep.Value,
+		}
+		b.concepts[entry.value] = entry
+		b.site.Concepts = append(b.site.Concepts, entry)
+	}
+	for _, concept := range concepts {
+		entry := b.concepts[concept.ConceptStep.Value]
+		entry.Steps = b.stepViews(concept.ConceptStep.ConceptSteps, 0)
+		for _, step := range concept.ConceptStep.ConceptSteps {
+			if used := b.concepts[step.Value]; step.IsConcept && used != nil && !containsConcept(used.UsedBy, entry) {
+				used.UsedBy = append(used.UsedBy, entry)
+			}
+		}
+	}
+}
+
+func (b *siteBuilder) specPage(spec *gauge.Specification) *specPage {
+	page := &specPage{FileName: relativePath(spec.FileName)}
+	page.Name = uniqueName(slug(strings.TrimSuffix(page.FileName, filepath.Ext(page.FileName))), b.pageNames)
+	if spec.Heading != nil {
+		page.Heading = spec.Heading.Value
+	}
+	if spec.Tags != nil {
+		page.Tags = spec.Tags.Values
+	}
+	b.addTags(page.Tags, &usage{Spec: page})
+	specStatus := b.lastRun.spec(spec.FileName)
+	if specStatus != nil {
+		page.Status = specStatus.status
+	}
+
+	anchors := make(map[string]bool)
+	blocks := &page.Blocks
+	items := make([]gauge.Item, 0)
+	flush := func() {
+		*blocks = append(*blocks, b.blocks(items, page, nil)...)
+		items = make([]gauge.Item, 0)
+	}
+	for _, item := range spec.Items {
+		switch item.Kind() {
+		case gauge.ScenarioKind:
+			flush()
+			page.Scenarios = append(page.Scenarios, b.scenarioSection(item.(*gauge.Scenario), page, anchors, specStatus))
+		case gauge.TearDownKind:
+			flush()
+			blocks = &page.TearDown
+		default:
+			items = append(items, item)
+		}
+	}
+	flush()
+	return page
+}
+
+func (b *siteBuilder) scenarioSection(scenario *gauge.Scenario, page *specPage, anchors map[string]bool, specStatus *specStatus) *scenarioSection {
+	section := &scenarioSection{}
+	if scenario.Heading != nil {
+		section.Heading = scenario.Heading.Value
+	}
+	section.Anchor = uniqueName("scenario-"+slug(section.Heading), anchors)
+	if scenario.Tags != nil {
+		section.Tags = scenario.Tags.Values
+	}
+	if specStatus != nil {
+		section.Status = specStatus.scenarios[section.Heading]
+	}
+	b.addTags(section.Tags, &usage{Spec: page, Scenario: section})
+	section.Blocks = b.blocks(scenario.Items, page, section)
+	return section
+}
+
+// blocks turns the items of a spec or scenario into blocks, joining consecutive comment lines into a paragraph.
+// Tags are left out since they are shown under the heading.
+func (b *siteBuilder) blocks(items []gauge.Item, page *specPage, section *scenarioSection) []*block {
+	blocks := make([]*block, 0)
+	var paragraph []string
+	flush := func() {
+		if len(paragraph) > 0 {
+			blocks = append(blocks, &block{Text: strings.Join(paragraph, "\n")})
+			paragraph = nil
+		}
+	}
+	for _, item := range items {
+		if item.Kind() == gauge.CommentKind {
+			if value := strings.TrimSpace(item.(*gauge.Comment).Value); value != "" {
+				paragraph = append(paragraph, value)
+			} else {
+				flush()
+			}
+			continue
+		}
+		flush()
+		switch item.Kind() {
+		case gauge.TableKind:
+			blocks = append(blocks, &block{Table: newTableView(item.(*gauge.Table))})
+		case gauge.DataTableKind:
+			dataTable := item.(*gauge.DataTable)
+			dataBlock := &block{Table: newTableView(&dataTable.Table)}
+			if dataTable.IsExternal {
+				dataBlock.Text = dataTable.Value
+			}
+			blocks = append(blocks, dataBlock)
+		case gauge.StepKind:
+			step := item.(*gauge.Step)
+			if len(blocks) == 0 || blocks[len(blocks)-1].Steps == nil {
+				blocks = append(blocks, &block{Steps: make([]*stepView, 0)})
+			}
+			last := blocks[len(blocks)-1]
+			last.Steps = append(last.Steps, b.stepView(step, 0))
+			b.addUsage(step, page, section)
+		}
+	}
+	flush()
+	return blocks
+}
+
+func (b *siteBuilder) addTags(tags []string, u *usage) {
+	for _, name := range tags {
+		tag, ok := b.tags[name]
+		if !ok {
+			tag = &tagEntry{Name: name, Anchor: "tag-" + slug(name)}
+			b.tags[name] = tag
+		}
+		tag.Usages = append(tag.Usages, u)
+	}
+}
+
+func (b *siteBuilder) addUsage(step *gauge.Step, page *specPage, section *scenarioSection) {
+	if !step.IsConcept {
+		return
+	}
+	concept := b.concepts[step.Value]
+	if concept == nil {
+		return
+	}
+	for _, u := range concept.Usages {
+		if u.Spec == page && u.Scenario == section {
+			return
+		}
+	}
+	concept.Usages = append(concept.Usages, &usage{Spec: page, Scenario: section})
+}
+
+func (b *siteBuilder) stepViews(steps []*gauge.Step, depth int) []*stepView {
+	views := make([]*stepView, 0, len(steps))
+	for _, step := range steps {
+		views = append(views, b.stepView(step, depth))
+	}
+	return views
+}
+
+func (b *siteBuilder) stepView(step *gauge.Step, depth int) *stepView {
+	view := &stepView{}
+	text := strings.TrimSpace(step.Value)
+	for i, arg := range step.Args {
+		index := strings.Index(text, gauge.ParameterPlaceholder)
+		if index < 0 {
+			break
+		}
+		if prefix := text[:index]; prefix != "" {
+			view.Parts = append(view.Parts, stepPart{Text: prefix})
+		}
+		text = text[index+len(gauge.ParameterPlaceholder):]
+		if arg.ArgType == gauge.TableArg {
+			view.Table = newTableView(&step.Args[i].Table)
+			continue
+		}
+		view.Parts = append(view.Parts, stepPart{Text: argText(arg), Param: true})
+	}
+	if text = strings.TrimRight(text, " "); text != "" {
+		view.Parts = append(view.Parts, stepPart{Text: text})
+	}
+	if step.IsConcept {
+		view.Concept = b.concepts[step.Value]
+		if depth < maxExpansionDepth {
+			view.Steps = b.stepViews(step.ConceptSteps, depth+1)
+		}
+	}
+	return view
+}
+
+func argText(arg *gauge.StepArg) string {
+	switch arg.ArgType {
+	case gauge.Dynamic:
+		return fmt.Sprintf("<%s>", arg.Value)
+	case gauge.SpecialString, gauge.SpecialTable:
+		return fmt.Sprintf("<%s>", arg.Name)
+	}
+	return fmt.Sprintf("\"%s\"", arg.Value)
+}
+
+// stepText is the step as written in specs, without its inline table
+func stepText(step *gauge.Step) string {
+	text := strings.TrimSpace(step.Value)
+	for _, arg := range step.Args {
+		replacement := argText(arg)
+		if arg.ArgType == gauge.TableArg {
+			replacement = ""
+		}
+		text = strings.Replace(text, gauge.ParameterPlaceholder, replacement, 1)
+	}
+	return strings.TrimSpace(text)
+}
+
+func newTableView(table *gauge.Table) *tableView {
+	view := &tableView{Headers: table.Headers, Rows: make([][]string, 0)}
+	for i := 0; i < table.GetRowCount(); i++ {
+		row := make([]string, 0, len(table.Headers))
+		for _, header := range table.Headers {
+			row = append(row, table.Get(header)[i].GetValue())
+		}
+		view.Rows = append(view.Rows, row)
+	}
+	return view
+}
+
+func relativePath(file string) string {
+	if rel, err := filepath.Rel(config.ProjectRoot, file); err == nil && !strings.HasPrefix(rel, "..") {
+		file = rel
+	}
+	return filepath.ToSlash(file)
+}
+
+// slug turns text into a name usable in file names and anchors
+func slug(text string) string {
+	var s []rune
+	dash := false
+	for _, r := range strings.ToLower(text) {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			s = append(s, r)
+			dash = false
+		} else if !dash && len(s) > 0 {
+			s = append(s, '-')
+			dash = true
+		}
+	}
+	result := strings.TrimSuffix(string(s), "-")
+	if result == "" {
+		return "item"
+	}
+	return result
+}
+
+// uniqueName returns name, or name with a number when it is already taken
+func uniqueName(name string, taken map[string]bool) string {
+	unique := name
+	for i := 2; taken[unique]; i++ {
+		unique = fmt.Sprintf("%s-%d", name, i)
+	}
+	taken[unique] = true
+	return unique
+}
+
+func containsConcept(concepts []*conceptEntry, concept *conceptEntry) bool {
+	for _, c := range concepts {
+		if c == concept {
+			return true
+		}
+	}
+	return false
+}
+
+type byHeading []*gauge.Concept
+
+func (s byHeading) Len() int {
+	return len(s)
+}
+
+func (s byHeading) Swap(i, j int) {
+	s[i], s[j] = s[j], s[i]
+}
+
+func (s byHeading) Less(i, j int) bool {
+	return strings.ToLower(stepText(s[i].ConceptStep)) < strings.ToLower(stepText(s[j].ConceptStep))
+}
+
+type byTagName []*tagEntry
+
+func (s byTagName) Len() int {
+	return len(s)
+}
+
+func (s byTagName) Swap(i, j int) {
+	s[i], s[j] = s[j], s[i]
+}
+
+func (s byTagName) Less(i, j int) bool {
+	return strings.ToLower(s[i].Name) < strings.ToLower(s[j].Name)
+}
@@ -0,0 +1,113 @@
+// Copyright 2015 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+
+package docs
+
+import (
+	"path/filepath"
+
+	"github.com/getgauge/gauge/gauge_messages"
+)
+
+const (
+	passed  = "passed"
+	failed  = "failed"
+	skipped = "skipped"
+)
+
+// runStatus is the status of the specs and scenarios in the last run, keyed by the file of the spec and the heading
+// of the scenario
+type runStatus struct {
+	summary *runSummary
+	specs   map[string]*specStatus
+}
+
+type runSummary struct {
+	Timestamp   string
+	Environment string
+	Passed      int
+	Failed      int
+	Skipped     int
+	// Partial is set if only some of the specs or scenarios were run, e.g. filtered by Tags
+	Partial bool
+	Tags    string
+}
+
+type specStatus struct {
+	status    string
+	scenarios map[string]string
+}
+
+func newRunStatus(suiteResult *gauge_messages.ProtoSuiteResult) *runStatus {
+	run := &runStatus{
+		summary: &runSummary{
+			Timestamp:   suiteResult.GetTimestamp(),
+			Environment: suiteResult.GetEnvironment(),
+			Partial:     suiteResult.GetIsPartialRun(),
+			Tags:        suiteResult.GetTags(),
+		},
+		specs: make(map[string]*specStatus),
+	}
+	for _, specResult := range suiteResult.GetSpecResults() {
+		status := &specStatus{status: passed, scenarios: make(map[string]string)}
+		if specResult.GetSkipped() {
+			status.status = skipped
+			run.summary.Skipped++
+		} else if specResult.GetFailed() {
+			status.status = failed
+			run.summary.Failed++
+		} else {
+			run.summary.Passed++
+		}
+		for _, item := range specResult.GetProtoSpec().GetItems() {
+			switch item.GetItemType() {
+			case gauge_messages.ProtoItem_Scenario:
+				status.addScenario(item.GetScenario())
+			case gauge_messages.ProtoItem_TableDrivenScenario:
+				for _, scenario := range item.GetTableDrivenScenario().GetScenarios() {
+					status.addScenario(scenario)
+				}
+			}
+		}
+		run.specs[filepath.Clean(specResult.GetProtoSpec().GetFileName())] = status
+	}
+	return run
+}
+
+// addScenario records the status of a scenario. A table driven scenario failed if it failed for any row, and was
+// skipped only if it was skipped for all of them.
+func (s *specStatus) addScenario(scenario *gauge_messages.ProtoScenario) {
+	status := passed
+	if scenario.GetFailed() {
+		status = failed
+	} else if scenario.GetSkipped() {
+		status = skipped
+	}
+	heading := scenario.GetScenarioHeading()
+	previous, ok := s.scenarios[heading]
+	if !ok || previous == skipped || status == failed {
+		s.scenarios[heading] = status
+	}
+}
+
+// spec returns the status of the spec in the file, nil if there was no last run or the spec was not run
+func (r *runStatus) spec(fileName string) *specStatus {
+	if r == nil {
+		return nil
+	}
+	return r.specs[filepath.Clean(fileName)]
+}
@@ -36,6 +36,7 @@ import (
 	"github.com/getgauge/gauge/plugin/install"
 	"github.com/getgauge/gauge/reporter"
 	"github.com/getgauge/gauge/runner"
+	"github.com/golang/protobuf/proto"
 )
 
 var NumberOfExecutionStreams int
@@ -57,10 +58,11 @@ type executionInfo struct {
 	errMaps         *validationErrMaps
 	inParallel      bool
 	numberOfStreams int
+	log             *logger.Entry
 }
 
-func newExecutionInfo(manifest *manifest.Manifest, specStore *specStore, runner *runner.TestRunner, ph *plugin.Handler, reporter reporter.Reporter, errMap *validationErrMaps, isParallel bool) *executionInfo {
-	return &executionInfo{manifest, specStore, runner, ph, reporter, errMap, isParallel, NumberOfExecutionStreams}
+func newExecutionInfo(manifest *manifest.Manifest, specStore *specStore, runner *runner.TestRunner, ph *plugin.Handler, reporter reporter.Reporter, errMap *validationErrMaps, isParallel bool, log *logger.Entry) *executionInfo {
+	return &executionInfo{manifest, specStore, runner, ph, reporter, errMap, isParallel, NumberOfExecutionStreams, log}
 }
 
 type specStore struct {
@@ -105,12 +107,17 @@ func ExecuteSpecs(args []string) int {
 	}
 	runner := startAPI()
 	errMap := validateSpecs(manifest, specsToExecute, runner, conceptsDictionary)
-	executionInfo := newExecutionInfo(manifest, &specStore{specs: specsToExecute}, runner, nil, reporter.Current(), errMap, InParallel)
+	executionInfo := newExecutionInfo(manifest, &specStore{specs: specsToExecute}, runner, nil, reporter.Current(), errMap, InParallel, logger.WithFields(logger.Fields{}))
 	execution := newExecution(executionInfo)
 	execution.start()
-	result := execution.run()
+	suiteResult := execution.run()
 	execution.finish()
-	exitCode := printExecutionStatus(result, errMap)
+	lastRunResult := gauge.ConvertToProtoSuiteResult(suiteResult)
+	lastRunResult.IsPartialRun = proto.Bool(filter.IsPartialRun(args))
+	if err := result.SaveLastRunResult(lastRunResult); err != nil {
+		logger.Debug("Failed to save the result of the execution. %s", err.Error())
+	}
+	exitCode := printExecutionStatus(suiteResult, errMap)
 	return exitCode
 }
 
@@ -109,7 +109,7 @@ func (e *parallelExecution) eagerExecution(distributions int) []*result.SuiteRes
 	specCollections := filter.DistributeSpecs(e.specStore.specs, distributions)
 	suiteResultChannel := make(chan *result.SuiteResult, len(specCollections))
 	for i, specCollection := range specCollections {
-		go e.startSpecsExecution(specCollection, suiteResultChannel, reporter.NewParallelConsole(i+1))
+		go e.startSpecsExecution(specCollection, suiteResultChannel, reporter.NewParallelConsole(i+1), i+1)
 	}
 	var suiteResults []*result.SuiteResult
 	for _ = range specCollections {
@@ -118,22 +118,23 @@ func (e *parallelExecution) eagerExecution(distributions int) []*result.SuiteRes
 	return suiteResults
 }
 
-func (e *parallelExecution) startSpecsExecution(specCollection *filter.SpecCollection, suiteResults chan *result.SuiteResult, reporter reporter.Reporter) {
-	testRunner, err := runner.StartRunnerAndMakeConnection(e.manifest, reporter, make(chan bool))
+func (e *parallelExecution) startSpecsExecution(specCollection *filter.SpecCollection, suiteResults chan *result.SuiteResult, reporter reporter.Reporter, stream int) {
+	log := logger.WithFields(logger.Fields{logger.StreamField: stream})
+	testRunner, err := runner.StartRunnerAndMakeConnection(e.manifest, reporter, stream, make(chan bool))
 	if err != nil {
-		logger.Errorf("Failed: " + err.Error())
-		logger.Debug("Skipping %s specifications", strconv.Itoa(len(specCollection.Specs)))
+		log.Errorf("Failed: " + err.Error())
+		log.Debug("Skipping %s specifications", strconv.Itoa(len(specCollection.Specs)))
 		suiteResults <- &result.SuiteResult{UnhandledErrors: []error{streamExecError{specsSkipped: specCollection.SpecNames(), message: fmt.Sprintf("Failed to start runner. %s", err.Error())}}}
 		return
 	}
-	e.startSpecsExecutionWithRunner(&specStore{specs: specCollection.Specs}, suiteResults, testRunner, reporter)
+	e.startSpecsExecutionWithRunner(&specStore{specs: specCollection.Specs}, suiteResults, testRunner, reporter, log)
 }
 
 func (e *parallelExecution) lazyExecution(totalStreams int) []*result.SuiteResult {
 	suiteResultChannel := make(chan *result.SuiteResult, e.specStore.size())
 	e.wg.Add(totalStreams)
 	for i := 0; i < totalStreams; i++ {
-		go e.startStream(e.specStore, reporter.NewParallelConsole(i+1), suiteResultChannel)
+		go e.startStream(e.specStore, reporter.NewParallelConsole(i+1), suiteResultChannel, i+1)
 	}
 	e.wg.Wait()
 	var suiteResults []*result.SuiteResult
@@ -144,19 +145,20 @@ func (e *parallelExecution) lazyExecution(totalStreams int) []*result.SuiteResul
 	return suiteResults
 }
 
-func (e *parallelExecution) startStream(specStore *specStore, reporter reporter.Reporter, suiteResultChannel chan *result.SuiteResult) {
+func (e *parallelExecution) startStream(specStore *specStore, reporter reporter.Reporter, suiteResultChannel chan *result.SuiteResult, stream int) {
 	defer e.wg.Done()
-	testRunner, err := runner.StartRunnerAndMakeConnection(e.manifest, reporter, make(chan bool))
+	log := logger.WithFields(logger.Fields{logger.StreamField: stream})
+	testRunner, err := runner.StartRunnerAndMakeConnection(e.manifest, reporter, stream, make(chan bool))
 	if err != nil {
-		logger.Errorf("Failed to start runner. Reason: %s", err.Error())
+		log.Errorf("Failed to start runner. Reason: %s", err.Error())
 		suiteResultChannel <- &result.SuiteResult{UnhandledErrors: []error{fmt.Errorf("Failed to start runner. %s", err.Error())}}
 		return
 	}
-	e.startSpecsExecutionWithRunner(specStore, suiteResultChannel, testRunner, reporter)
+	e.startSpecsExecutionWithRunner(specStore, suiteResultChannel, testRunner, reporter, log)
 }
 
-func (e *parallelExecution) startSpecsExecutionWithRunner(specStore *specStore, suiteResultsChan chan *result.SuiteResult, runner *runner.TestRunner, reporter reporter.Reporter) {
-	executionInfo := newExecutionInfo(e.manifest, specStore, runner, e.pluginHandler, reporter, e.errMaps, false)
+func (e *parallelExecution) startSpecsExecutionWithRunner(specStore *specStore, suiteResultsChan chan *result.SuiteResult, runner *runner.TestRunner, reporter reporter.Reporter, log *logger.Entry) {
+	executionInfo := newExecutionInfo(e.manifest, specStore, runner, e.pluginHandler, reporter, e.errMaps, false, log)
 	simpleExecution := newExecution(executionInfo)
 	simpleExecution.start()
 	result := simpleExecution.run()
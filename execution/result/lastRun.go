@@ -0,0 +1,121 @@
+// Copyright 2015 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+
+package result
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/getgauge/gauge/config"
+	"github.com/getgauge/gauge/gauge_messages"
+	"github.com/golang/protobuf/proto"
+)
+
+const lastRunResultFile = "last_run_result"
+
+// LastRunResultPath is the file in the .gauge directory of the project holding the result of the last execution
+func LastRunResultPath() string {
+	return filepath.Join(config.ProjectRoot, ".gauge", lastRunResultFile)
+}
+
+// SaveLastRunResult stores the statuses of the specs and scenarios of an execution, replacing those of the previous
+// one. Screenshots, messages, output and the results of steps are left out, since the file is kept in the project.
+func SaveLastRunResult(suiteResult *gauge_messages.ProtoSuiteResult) error {
+	bytes, err := proto.Marshal(lastRunStatuses(suiteResult))
+	if err != nil {
+		return err
+	}
+	file := LastRunResultPath()
+	if err := os.MkdirAll(filepath.Dir(file), 0755); err != nil {
+		return err
+	}
+	return ioutil.WriteFile(file, bytes, 0644)
+}
+
+func lastRunStatuses(suiteResult *gauge_messages.ProtoSuiteResult) *gauge_messages.ProtoSuiteResult {
+	statuses := &gauge_messages.ProtoSuiteResult{
+		Failed:            suiteResult.Failed,
+		SpecsFailedCount:  suiteResult.SpecsFailedCount,
+		ExecutionTime:     suiteResult.ExecutionTime,
+		SuccessRate:       suiteResult.SuccessRate,
+		Environment:       suiteResult.Environment,
+		Tags:              suiteResult.Tags,
+		ProjectName:       suiteResult.ProjectName,
+		Timestamp:         suiteResult.Timestamp,
+		SpecsSkippedCount: suiteResult.SpecsSkippedCount,
+		IsPartialRun:      suiteResult.IsPartialRun,
+	}
+	for _, specResult := range suiteResult.GetSpecResults() {
+		statuses.SpecResults = append(statuses.SpecResults, specStatuses(specResult))
+	}
+	return statuses
+}
+
+func specStatuses(specResult *gauge_messages.ProtoSpecResult) *gauge_messages.ProtoSpecResult {
+	protoSpec := specResult.GetProtoSpec()
+	spec := &gauge_messages.ProtoSpec{
+		SpecHeading:   protoSpec.SpecHeading,
+		IsTableDriven: protoSpec.IsTableDriven,
+		FileName:      protoSpec.FileName,
+	}
+	for _, item := range protoSpec.GetItems() {
+		switch item.GetItemType() {
+		case gauge_messages.ProtoItem_Scenario:
+			spec.Items = append(spec.Items, &gauge_messages.ProtoItem{ItemType: item.ItemType, Scenario: scenarioStatus(item.GetScenario())})
+		case gauge_messages.ProtoItem_TableDrivenScenario:
+			tableDrivenScenario := &gauge_messages.ProtoTableDrivenScenario{}
+			for _, scenario := range item.GetTableDrivenScenario().GetScenarios() {
+				tableDrivenScenario.Scenarios = append(tableDrivenScenario.Scenarios, scenarioStatus(scenario))
+			}
+			spec.Items = append(spec.Items, &gauge_messages.ProtoItem{ItemType: item.ItemType, TableDrivenScenario: tableDrivenScenario})
+		}
+	}
+	return &gauge_messages.ProtoSpecResult{
+		ProtoSpec:            spec,
+		ScenarioCount:        specResult.ScenarioCount,
+		ScenarioFailedCount:  specResult.ScenarioFailedCount,
+		Failed:               specResult.Failed,
+		FailedDataTableRows:  specResult.FailedDataTableRows,
+		ExecutionTime:        specResult.ExecutionTime,
+		Skipped:              specResult.Skipped,
+		ScenarioSkippedCount: specResult.ScenarioSkippedCount,
+	}
+}
+
+func scenarioStatus(scenario *gauge_messages.ProtoScenario) *gauge_messages.ProtoScenario {
+	return &gauge_messages.ProtoScenario{
+		ScenarioHeading: scenario.ScenarioHeading,
+		Failed:          scenario.Failed,
+		Skipped:         scenario.Skipped,
+		ExecutionTime:   scenario.ExecutionTime,
+	}
+}
+
+// LastRunResult reads the result stored by the last execution of the project.
+func LastRunResult() (*gauge_messages.ProtoSuiteResult, error) {
+	bytes, err := ioutil.ReadFile(LastRunResultPath())
+	if err != nil {
+		return nil, err
+	}
+	suiteResult := &gauge_messages.ProtoSuiteResult{}
+	if err := proto.Unmarshal(bytes, suiteResult); err != nil {
+		return nil, err
+	}
+	return suiteResult, nil
+}
@@ -44,11 +44,12 @@ type simpleExecution struct {
 	consoleReporter      reporter.Reporter
 	errMaps              *validationErrMaps
 	startTime            time.Time
+	log                  *logger.Entry
 }
 
 func newSimpleExecution(executionInfo *executionInfo) *simpleExecution {
 	return &simpleExecution{manifest: executionInfo.manifest, specStore: executionInfo.specStore,
-		runner: executionInfo.runner, pluginHandler: executionInfo.pluginHandler, consoleReporter: executionInfo.consoleReporter, errMaps: executionInfo.errMaps}
+		runner: executionInfo.runner, pluginHandler: executionInfo.pluginHandler, consoleReporter: executionInfo.consoleReporter, errMaps: executionInfo.errMaps, log: executionInfo.log}
 }
 
 func (e *simpleExecution) startExecution() *(gauge_messages.ProtoExecutionResult) {
@@ -60,7 +61,7 @@ func (e *simpleExecution) startExecution() *(gauge_messages.ProtoExecutionResult
 func (e *simpleExecution) initializeSuiteDataStore() *(gauge_messages.ProtoExecutionResult) {
 	initSuiteDataStoreMessage := &gauge_messages.Message{MessageType: gauge_messages.Message_SuiteDataStoreInit.Enum(),
 		SuiteDataStoreInitRequest: &gauge_messages.SuiteDataStoreInitRequest{}}
-	initResult := executeAndGetStatus(e.runner, initSuiteDataStoreMessage)
+	initResult := executeAndGetStatus(e.runner, e.log, initSuiteDataStoreMessage)
 	return initResult
 }
 
@@ -72,7 +73,7 @@ func (e *simpleExecution) endExecution() *(gauge_messages.ProtoExecutionResult)
 
 func (e *simpleExecution) executeHook(message *gauge_messages.Message) *(gauge_messages.ProtoExecutionResult) {
 	e.pluginHandler.NotifyPlugins(message)
-	executionResult := executeAndGetStatus(e.runner, message)
+	executionResult := executeAndGetStatus(e.runner, e.log, message)
 	e.addExecTime(executionResult.GetExecutionTime())
 	return executionResult
 }
@@ -133,13 +134,13 @@ func handleHookFailure(result result.Result, execResult *gauge_messages.ProtoExe
 	printStatus(execResult, reporter)
 }
 
-func getDataTableRows(rowCount int) indexRange {
+func getDataTableRows(rowCount int, log *logger.Entry) indexRange {
 	if TableRows == "" {
 		return indexRange{start: 0, end: rowCount - 1}
 	}
 	indexes, err := getDataTableRowsRange(TableRows, rowCount)
 	if err != nil {
-		logger.Errorf("Table rows validation failed. %s\n", err.Error())
+		log.Errorf("Table rows validation failed. %s\n", err.Error())
 	}
 	return indexes
 }
@@ -157,7 +158,8 @@ func (e *simpleExecution) stopAllPlugins() {
 }
 
 func (e *simpleExecution) executeSpec(specificationToExecute *gauge.Specification) {
-	executor := newSpecExecutor(specificationToExecute, e.runner, e.pluginHandler, getDataTableRows(specificationToExecute.DataTable.Table.GetRowCount()), e.consoleReporter, e.errMaps)
+	log := e.log.WithFields(logger.Fields{logger.SpecField: specificationToExecute.FileName})
+	executor := newSpecExecutor(specificationToExecute, e.runner, e.pluginHandler, getDataTableRows(specificationToExecute.DataTable.Table.GetRowCount(), log), e.consoleReporter, e.errMaps, log)
 	protoSpecResult := executor.execute()
 	e.suiteResult.AddSpecResult(protoSpecResult)
 }
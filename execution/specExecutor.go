@@ -18,11 +18,11 @@
 package execution
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
 	"strings"
 
-	"github.com/getgauge/gauge/conn"
 	"github.com/getgauge/gauge/execution/result"
 	"github.com/getgauge/gauge/formatter"
 	"github.com/getgauge/gauge/gauge"
@@ -45,6 +45,11 @@ type specExecutor struct {
 	currentTableRow      int
 	consoleReporter      reporter.Reporter
 	errMap               *validationErrMaps
+	specStdout           bytes.Buffer
+	specStderr           bytes.Buffer
+	scenarioStdout       bytes.Buffer
+	scenarioStderr       bytes.Buffer
+	log                  *logger.Entry
 }
 
 type indexRange struct {
@@ -52,19 +57,20 @@ type indexRange struct {
 	end   int
 }
 
-func newSpecExecutor(specToExecute *gauge.Specification, runner *runner.TestRunner, pluginHandler *plugin.Handler, tableRows indexRange, reporter reporter.Reporter, errMaps *validationErrMaps) *specExecutor {
+func newSpecExecutor(specToExecute *gauge.Specification, runner *runner.TestRunner, pluginHandler *plugin.Handler, tableRows indexRange, reporter reporter.Reporter, errMaps *validationErrMaps, log *logger.Entry) *specExecutor {
 	specExecutor := new(specExecutor)
-	specExecutor.initialize(specToExecute, runner, pluginHandler, tableRows, reporter, errMaps)
+	specExecutor.initialize(specToExecute, runner, pluginHandler, tableRows, reporter, errMaps, log)
 	return specExecutor
 }
 
-func (e *specExecutor) initialize(specificationToExecute *gauge.Specification, runner *runner.TestRunner, pluginHandler *plugin.Handler, tableRows indexRange, consoleReporter reporter.Reporter, errMap *validationErrMaps) {
+func (e *specExecutor) initialize(specificationToExecute *gauge.Specification, runner *runner.TestRunner, pluginHandler *plugin.Handler, tableRows indexRange, consoleReporter reporter.Reporter, errMap *validationErrMaps, log *logger.Entry) {
 	e.specification = specificationToExecute
 	e.runner = runner
 	e.pluginHandler = pluginHandler
 	e.dataTableIndex = tableRows
 	e.consoleReporter = consoleReporter
 	e.errMap = errMap
+	e.log = log
 }
 
 func (e *specExecutor) executeBeforeSpecHook() *gauge_messages.ProtoExecutionResult {
@@ -76,7 +82,7 @@ func (e *specExecutor) executeBeforeSpecHook() *gauge_messages.ProtoExecutionRes
 func (e *specExecutor) initSpecDataStore() error {
 	initSpecDataStoreMessage := &gauge_messages.Message{MessageType: gauge_messages.Message_SpecDataStoreInit.Enum(),
 		SpecDataStoreInitRequest: &gauge_messages.SpecDataStoreInitRequest{}}
-	initResult := executeAndGetStatus(e.runner, initSpecDataStoreMessage)
+	initResult := executeAndGetStatus(e.runner, e.log, initSpecDataStoreMessage)
 	if initResult.GetFailed() {
 		return fmt.Errorf("Spec data store didn't get initialized : %s\n", initResult.GetErrorMessage())
 	}
@@ -91,7 +97,7 @@ func (e *specExecutor) executeAfterSpecHook() *gauge_messages.ProtoExecutionResu
 
 func (e *specExecutor) executeHook(message *gauge_messages.Message, execTimeTracker result.ExecTimeTracker) *gauge_messages.ProtoExecutionResult {
 	e.pluginHandler.NotifyPlugins(message)
-	executionResult := executeAndGetStatus(e.runner, message)
+	executionResult := executeAndGetStatus(e.runner, e.log, message)
 	execTimeTracker.AddExecTime(executionResult.GetExecutionTime())
 	return executionResult
 }
@@ -131,6 +137,7 @@ func (e *specExecutor) execute() *result.SpecResult {
 	if len(e.specification.Scenarios) == 0 {
 		return e.createSkippedSpecResult(fmt.Errorf("No scenarios found in spec: %s\n", e.specification.FileName))
 	}
+	e.setLogContext("", "")
 	e.consoleReporter.SpecStart(specInfo.GetName())
 	beforeSpecHookStatus := e.executeBeforeSpecHook()
 	if beforeSpecHookStatus.GetFailed() {
@@ -151,13 +158,20 @@ func (e *specExecutor) execute() *result.SpecResult {
 		setSpecFailure(e.currentExecutionInfo)
 		handleHookFailure(e.specResult, afterSpecHookStatus, result.AddPostHook, e.consoleReporter)
 	}
+	e.collectSpecOutput()
+	if e.specStdout.Len() > 0 {
+		e.specResult.ProtoSpec.Stdout = proto.String(e.specStdout.String())
+	}
+	if e.specStderr.Len() > 0 {
+		e.specResult.ProtoSpec.Stderr = proto.String(e.specStderr.String())
+	}
 	e.specResult.Skipped = e.specResult.ScenarioSkippedCount > 0
 	e.consoleReporter.SpecEnd()
 	return e.specResult
 }
 
 func (e *specExecutor) createSkippedSpecResult(err error) *result.SpecResult {
-	logger.Errorf(err.Error())
+	e.log.Errorf(err.Error())
 	validationError := newValidationError(&gauge.Step{LineNo: e.specification.Heading.LineNo, LineText: e.specification.Heading.Value},
 		err.Error(), e.specification.FileName, nil)
 	for _, scenario := range e.specification.Scenarios {
@@ -196,7 +210,7 @@ func (e *specExecutor) executeBeforeScenarioHook(scenarioResult *result.Scenario
 func (e *specExecutor) initScenarioDataStore() error {
 	initScenarioDataStoreMessage := &gauge_messages.Message{MessageType: gauge_messages.Message_ScenarioDataStoreInit.Enum(),
 		ScenarioDataStoreInitRequest: &gauge_messages.ScenarioDataStoreInitRequest{}}
-	initResult := executeAndGetStatus(e.runner, initScenarioDataStoreMessage)
+	initResult := executeAndGetStatus(e.runner, e.log, initScenarioDataStoreMessage)
 	if initResult.GetFailed() {
 		return fmt.Errorf("Scenario data store didn't get initialized : %s\n", initResult.GetErrorMessage())
 	}
@@ -226,11 +240,15 @@ func (e *specExecutor) executeScenario(scenario *gauge.Scenario) *result.Scenari
 		e.setSkipInfoInResult(scenarioResult, scenario)
 		return scenarioResult
 	}
+	e.collectSpecOutput()
+	e.scenarioStdout.Reset()
+	e.scenarioStderr.Reset()
 	err := e.initScenarioDataStore()
 	if err != nil {
 		e.handleScenarioDataStoreFailure(scenarioResult, scenario, err)
 		return scenarioResult
 	}
+	e.setLogContext(scenario.Heading.Value, "")
 	e.consoleReporter.ScenarioStart(scenario.Heading.Value)
 	beforeHookExecutionStatus := e.executeBeforeScenarioHook(scenarioResult)
 	if beforeHookExecutionStatus.GetFailed() {
@@ -249,6 +267,13 @@ func (e *specExecutor) executeScenario(scenario *gauge.Scenario) *result.Scenari
 		handleHookFailure(scenarioResult, afterHookExecutionStatus, result.AddPostHook, e.consoleReporter)
 		setScenarioFailure(e.currentExecutionInfo)
 	}
+	e.collectScenarioOutput()
+	if e.scenarioStdout.Len() > 0 {
+		scenarioResult.ProtoScenario.Stdout = proto.String(e.scenarioStdout.String())
+	}
+	if e.scenarioStderr.Len() > 0 {
+		scenarioResult.ProtoScenario.Stderr = proto.String(e.scenarioStderr.String())
+	}
 	e.consoleReporter.ScenarioEnd(scenarioResult.GetFailure())
 
 	return scenarioResult
@@ -467,13 +492,52 @@ func printStatus(executionResult *gauge_messages.ProtoExecutionResult, reporter
 	reporter.Error("Stacktrace: \n%s", executionResult.GetStackTrace())
 }
 
+// setLogContext logs the output of the runner with the spec, and the scenario and step if any, currently being executed
+func (e *specExecutor) setLogContext(scenario, step string) {
+	if e.runner == nil {
+		return
+	}
+	fields := e.log.Fields()
+	if scenario != "" {
+		fields[logger.ScenarioField] = scenario
+	}
+	if step != "" {
+		fields[logger.StepField] = step
+	}
+	e.runner.SetLogContext(fields)
+}
+
+// takeOutput returns what the runner wrote to stdout and stderr since the output was last taken
+func (e *specExecutor) takeOutput() (string, string) {
+	if e.runner == nil {
+		return "", ""
+	}
+	return e.runner.TakeOutput()
+}
+
+// collectSpecOutput adds what the runner wrote outside of the scenarios, e.g. in spec hooks, to the output of the spec
+func (e *specExecutor) collectSpecOutput() {
+	stdout, stderr := e.takeOutput()
+	e.specStdout.WriteString(stdout)
+	e.specStderr.WriteString(stderr)
+}
+
+// collectScenarioOutput adds what the runner wrote outside of the steps, e.g. in hooks, to the output of the scenario
+func (e *specExecutor) collectScenarioOutput() {
+	stdout, stderr := e.takeOutput()
+	e.scenarioStdout.WriteString(stdout)
+	e.scenarioStderr.WriteString(stderr)
+}
+
 func (e *specExecutor) executeStep(protoStep *gauge_messages.ProtoStep) bool {
 	stepRequest := e.createStepRequest(protoStep)
 	stepText := formatter.FormatStep(parser.CreateStepFromStepRequest(stepRequest))
 	e.consoleReporter.StepStart(stepText)
+	e.collectScenarioOutput()
 
 	protoStepExecResult := &gauge_messages.ProtoStepExecutionResult{}
 	e.currentExecutionInfo.CurrentStep = &gauge_messages.StepInfo{Step: stepRequest, IsFailed: proto.Bool(false)}
+	e.setLogContext(e.currentExecutionInfo.GetCurrentScenario().GetName(), stepText)
 
 	beforeHookStatus := e.executeBeforeStepHook()
 	if beforeHookStatus.GetFailed() {
@@ -483,7 +547,7 @@ func (e *specExecutor) executeStep(protoStep *gauge_messages.ProtoStep) bool {
 		printStatus(beforeHookStatus, e.consoleReporter)
 	} else {
 		executeStepMessage := &gauge_messages.Message{MessageType: gauge_messages.Message_ExecuteStep.Enum(), ExecuteStepRequest: stepRequest}
-		stepExecutionStatus := executeAndGetStatus(e.runner, executeStepMessage)
+		stepExecutionStatus := executeAndGetStatus(e.runner, e.log, executeStepMessage)
 		if stepExecutionStatus.GetFailed() {
 			setStepFailure(e.currentExecutionInfo, e.consoleReporter)
 		}
@@ -500,6 +564,13 @@ func (e *specExecutor) executeStep(protoStep *gauge_messages.ProtoStep) bool {
 	protoStepExecResult.ExecutionResult.Message = afterStepHookStatus.Message
 	protoStepExecResult.Skipped = protoStep.StepExecutionResult.Skipped
 	protoStepExecResult.SkippedReason = protoStep.StepExecutionResult.SkippedReason
+	stdout, stderr := e.takeOutput()
+	if stdout != "" {
+		protoStepExecResult.Stdout = proto.String(stdout)
+	}
+	if stderr != "" {
+		protoStepExecResult.Stderr = proto.String(stderr)
+	}
 	protoStep.StepExecutionResult = protoStepExecResult
 
 	stepFailed := protoStep.GetStepExecutionResult().GetExecutionResult().GetFailed()
@@ -508,6 +579,7 @@ func (e *specExecutor) executeStep(protoStep *gauge_messages.ProtoStep) bool {
 		e.consoleReporter.Error("Failed Step: %s", e.currentExecutionInfo.CurrentStep.Step.GetActualStepText())
 		e.consoleReporter.Error("Error Message: %s", strings.TrimSpace(result.GetErrorMessage()))
 		e.consoleReporter.Error("Stacktrace: \n%s", result.GetStackTrace())
+		e.consoleReporter.StepFailureOutput(stdout, stderr)
 	}
 	e.consoleReporter.StepEnd(stepFailed)
 	return stepFailed
@@ -528,14 +600,14 @@ func (e *specExecutor) executeBeforeStepHook() *gauge_messages.ProtoExecutionRes
 	message := &gauge_messages.Message{MessageType: gauge_messages.Message_StepExecutionStarting.Enum(),
 		StepExecutionStartingRequest: &gauge_messages.StepExecutionStartingRequest{CurrentExecutionInfo: e.currentExecutionInfo}}
 	e.pluginHandler.NotifyPlugins(message)
-	return executeAndGetStatus(e.runner, message)
+	return executeAndGetStatus(e.runner, e.log, message)
 }
 
 func (e *specExecutor) executeAfterStepHook() *gauge_messages.ProtoExecutionResult {
 	message := &gauge_messages.Message{MessageType: gauge_messages.Message_StepExecutionEnding.Enum(),
 		StepExecutionEndingRequest: &gauge_messages.StepExecutionEndingRequest{CurrentExecutionInfo: e.currentExecutionInfo}}
 	e.pluginHandler.NotifyPlugins(message)
-	return executeAndGetStatus(e.runner, message)
+	return executeAndGetStatus(e.runner, e.log, message)
 }
 
 func (e *specExecutor) createStepRequest(protoStep *gauge_messages.ProtoStep) *gauge_messages.ExecuteStepRequest {
@@ -548,8 +620,8 @@ func (e *specExecutor) getCurrentDataTableValueFor(columnName string) string {
 	return e.specification.DataTable.Table.Get(columnName)[e.currentTableRow].Value
 }
 
-func executeAndGetStatus(runner *runner.TestRunner, message *gauge_messages.Message) *gauge_messages.ProtoExecutionResult {
-	response, err := conn.GetResponseForGaugeMessage(message, runner.Connection)
+func executeAndGetStatus(runner *runner.TestRunner, log *logger.Entry, message *gauge_messages.Message) *gauge_messages.ProtoExecutionResult {
+	response, err := runner.Connection.GetResponse(message)
 	if err != nil {
 		return &gauge_messages.ProtoExecutionResult{Failed: proto.Bool(true), ErrorMessage: proto.String(err.Error())}
 	}
@@ -558,13 +630,13 @@ func executeAndGetStatus(runner *runner.TestRunner, message *gauge_messages.Mess
 		executionResult := response.GetExecutionStatusResponse().GetExecutionResult()
 		if executionResult == nil {
 			errMsg := "ProtoExecutionResult obtained is nil"
-			logger.Errorf(errMsg)
+			log.Errorf(errMsg)
 			return errorResult(errMsg)
 		}
 		return executionResult
 	}
 	errMsg := fmt.Sprintf("Expected ExecutionStatusResponse. Obtained: %s", response.GetMessageType())
-	logger.Errorf(errMsg)
+	log.Errorf(errMsg)
 	return errorResult(errMsg)
 }
 
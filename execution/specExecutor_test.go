@@ -19,13 +19,20 @@ package execution
 
 import (
 	"fmt"
+	"io"
+	"net"
 	"path/filepath"
 
+	"github.com/getgauge/gauge/conn"
 	"github.com/getgauge/gauge/execution/result"
 	"github.com/getgauge/gauge/gauge"
 	"github.com/getgauge/gauge/gauge_messages"
+	"github.com/getgauge/gauge/logger"
 	"github.com/getgauge/gauge/parser"
+	"github.com/getgauge/gauge/plugin"
 	"github.com/getgauge/gauge/reporter"
+	"github.com/getgauge/gauge/runner"
+	"github.com/golang/protobuf/proto"
 	. "gopkg.in/check.v1"
 )
 
@@ -109,7 +116,7 @@ func (s *MySuite) TestResolveConceptToProtoConceptItem(c *C) {
 
 	spec, _ := new(parser.SpecParser).Parse(specText, conceptDictionary)
 
-	specExecutor := newSpecExecutor(spec, nil, nil, indexRange{start: 0, end: 0}, nil, nil)
+	specExecutor := newSpecExecutor(spec, nil, nil, indexRange{start: 0, end: 0}, nil, nil, logger.WithFields(logger.Fields{}))
 	specExecutor.errMap = &validationErrMaps{make(map[*gauge.Specification][]*stepValidationError), make(map[*gauge.Scenario][]*stepValidationError), make(map[*gauge.Step]*stepValidationError)}
 	protoConcept := specExecutor.resolveToProtoConceptItem(*spec.Scenarios[0].Steps[0]).GetConcept()
 
@@ -147,7 +154,7 @@ func (s *MySuite) TestResolveNestedConceptToProtoConceptItem(c *C) {
 	specParser := new(parser.SpecParser)
 	spec, _ := specParser.Parse(specText, conceptDictionary)
 
-	specExecutor := newSpecExecutor(spec, nil, nil, indexRange{start: 0, end: 0}, nil, nil)
+	specExecutor := newSpecExecutor(spec, nil, nil, indexRange{start: 0, end: 0}, nil, nil, logger.WithFields(logger.Fields{}))
 	specExecutor.errMap = &validationErrMaps{make(map[*gauge.Specification][]*stepValidationError), make(map[*gauge.Scenario][]*stepValidationError), make(map[*gauge.Step]*stepValidationError)}
 	protoConcept := specExecutor.resolveToProtoConceptItem(*spec.Scenarios[0].Steps[0]).GetConcept()
 	checkConceptParameterValuesInOrder(c, protoConcept, "456", "foo", "9900")
@@ -194,7 +201,7 @@ func (s *MySuite) TestResolveToProtoConceptItemWithDataTable(c *C) {
 	specParser := new(parser.SpecParser)
 	spec, _ := specParser.Parse(specText, conceptDictionary)
 
-	specExecutor := newSpecExecutor(spec, nil, nil, indexRange{start: 0, end: 0}, nil, nil)
+	specExecutor := newSpecExecutor(spec, nil, nil, indexRange{start: 0, end: 0}, nil, nil, logger.WithFields(logger.Fields{}))
 
 	// For first row
 	specExecutor.currentTableRow = 0
@@ -305,7 +312,7 @@ func (s *MySuite) TestToGetDataTableRowFromInvalidInput(c *C) {
 func (s *MySuite) TestCreateSkippedSpecResult(c *C) {
 	spec := &gauge.Specification{Heading: &gauge.Heading{LineNo: 0, Value: "SPEC_HEADING"}, FileName: "FILE"}
 
-	specExecutor := newSpecExecutor(spec, nil, nil, indexRange{start: 0, end: 0}, nil, nil)
+	specExecutor := newSpecExecutor(spec, nil, nil, indexRange{start: 0, end: 0}, nil, nil, logger.WithFields(logger.Fields{}))
 	specExecutor.errMap = &validationErrMaps{make(map[*gauge.Specification][]*stepValidationError), make(map[*gauge.Scenario][]*stepValidationError), make(map[*gauge.Step]*stepValidationError)}
 	specExecutor.consoleReporter = reporter.Current()
 	specExecutor.specResult = &result.SpecResult{}
@@ -329,7 +336,7 @@ func (s *MySuite) TestCreateSkippedSpecResultWithScenarios(c *C) {
 
 	spec, _ := new(parser.SpecParser).Parse(specText, gauge.NewConceptDictionary())
 	spec.FileName = "FILE"
-	specExecutor := newSpecExecutor(spec, nil, nil, indexRange{start: 0, end: 0}, nil, nil)
+	specExecutor := newSpecExecutor(spec, nil, nil, indexRange{start: 0, end: 0}, nil, nil, logger.WithFields(logger.Fields{}))
 	specExecutor.errMap = &validationErrMaps{make(map[*gauge.Specification][]*stepValidationError), make(map[*gauge.Scenario][]*stepValidationError), make(map[*gauge.Step]*stepValidationError)}
 	specExecutor.consoleReporter = reporter.Current()
 	specExecutor.specResult = &result.SpecResult{ProtoSpec: &gauge_messages.ProtoSpec{}}
@@ -349,3 +356,69 @@ func (s *MySuite) TestCreateSkippedSpecResultWithScenarios(c *C) {
 	c.Assert(specExecutor.errMap.scenarioErrs[spec.Scenarios[0]][0].step.LineNo, Equals, 1)
 	c.Assert(specExecutor.errMap.scenarioErrs[spec.Scenarios[0]][0].step.LineText, Equals, "A spec heading")
 }
+
+// outputRecorder is a reporter which records the output it is given for failed steps
+type outputRecorder struct {
+	failureOutput []string
+}
+
+func (r *outputRecorder) SpecStart(string)             {}
+func (r *outputRecorder) SpecEnd()                     {}
+func (r *outputRecorder) ScenarioStart(string)         {}
+func (r *outputRecorder) ScenarioEnd(bool)             {}
+func (r *outputRecorder) StepStart(string)             {}
+func (r *outputRecorder) StepEnd(bool)                 {}
+func (r *outputRecorder) ConceptStart(string)          {}
+func (r *outputRecorder) ConceptEnd(bool)              {}
+func (r *outputRecorder) DataTable(string)             {}
+func (r *outputRecorder) Error(string, ...interface{}) {}
+func (r *outputRecorder) Write(b []byte) (int, error)  { return len(b), nil }
+func (r *outputRecorder) StepFailureOutput(stdout, stderr string) {
+	r.failureOutput = append(r.failureOutput, stdout)
+}
+
+// startOutputtingRunner starts a fake runner which passes every request but the steps named "failing step". Each step
+// writes its name to stdout before its response, and "done" only after the response to its after step hook, as the
+// copy of a pipe can lag behind.
+func startOutputtingRunner() *runner.TestRunner {
+	gaugeEnd, runnerEnd := net.Pipe()
+	testRunner := &runner.TestRunner{Connection: conn.NewMessageConnection(gaugeEnd)}
+	stdout, _ := testRunner.CaptureOutput()
+	go serveMessages(runnerEnd, func(message *gauge_messages.Message, connection net.Conn) {
+		failed := false
+		if message.GetMessageType() == gauge_messages.Message_ExecuteStep {
+			stepText := message.GetExecuteStepRequest().GetActualStepText()
+			failed = stepText == "failing step"
+			io.WriteString(stdout, stepText+"\n")
+		}
+		data, _ := proto.Marshal(&gauge_messages.Message{MessageId: message.MessageId, MessageType: gauge_messages.Message_ExecutionStatusResponse.Enum(),
+			ExecutionStatusResponse: &gauge_messages.ExecutionStatusResponse{ExecutionResult: &gauge_messages.ProtoExecutionResult{Failed: proto.Bool(failed), ExecutionTime: proto.Int64(1)}}})
+		conn.Write(connection, data)
+		if message.GetMessageType() == gauge_messages.Message_StepExecutionEnding {
+			io.WriteString(stdout, "done\n")
+		}
+	})
+	return testRunner
+}
+
+func (s *MySuite) TestStepOutputIsAttributedToTheStepWhichWroteIt(c *C) {
+	testRunner := startOutputtingRunner()
+	defer testRunner.Connection.Close()
+	recorder := &outputRecorder{}
+	specExecutor := newSpecExecutor(&gauge.Specification{}, testRunner, &plugin.Handler{}, indexRange{}, recorder, nil, logger.WithFields(logger.Fields{}))
+	specExecutor.currentExecutionInfo = &gauge_messages.ExecutionInfo{
+		CurrentSpec:     &gauge_messages.SpecInfo{Name: proto.String("spec"), FileName: proto.String("spec.spec"), IsFailed: proto.Bool(false)},
+		CurrentScenario: &gauge_messages.ScenarioInfo{Name: proto.String("scenario"), IsFailed: proto.Bool(false)},
+	}
+	steps := []*gauge_messages.ProtoStep{
+		{ActualText: proto.String("failing step"), ParsedText: proto.String("failing step"), StepExecutionResult: &gauge_messages.ProtoStepExecutionResult{}},
+		{ActualText: proto.String("passing step"), ParsedText: proto.String("passing step"), StepExecutionResult: &gauge_messages.ProtoStepExecutionResult{}},
+	}
+
+	c.Assert(specExecutor.executeStep(steps[0]), Equals, true)
+	c.Assert(specExecutor.executeStep(steps[1]), Equals, false)
+
+	c.Assert(steps[0].GetStepExecutionResult().GetStdout(), Equals, "failing step\ndone\n")
+	c.Assert(steps[1].GetStepExecutionResult().GetStdout(), Equals, "passing step\ndone\n")
+	c.Assert(recorder.failureOutput, DeepEquals, []string{"failing step\ndone\n"})
+}
@@ -21,9 +21,10 @@ import (
 	"errors"
 	"strconv"
 	"strings"
+	"sync"
+	"time"
 
 	"github.com/getgauge/gauge/config"
-	"github.com/getgauge/gauge/conn"
 	"github.com/getgauge/gauge/gauge"
 	"github.com/getgauge/gauge/gauge_messages"
 	"github.com/getgauge/gauge/manifest"
@@ -38,12 +39,9 @@ type validator struct {
 	conceptsDictionary *gauge.ConceptDictionary
 }
 
-type specValidator struct {
-	specification        *gauge.Specification
-	runner               *runner.TestRunner
-	conceptsDictionary   *gauge.ConceptDictionary
-	stepValidationErrors []*stepValidationError
-	stepValidationCache  map[string]*stepValidationError
+// stepCollector collects the steps of a specification to validate, with the steps of concepts in place of the concepts.
+type stepCollector struct {
+	steps []*gauge.Step
 }
 
 type stepValidationError struct {
@@ -68,13 +66,27 @@ func newValidator(manifest *manifest.Manifest, specsToExecute []*gauge.Specifica
 }
 
 func (v *validator) validate() validationErrors {
+	stepsOfSpecs := make(map[*gauge.Specification][]*gauge.Step)
+	var uniqueSteps []*gauge.Step
+	isCollected := make(map[string]bool)
+	for _, spec := range v.specsToExecute {
+		collector := &stepCollector{}
+		spec.Traverse(collector)
+		stepsOfSpecs[spec] = collector.steps
+		for _, step := range collector.steps {
+			if !isCollected[step.Value] {
+				isCollected[step.Value] = true
+				uniqueSteps = append(uniqueSteps, step)
+			}
+		}
+	}
+	results := v.validateSteps(uniqueSteps)
 	validationStatus := make(validationErrors)
-	specValidator := &specValidator{runner: v.runner, conceptsDictionary: v.conceptsDictionary, stepValidationCache: make(map[string]*stepValidationError)}
 	for _, spec := range v.specsToExecute {
-		specValidator.specification = spec
-		validationErrors := specValidator.validate()
-		if len(validationErrors) != 0 {
-			validationStatus[spec] = validationErrors
+		for _, step := range stepsOfSpecs[spec] {
+			if err := results[step.Value]; err != nil {
+				validationStatus[spec] = append(validationStatus[spec], newValidationError(step, err.message, spec.FileName, err.errorType))
+			}
 		}
 	}
 	if len(validationStatus) > 0 {
@@ -83,49 +95,98 @@ func (v *validator) validate() validationErrors {
 	return nil
 }
 
-func (v *specValidator) validate() []*stepValidationError {
-	v.specification.Traverse(v)
-	return v.stepValidationErrors
+// validateSteps validates the steps concurrently, returning the validation errors by step value.
+func (v *validator) validateSteps(steps []*gauge.Step) map[string]*stepValidationError {
+	results := make(map[string]*stepValidationError)
+	var mutex sync.Mutex
+	var wg sync.WaitGroup
+	stepsChan := make(chan *gauge.Step)
+	workers := config.RunnerRequestsInFlight()
+	if len(steps) < workers {
+		workers = len(steps)
+	}
+	timeout := newProgressTimeout(config.RunnerRequestTimeout())
+	defer timeout.stop()
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for step := range stepsChan {
+				err := v.validateStep(step, timeout)
+				mutex.Lock()
+				results[step.Value] = err
+				mutex.Unlock()
+			}
+		}()
+	}
+	for _, step := range steps {
+		stepsChan <- step
+	}
+	close(stepsChan)
+	wg.Wait()
+	return results
+}
+
+// progressTimeout times out the requests in flight when the runner has answered none of them within the timeout.
+// Runners handle requests one at a time, so a request queued behind others must not time out while the runner is
+// still answering those.
+type progressTimeout struct {
+	mutex    sync.Mutex
+	duration time.Duration
+	timer    *time.Timer
+	expired  chan time.Time
+}
+
+func newProgressTimeout(duration time.Duration) *progressTimeout {
+	t := &progressTimeout{duration: duration, expired: make(chan time.Time)}
+	t.timer = time.AfterFunc(duration, func() { close(t.expired) })
+	return t
+}
+
+// progressed restarts the timeout, unless it has already expired.
+func (t *progressTimeout) progressed() {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	if t.timer.Stop() {
+		t.timer.Reset(t.duration)
+	}
+}
+
+func (t *progressTimeout) stop() {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	t.timer.Stop()
 }
 
-func (v *specValidator) Step(step *gauge.Step) {
+func (c *stepCollector) Step(step *gauge.Step) {
 	if step.IsConcept {
 		for _, conceptStep := range step.ConceptSteps {
-			v.Step(conceptStep)
+			c.Step(conceptStep)
 		}
 	} else {
-		value, ok := v.stepValidationCache[step.Value]
-		if !ok {
-			err := v.validateStep(step)
-			if err != nil {
-				v.stepValidationErrors = append(v.stepValidationErrors, err)
-			}
-			v.stepValidationCache[step.Value] = err
-		} else if value != nil {
-			v.stepValidationErrors = append(v.stepValidationErrors,
-				newValidationError(step, value.message, v.specification.FileName, value.errorType))
-		}
+		c.steps = append(c.steps, step)
 	}
 }
 
 var invalidResponse gauge_messages.StepValidateResponse_ErrorType = -1
 
-func (v *specValidator) validateStep(step *gauge.Step) *stepValidationError {
+func (v *validator) validateStep(step *gauge.Step, timeout *progressTimeout) *stepValidationError {
 	message := &gauge_messages.Message{MessageType: gauge_messages.Message_StepValidateRequest.Enum(),
 		StepValidateRequest: &gauge_messages.StepValidateRequest{StepText: proto.String(step.Value), NumberOfParameters: proto.Int(len(step.Args))}}
-	response, err := conn.GetResponseForMessageWithTimeout(message, v.runner.Connection, config.RunnerRequestTimeout())
+	response, err := v.runner.Connection.GetResponseUntil(message, timeout.expired)
 	if err != nil {
-		return newValidationError(step, err.Error(), v.specification.FileName, nil)
+		return newValidationError(step, err.Error(), "", nil)
 	}
+	timeout.progressed()
 	if response.GetMessageType() == gauge_messages.Message_StepValidateResponse {
 		validateResponse := response.GetStepValidateResponse()
 		if !validateResponse.GetIsValid() {
 			message := getMessage(validateResponse.ErrorType.String())
-			return newValidationError(step, message, v.specification.FileName, validateResponse.ErrorType)
+			return newValidationError(step, message, "", validateResponse.ErrorType)
 		}
 		return nil
 	}
-	return newValidationError(step, "Invalid response from runner for Validation request", v.specification.FileName, &invalidResponse)
+	return newValidationError(step, "Invalid response from runner for Validation request", "", &invalidResponse)
 }
 
 func getMessage(message string) string {
@@ -133,39 +194,38 @@ func getMessage(message string) string {
 	return strings.ToUpper(lower[:1]) + lower[1:]
 }
 
-func (v *specValidator) ContextStep(step *gauge.Step) {
-	v.Step(step)
+func (c *stepCollector) ContextStep(step *gauge.Step) {
+	c.Step(step)
 }
 
-func (v *specValidator) TearDown(step *gauge.TearDown) {
+func (c *stepCollector) TearDown(step *gauge.TearDown) {
 }
 
-func (v *specValidator) SpecHeading(heading *gauge.Heading) {
-	v.stepValidationErrors = make([]*stepValidationError, 0)
+func (c *stepCollector) SpecHeading(heading *gauge.Heading) {
 }
 
-func (v *specValidator) SpecTags(tags *gauge.Tags) {
+func (c *stepCollector) SpecTags(tags *gauge.Tags) {
 }
 
-func (v *specValidator) ScenarioTags(tags *gauge.Tags) {
+func (c *stepCollector) ScenarioTags(tags *gauge.Tags) {
 
 }
 
-func (v *specValidator) DataTable(dataTable *gauge.Table) {
+func (c *stepCollector) DataTable(dataTable *gauge.Table) {
 
 }
 
-func (v *specValidator) Scenario(scenario *gauge.Scenario) {
+func (c *stepCollector) Scenario(scenario *gauge.Scenario) {
 
 }
 
-func (v *specValidator) ScenarioHeading(heading *gauge.Heading) {
+func (c *stepCollector) ScenarioHeading(heading *gauge.Heading) {
 }
 
-func (v *specValidator) Comment(comment *gauge.Comment) {
+func (c *stepCollector) Comment(comment *gauge.Comment) {
 }
 
-func (v *specValidator) ExternalDataTable(dataTable *gauge.DataTable) {
+func (c *stepCollector) ExternalDataTable(dataTable *gauge.DataTable) {
 
 }
 
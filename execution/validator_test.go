@@ -0,0 +1,161 @@
+// Copyright 2015 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+
+package execution
+
+import (
+	"bytes"
+	"net"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/getgauge/gauge/conn"
+	"github.com/getgauge/gauge/gauge"
+	"github.com/getgauge/gauge/gauge_messages"
+	"github.com/getgauge/gauge/parser"
+	"github.com/getgauge/gauge/runner"
+	"github.com/golang/protobuf/proto"
+	. "gopkg.in/check.v1"
+)
+
+// fakeRunner answers step validation requests one at a time, taking delay for each, and counts the requests for each
+// step.
+type fakeRunner struct {
+	mutex        sync.Mutex
+	requests     map[string]int
+	invalidSteps map[string]bool
+	delay        time.Duration
+}
+
+func (r *fakeRunner) serve(connection net.Conn) {
+	serveMessages(connection, r.respond)
+}
+
+// serveMessages calls respond with each message read from the connection, until it is closed.
+func serveMessages(connection net.Conn, respond func(*gauge_messages.Message, net.Conn)) {
+	buffer := new(bytes.Buffer)
+	data := make([]byte, 8192)
+	for {
+		n, err := connection.Read(data)
+		if err != nil {
+			return
+		}
+		buffer.Write(data[0:n])
+		for {
+			messageLength, bytesRead := proto.DecodeVarint(buffer.Bytes())
+			if bytesRead == 0 || uint64(buffer.Len()-bytesRead) < messageLength {
+				break
+			}
+			message := &gauge_messages.Message{}
+			proto.Unmarshal(buffer.Bytes()[bytesRead:bytesRead+int(messageLength)], message)
+			buffer.Next(bytesRead + int(messageLength))
+			respond(message, connection)
+		}
+	}
+}
+
+func (r *fakeRunner) respond(message *gauge_messages.Message, connection net.Conn) {
+	stepText := message.GetStepValidateRequest().GetStepText()
+	time.Sleep(r.delay)
+	r.mutex.Lock()
+	r.requests[stepText]++
+	r.mutex.Unlock()
+	response := &gauge_messages.StepValidateResponse{IsValid: proto.Bool(!r.invalidSteps[stepText])}
+	if r.invalidSteps[stepText] {
+		response.ErrorType = gauge_messages.StepValidateResponse_STEP_IMPLEMENTATION_NOT_FOUND.Enum()
+	}
+	data, _ := proto.Marshal(&gauge_messages.Message{MessageId: message.MessageId, MessageType: gauge_messages.Message_StepValidateResponse.Enum(), StepValidateResponse: response})
+	conn.Write(connection, data)
+}
+
+func startFakeRunner(invalidSteps ...string) (*fakeRunner, *runner.TestRunner) {
+	fake := &fakeRunner{requests: make(map[string]int), invalidSteps: make(map[string]bool)}
+	for _, step := range invalidSteps {
+		fake.invalidSteps[step] = true
+	}
+	gaugeEnd, runnerEnd := net.Pipe()
+	go fake.serve(runnerEnd)
+	return fake, &runner.TestRunner{Connection: conn.NewMessageConnection(gaugeEnd)}
+}
+
+func parseSpecFor(c *C, fileName, specText string) *gauge.Specification {
+	spec, result := new(parser.SpecParser).Parse(specText, gauge.NewConceptDictionary())
+	c.Assert(result.Ok, Equals, true)
+	spec.FileName = fileName
+	return spec
+}
+
+func (s *MySuite) TestValidationRequestsEachStepValueOnce(c *C) {
+	spec1 := parseSpecFor(c, "spec1.spec", "# Spec 1\n* context step\n## Scenario 1\n* step \"a\"\n* unimplemented step\n## Scenario 2\n* step \"b\"\n")
+	spec2 := parseSpecFor(c, "spec2.spec", "# Spec 2\n## Scenario\n* unimplemented step\n* step \"c\"\n* context step\n")
+	fake, testRunner := startFakeRunner("unimplemented step")
+	defer testRunner.Connection.Close()
+
+	errs := newValidator(nil, []*gauge.Specification{spec1, spec2}, testRunner, gauge.NewConceptDictionary()).validate()
+
+	c.Assert(fake.requests, DeepEquals, map[string]int{"context step": 1, "step {}": 1, "unimplemented step": 1})
+	c.Assert(len(errs), Equals, 2)
+	c.Assert(len(errs[spec1]), Equals, 1)
+	c.Assert(errs[spec1][0].step, Equals, spec1.Scenarios[0].Steps[1])
+	c.Assert(errs[spec1][0].fileName, Equals, "spec1.spec")
+	c.Assert(errs[spec1][0].message, Equals, "Step implementation not found")
+	c.Assert(len(errs[spec2]), Equals, 1)
+	c.Assert(errs[spec2][0].step, Equals, spec2.Scenarios[0].Steps[0])
+	c.Assert(errs[spec2][0].fileName, Equals, "spec2.spec")
+}
+
+func (s *MySuite) TestValidationOfValidSpecs(c *C) {
+	spec := parseSpecFor(c, "spec.spec", "# Spec\n## Scenario\n* step\n")
+	_, testRunner := startFakeRunner()
+	defer testRunner.Connection.Close()
+
+	errs := newValidator(nil, []*gauge.Specification{spec}, testRunner, gauge.NewConceptDictionary()).validate()
+
+	c.Assert(errs, IsNil)
+}
+
+func (s *MySuite) TestValidationRequestsQueuedBehindOthersDoNotTimeOut(c *C) {
+	spec := parseSpecFor(c, "spec.spec", "# Spec\n## Scenario\n* step 1\n* step 2\n* step 3\n* step 4\n* step 5\n* step 6\n")
+	fake, testRunner := startFakeRunner()
+	defer testRunner.Connection.Close()
+	// Every request would time out after the third one if they were timed from when they were sent
+	fake.delay = 40 * time.Millisecond
+	oldTimeout := os.Getenv("runner_request_timeout")
+	os.Setenv("runner_request_timeout", "100")
+	defer os.Setenv("runner_request_timeout", oldTimeout)
+
+	errs := newValidator(nil, []*gauge.Specification{spec}, testRunner, gauge.NewConceptDictionary()).validate()
+
+	c.Assert(errs, IsNil)
+	c.Assert(len(fake.requests), Equals, 6)
+}
+
+func (s *MySuite) TestValidationTimesOutWhenTheRunnerStopsAnswering(c *C) {
+	spec := parseSpecFor(c, "spec.spec", "# Spec\n## Scenario\n* step 1\n* step 2\n")
+	fake, testRunner := startFakeRunner()
+	defer testRunner.Connection.Close()
+	fake.delay = 200 * time.Millisecond
+	oldTimeout := os.Getenv("runner_request_timeout")
+	os.Setenv("runner_request_timeout", "50")
+	defer os.Setenv("runner_request_timeout", oldTimeout)
+
+	errs := newValidator(nil, []*gauge.Specification{spec}, testRunner, gauge.NewConceptDictionary()).validate()
+
+	c.Assert(len(errs[spec]), Equals, 2)
+	c.Assert(errs[spec][0].message, Equals, "Request Timeout")
+}
@@ -1,8 +1,14 @@
 package filter
 
 import (
+	"path/filepath"
+	"strings"
+
+	"github.com/getgauge/common"
+	"github.com/getgauge/gauge/config"
 	"github.com/getgauge/gauge/gauge"
 	"github.com/getgauge/gauge/parser"
+	"github.com/getgauge/gauge/util"
 )
 
 var ExecuteTags string
@@ -28,25 +34,54 @@ func applyFilters(specsToExecute []*gauge.Specification, filters []specsFilter)
 	return specsToExecute
 }
 
+// specsFromArgs parses the spec files of all the args at once so that they share the parser workers, and returns the
+// specs in the order of the args. Only the scenario at the index is kept for indexed args.
 func specsFromArgs(conceptDictionary *gauge.ConceptDictionary, args []string) []*gauge.Specification {
-	allSpecs := make([]*gauge.Specification, 0)
-	specs := make([]*gauge.Specification, 0)
-	var specParseResults []*parser.ParseResult
-	for _, arg := range args {
+	specFiles := make([]string, 0)
+	specFilesOfArgs := make([]int, len(args))
+	for i, arg := range args {
 		specSource := arg
-		if isIndexedSpec(specSource) {
-			specs, specParseResults = getSpecWithScenarioIndex(specSource, conceptDictionary)
-		} else {
-			specs, specParseResults = parser.FindSpecs(specSource, conceptDictionary)
+		if isIndexedSpec(arg) {
+			specSource, _ = GetIndexedSpecName(arg)
+		}
+		files := util.GetSpecFiles(specSource)
+		specFilesOfArgs[i] = len(files)
+		specFiles = append(specFiles, files...)
+	}
+	specs, specParseResults := parser.ParseSpecFiles(specFiles, conceptDictionary)
+	// Exits if a spec failed to parse, so there is a spec for each spec file
+	parser.HandleParseResult(specParseResults...)
+	allSpecs := make([]*gauge.Specification, 0)
+	for i, arg := range args {
+		argSpecs := specs[:specFilesOfArgs[i]]
+		specs = specs[specFilesOfArgs[i]:]
+		if isIndexedSpec(arg) {
+			_, indexToFilter := GetIndexedSpecName(arg)
+			argSpecs = filterSpecsItems(argSpecs, newScenarioIndexFilterToRetain(indexToFilter))
 		}
-		parser.HandleParseResult(specParseResults...)
-		allSpecs = append(allSpecs, specs...)
+		allSpecs = append(allSpecs, argSpecs...)
 	}
 	return allSpecs
 }
 
-func getSpecWithScenarioIndex(specSource string, conceptDictionary *gauge.ConceptDictionary) ([]*gauge.Specification, []*parser.ParseResult) {
-	specName, indexToFilter := GetIndexedSpecName(specSource)
-	parsedSpecs, parseResult := parser.FindSpecs(specName, conceptDictionary)
-	return filterSpecsItems(parsedSpecs, newScenarioIndexFilterToRetain(indexToFilter)), parseResult
+// IsPartialRun tells if only some of the specs or scenarios of the project are executed, i.e. the args do not include
+// the specs directory of the project or the specs are filtered by tags or a group.
+func IsPartialRun(args []string) bool {
+	if ExecuteTags != "" || Distribute != -1 {
+		return true
+	}
+	specsDir, err := filepath.Abs(filepath.Join(config.ProjectRoot, common.SpecsDirectoryName))
+	if err != nil {
+		return true
+	}
+	for _, arg := range args {
+		if isIndexedSpec(arg) {
+			continue
+		}
+		dir, err := filepath.Abs(arg)
+		if err == nil && (dir == specsDir || strings.HasPrefix(specsDir, dir+string(filepath.Separator))) {
+			return false
+		}
+	}
+	return true
 }
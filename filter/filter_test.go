@@ -0,0 +1,71 @@
+// Copyright 2015 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+
+package filter
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/getgauge/gauge/config"
+	"github.com/getgauge/gauge/gauge"
+	. "gopkg.in/check.v1"
+)
+
+func (s *MySuite) TestSpecsFromArgsAreInTheOrderOfTheArgs(c *C) {
+	dir, err := ioutil.TempDir("", "gaugeSpecsFromArgs")
+	c.Assert(err, IsNil)
+	defer os.RemoveAll(dir)
+	writeSpec := func(name, text string) string {
+		file := filepath.Join(dir, name)
+		c.Assert(ioutil.WriteFile(file, []byte(text), 0644), IsNil)
+		return file
+	}
+	specA := writeSpec("a.spec", "A\n=\nFirst\n-----\n* step\n")
+	specB := writeSpec("b.spec", "B\n=\nFirst\n-----\n* step\nSecond\n------\n* step\n")
+	specC := writeSpec("c.spec", "C\n=\nFirst\n-----\n* step\n")
+
+	specs := specsFromArgs(gauge.NewConceptDictionary(), []string{specC, specB + ":1", specA})
+
+	c.Assert(len(specs), Equals, 3)
+	c.Assert(specs[0].FileName, Equals, specC)
+	c.Assert(specs[1].FileName, Equals, specB)
+	c.Assert(len(specs[1].Scenarios), Equals, 1)
+	c.Assert(specs[1].Scenarios[0].Heading.Value, Equals, "Second")
+	c.Assert(specs[2].FileName, Equals, specA)
+}
+
+func (s *MySuite) TestRunOfTheSpecsDirectoryIsNotPartial(c *C) {
+	oldRoot, oldDistribute := config.ProjectRoot, Distribute
+	config.ProjectRoot, Distribute = "project", -1
+	defer func() { config.ProjectRoot, Distribute = oldRoot, oldDistribute }()
+
+	c.Assert(IsPartialRun([]string{filepath.Join("project", "specs")}), Equals, false)
+	c.Assert(IsPartialRun([]string{"project"}), Equals, false)
+	c.Assert(IsPartialRun([]string{filepath.Join("project", "specs", "a.spec")}), Equals, true)
+	c.Assert(IsPartialRun([]string{filepath.Join("project", "specs", "a.spec:1")}), Equals, true)
+	c.Assert(IsPartialRun([]string{filepath.Join("project", "spec")}), Equals, true)
+}
+
+func (s *MySuite) TestRunFilteredByTagsIsPartial(c *C) {
+	oldRoot, oldDistribute := config.ProjectRoot, Distribute
+	config.ProjectRoot, Distribute, ExecuteTags = "project", -1, "smoke"
+	defer func() { config.ProjectRoot, Distribute, ExecuteTags = oldRoot, oldDistribute, "" }()
+
+	c.Assert(IsPartialRun([]string{filepath.Join("project", "specs")}), Equals, true)
+}
@@ -517,6 +517,22 @@ func (s *MySuite) TestToFilterMultipleScenariosByTags(c *C) {
 	c.Assert(specs[0].Scenarios[1].Heading.Value, Equals, "Scenario Heading 3")
 }
 
+func (s *MySuite) TestToFilterByTagsOfConsecutiveTagLines(c *C) {
+	text := "Spec Heading\n============\ntags: spec1\ntags: spec2\n\nScenario Heading 1\n------------------\ntags: smoke\ntags: slow\n* step\n\nScenario Heading 2\n------------------\ntags: slow\n* step\n"
+	parse := func() []*gauge.Specification {
+		spec, result := new(parser.SpecParser).Parse(text, gauge.NewConceptDictionary())
+		c.Assert(result.Ok, Equals, true)
+		return []*gauge.Specification{spec}
+	}
+
+	specs := filterSpecsByTags(parse(), "smoke & slow")
+	c.Assert(len(specs[0].Scenarios), Equals, 1)
+	c.Assert(specs[0].Scenarios[0].Heading.Value, Equals, "Scenario Heading 1")
+
+	specs = filterSpecsByTags(parse(), "spec1 & spec2")
+	c.Assert(len(specs[0].Scenarios), Equals, 2)
+}
+
 func (s *MySuite) TestToFilterScenariosByUnavailableTags(c *C) {
 	myTags := []string{"tag1", "tag2"}
 	tokens := []*parser.Token{
@@ -0,0 +1,95 @@
+// Copyright 2015 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+
+package formatter
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/getgauge/common"
+	"github.com/getgauge/gauge/config"
+	"github.com/getgauge/gauge/logger"
+	"github.com/getgauge/gauge/parser"
+	"github.com/getgauge/gauge/util"
+)
+
+// CheckFormatIn checks that the spec and concept files in the given location are formatted, without changing them.
+// A diff is printed for each file formatting would change, and gauge exits with a non-zero code if there is any.
+func CheckFormatIn(filesLocation string) {
+	c, err := ProjectConfig()
+	if err != nil {
+		logger.Fatalf("Failed to read the formatting rules. %s", err.Error())
+	}
+	diffs, parseResults := checkFormat(util.GetSpecFiles(filesLocation), util.GetConceptFiles(filesLocation), c)
+	for _, diff := range diffs {
+		fmt.Print(diff)
+	}
+	failed := reportParseResults(parseResults)
+	if len(diffs) > 0 {
+		logger.Errorf("%d file(s) are not formatted. Run `gauge --format %s` to format them.", len(diffs), filesLocation)
+	}
+	if failed > 0 {
+		logger.Errorf("%d file(s) could not be checked.", failed)
+	}
+	if len(diffs) > 0 || failed > 0 {
+		os.Exit(1)
+	}
+	logger.Info("All spec and concept files are formatted.")
+}
+
+// reportParseResults logs the errors and warnings of the parse results and returns the number of failed results.
+// Unlike parser.HandleParseResult it does not exit, so the files which are not formatted are still reported.
+func reportParseResults(parseResults []*parser.ParseResult) int {
+	failed := 0
+	for _, result := range parseResults {
+		if !result.Ok {
+			logger.Errorf(result.Error())
+			failed++
+		}
+		for _, warning := range result.Warnings {
+			logger.Warning("%s : %v", result.FileName, warning)
+		}
+	}
+	return failed
+}
+
+// checkFormat returns a unified diff for each of the files which formatting would change.
+func checkFormat(specFiles, conceptFiles []string, c *Config) ([]string, []*parser.ParseResult) {
+	formatted, parseResults := formatFiles(specFiles, conceptFiles, c)
+	var diffs []string
+	for _, fileName := range sortedFileNames(formatted) {
+		original, err := common.ReadFileContents(fileName)
+		if err != nil {
+			parseResults = append(parseResults, &parser.ParseResult{ParseError: &parser.ParseError{Message: err.Error()}, FileName: fileName})
+			continue
+		}
+		if diff := unifiedDiff(relativePath(fileName), original, formatted[fileName]); diff != "" {
+			diffs = append(diffs, diff)
+		}
+	}
+	return diffs, parseResults
+}
+
+func relativePath(fileName string) string {
+	if relPath, err := filepath.Rel(config.ProjectRoot, fileName); err == nil && !strings.HasPrefix(relPath, "..") {
+		return filepath.ToSlash(relPath)
+	}
+	return filepath.ToSlash(fileName)
+}
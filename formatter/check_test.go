@@ -0,0 +1,109 @@
+// Copyright 2015 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+
+package formatter
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	. "gopkg.in/check.v1"
+)
+
+func (s *MySuite) TestUnifiedDiffOfSameTextIsEmpty(c *C) {
+	c.Assert(unifiedDiff("a.spec", "Spec\n====\n", "Spec\n====\n"), Equals, "")
+}
+
+func (s *MySuite) TestUnifiedDiff(c *C) {
+	original := "Spec\n=\n* step 1\n* step 2\n* step 3\n* step 4\n* step 5\n* step 6\n* step 7\n* step 8\n* step 9\n*   step 10\n"
+	formatted := "Spec\n====\n* step 1\n* step 2\n* step 3\n* step 4\n* step 5\n* step 6\n* step 7\n* step 8\n* step 9\n* step 10\n"
+
+	diff := unifiedDiff("specs/a.spec", original, formatted)
+
+	c.Assert(diff, Equals, `--- a/specs/a.spec
++++ b/specs/a.spec
+@@ -1,5 +1,5 @@
+ Spec
+-=
++====
+ * step 1
+ * step 2
+ * step 3
+@@ -9,4 +9,4 @@
+ * step 7
+ * step 8
+ * step 9
+-*   step 10
++* step 10
+`)
+}
+
+func (s *MySuite) TestUnifiedDiffMergesCloseChangesAndMarksMissingNewline(c *C) {
+	diff := unifiedDiff("a.cpt", "a\nb\nc\n", "a\nc\nd")
+
+	c.Assert(diff, Equals, `--- a/a.cpt
++++ b/a.cpt
+@@ -1,3 +1,3 @@
+ a
+-b
+ c
++d
+\ No newline at end of file
+`)
+}
+
+func (s *MySuite) TestCheckFormatReportsOnlyUnformattedFiles(c *C) {
+	dir, err := ioutil.TempDir("", "gaugeFormat")
+	c.Assert(err, IsNil)
+	defer os.RemoveAll(dir)
+	formattedSpec := filepath.Join(dir, "formatted.spec")
+	unformattedSpec := filepath.Join(dir, "unformatted.spec")
+	concept := filepath.Join(dir, "concepts.cpt")
+	c.Assert(ioutil.WriteFile(formattedSpec, []byte("Formatted\n=========\nScenario\n--------\n* step\n"), 0644), IsNil)
+	c.Assert(ioutil.WriteFile(unformattedSpec, []byte("Unformatted\n===\nScenario\n--------\n*    step\n"), 0644), IsNil)
+	c.Assert(ioutil.WriteFile(concept, []byte("# concept with <arg>\n*   step <arg>\n"), 0644), IsNil)
+
+	diffs, parseResults := checkFormat([]string{formattedSpec, unformattedSpec}, []string{concept}, DefaultConfig())
+
+	for _, result := range parseResults {
+		c.Assert(result.ParseError, IsNil)
+	}
+	c.Assert(len(diffs), Equals, 2)
+	c.Assert(diffs[0], Matches, "(?s)--- a/.*concepts.cpt\n.* # concept with <arg>\n-\\*   step <arg>\n\\+\\* step <arg>\n")
+	c.Assert(diffs[1], Matches, "(?s).*-===\n\\+===========\n.*-\\*    step\n\\+\\* step\n")
+}
+
+func (s *MySuite) TestConceptsDefinedInTwoFilesAreNotFormatted(c *C) {
+	dir, err := ioutil.TempDir("", "gaugeFormat")
+	c.Assert(err, IsNil)
+	defer os.RemoveAll(dir)
+	first := filepath.Join(dir, "first.cpt")
+	second := filepath.Join(dir, "second.cpt")
+	other := filepath.Join(dir, "other.cpt")
+	c.Assert(ioutil.WriteFile(first, []byte("# login\n*   open login page\n"), 0644), IsNil)
+	c.Assert(ioutil.WriteFile(second, []byte("# logout\n*   click logout\n# login\n*   sign in\n"), 0644), IsNil)
+	c.Assert(ioutil.WriteFile(other, []byte("# search\n*   type query\n"), 0644), IsNil)
+
+	formatted, parseResults := formatFiles(nil, []string{first, second, other}, DefaultConfig())
+
+	c.Assert(len(parseResults), Equals, 1)
+	c.Assert(parseResults[0].FileName, Equals, second)
+	c.Assert(parseResults[0].ParseError.LineNo, Equals, 3)
+	c.Assert(len(formatted), Equals, 1)
+	c.Assert(formatted[other], Matches, "(?s)# search\n\\* type query\n.*")
+}
@@ -0,0 +1,151 @@
+// Copyright 2015 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+
+package formatter
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// Formatting rules are read from the project properties, e.g. env/default/default.properties.
+const (
+	headingStyleProperty             = "format_heading_style"
+	tableAlignmentProperty           = "format_table_alignment"
+	maxColumnWidthProperty           = "format_table_max_column_width"
+	blankLinesBetweenStepsProperty   = "format_blank_lines_between_steps"
+	blankLinesBeforeScenarioProperty = "format_blank_lines_before_scenario"
+	sortTagsProperty                 = "format_sort_tags"
+	tagsLineLengthProperty           = "format_tags_max_line_length"
+	trailingNewlineProperty          = "format_trailing_newline"
+	modeProperty                     = "format_mode"
+)
+
+const (
+	underlineHeading = "underline"
+	hashHeading      = "hash"
+	alignLeft        = "left"
+	alignRight       = "right"
+	alignCenter      = "center"
+	preserve         = -1
+	rewriteMode      = "rewrite"
+	preserveMode     = "preserve"
+)
+
+// Config holds the formatting rules of a project. The zero values of the optional rules keep the spec as it is.
+type Config struct {
+	// Mode is rewrite to write specs again from what was parsed, or preserve to keep the text of specs as written and
+	// only normalise the layout of headings, steps, tags and tables
+	Mode string
+	// HeadingStyle is underline (Heading followed by === or ---) or hash (# Heading and ## Heading)
+	HeadingStyle string
+	// TableAlignment aligns the cells of tables to the left, right or center of their column
+	TableAlignment string
+	// MaxColumnWidth limits the padding of table columns, cells longer than it are not padded. 0 means no limit.
+	MaxColumnWidth int
+	// BlankLinesBetweenSteps and BlankLinesBeforeScenario are the number of blank lines to keep, -1 keeps them as they are
+	BlankLinesBetweenSteps   int
+	BlankLinesBeforeScenario int
+	SortTags                 bool
+	// TagsLineLength wraps tags over several tags lines longer than it. 0 means no wrapping.
+	TagsLineLength int
+	// TrailingNewline is true to end files with exactly one newline, false to end them without one and nil to keep them
+	TrailingNewline *bool
+}
+
+// DefaultConfig returns the formatting rules used when the project does not configure any.
+func DefaultConfig() *Config {
+	return &Config{Mode: rewriteMode, HeadingStyle: underlineHeading, TableAlignment: alignLeft, BlankLinesBetweenSteps: preserve, BlankLinesBeforeScenario: preserve}
+}
+
+// ProjectConfig reads the formatting rules from the project properties.
+func ProjectConfig() (*Config, error) {
+	c := DefaultConfig()
+	var err error
+	if c.Mode, err = oneOf(modeProperty, c.Mode, rewriteMode, preserveMode); err != nil {
+		return nil, err
+	}
+	if c.HeadingStyle, err = oneOf(headingStyleProperty, c.HeadingStyle, underlineHeading, hashHeading); err != nil {
+		return nil, err
+	}
+	if c.TableAlignment, err = oneOf(tableAlignmentProperty, c.TableAlignment, alignLeft, alignRight, alignCenter); err != nil {
+		return nil, err
+	}
+	if c.MaxColumnWidth, err = intProperty(maxColumnWidthProperty, 0, 0); err != nil {
+		return nil, err
+	}
+	if c.BlankLinesBetweenSteps, err = intProperty(blankLinesBetweenStepsProperty, preserve, preserve); err != nil {
+		return nil, err
+	}
+	if c.BlankLinesBeforeScenario, err = intProperty(blankLinesBeforeScenarioProperty, preserve, preserve); err != nil {
+		return nil, err
+	}
+	if c.TagsLineLength, err = intProperty(tagsLineLengthProperty, 0, 0); err != nil {
+		return nil, err
+	}
+	if value := strings.TrimSpace(os.Getenv(sortTagsProperty)); value != "" {
+		if c.SortTags, err = strconv.ParseBool(value); err != nil {
+			return nil, fmt.Errorf("Invalid value %s for %s, should be true or false", value, sortTagsProperty)
+		}
+	}
+	if value := strings.TrimSpace(os.Getenv(trailingNewlineProperty)); value != "" {
+		trailingNewline, err := strconv.ParseBool(value)
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value %s for %s, should be true or false", value, trailingNewlineProperty)
+		}
+		c.TrailingNewline = &trailingNewline
+	}
+	return c, nil
+}
+
+// ProjectConfigOrDefault returns the formatting rules of the project, falling back to the default rules if they are
+// invalid. It is resolved once by commands which rewrite files as a side effect, like refactorings, and passed on.
+// The commands formatting files report invalid rules through ProjectConfig.
+func ProjectConfigOrDefault() *Config {
+	c, err := ProjectConfig()
+	if err != nil {
+		return DefaultConfig()
+	}
+	return c
+}
+
+func oneOf(property, defaultValue string, allowed ...string) (string, error) {
+	value := strings.ToLower(strings.TrimSpace(os.Getenv(property)))
+	if value == "" {
+		return defaultValue, nil
+	}
+	for _, a := range allowed {
+		if value == a {
+			return value, nil
+		}
+	}
+	return "", fmt.Errorf("Invalid value %s for %s, should be one of %s", value, property, strings.Join(allowed, ", "))
+}
+
+func intProperty(property string, defaultValue, min int) (int, error) {
+	value := strings.TrimSpace(os.Getenv(property))
+	if value == "" {
+		return defaultValue, nil
+	}
+	i, err := strconv.Atoi(value)
+	if err != nil || i < min {
+		return 0, fmt.Errorf("Invalid value %s for %s, should be a number not less than %d", value, property, min)
+	}
+	return i, nil
+}
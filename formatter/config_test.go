@@ -0,0 +1,115 @@
+// Copyright 2015 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+
+package formatter
+
+import (
+	"os"
+
+	"github.com/getgauge/gauge/gauge"
+	"github.com/getgauge/gauge/parser"
+	. "gopkg.in/check.v1"
+)
+
+func formatWith(c *C, specText string, config *Config) string {
+	spec, result := new(parser.SpecParser).Parse(specText, gauge.NewConceptDictionary())
+	c.Assert(result.Ok, Equals, true)
+	return FormatSpecification(spec, config)
+}
+
+func (s *MySuite) TestProjectConfigIsReadFromProperties(c *C) {
+	os.Setenv(headingStyleProperty, "hash")
+	os.Setenv(blankLinesBeforeScenarioProperty, "1")
+	os.Setenv(trailingNewlineProperty, "true")
+	defer os.Unsetenv(headingStyleProperty)
+	defer os.Unsetenv(blankLinesBeforeScenarioProperty)
+	defer os.Unsetenv(trailingNewlineProperty)
+
+	config, err := ProjectConfig()
+
+	c.Assert(err, IsNil)
+	c.Assert(config.HeadingStyle, Equals, hashHeading)
+	c.Assert(config.BlankLinesBeforeScenario, Equals, 1)
+	c.Assert(config.BlankLinesBetweenSteps, Equals, preserve)
+	c.Assert(*config.TrailingNewline, Equals, true)
+}
+
+func (s *MySuite) TestProjectConfigWithInvalidValue(c *C) {
+	os.Setenv(tableAlignmentProperty, "justify")
+	defer os.Unsetenv(tableAlignmentProperty)
+
+	_, err := ProjectConfig()
+
+	c.Assert(err, ErrorMatches, "Invalid value justify for format_table_alignment, should be one of left, right, center")
+}
+
+func (s *MySuite) TestFormattingWithDefaultConfigKeepsBlankLines(c *C) {
+	specText := "Spec\n====\n\n\nScenario\n--------\n* step one\n\n* step two\n\n"
+
+	c.Assert(formatWith(c, specText, DefaultConfig()), Equals, specText)
+}
+
+func (s *MySuite) TestFormattingWithHashHeadingsAndBlankLines(c *C) {
+	config := DefaultConfig()
+	config.HeadingStyle = hashHeading
+	config.BlankLinesBetweenSteps = 0
+	config.BlankLinesBeforeScenario = 1
+	no := false
+	config.TrailingNewline = &no
+
+	formatted := formatWith(c, "Spec\n====\nScenario 1\n----------\n* step one\n\n\n* step two\n\n\n\n## Scenario 2\n* step three\n\n", config)
+
+	c.Assert(formatted, Equals, "# Spec\n\n## Scenario 1\n* step one\n* step two\n\n## Scenario 2\n* step three")
+}
+
+func (s *MySuite) TestFormattingTablesWithAlignmentAndMaxColumnWidth(c *C) {
+	config := DefaultConfig()
+	config.TableAlignment = alignRight
+	config.MaxColumnWidth = 4
+	table := &gauge.Table{}
+	table.AddHeaders([]string{"id", "name"})
+	table.AddRowValues([]string{"1", "a long name"})
+	table.AddRowValues([]string{"22", "bob"})
+
+	c.Assert(formatTable(table, config), Equals, "     |id|name|\n     |--|----|\n     | 1|a long name|\n     |22| bob|\n")
+
+	config.TableAlignment = alignCenter
+	config.MaxColumnWidth = 0
+	c.Assert(formatTable(table, config), Equals, "     |id|   name    |\n     |--|-----------|\n     |1 |a long name|\n     |22|    bob    |\n")
+}
+
+func (s *MySuite) TestFormattingTagsSortedAndWrapped(c *C) {
+	config := DefaultConfig()
+	config.SortTags = true
+	config.TagsLineLength = 20
+
+	formatted := formatTags(&gauge.Tags{Values: []string{"smoke", "login", "regression", "api"}}, config)
+
+	c.Assert(formatted, Equals, "tags: api, login,\ntags: regression,\ntags: smoke\n")
+}
+
+func (s *MySuite) TestWrappedTagsAreParsedAsTagsOfTheSpec(c *C) {
+	config := DefaultConfig()
+	config.TagsLineLength = 20
+	specText := "Spec\n====\ntags: api, login,\ntags: regression\nScenario\n--------\n* step\n"
+
+	spec, result := new(parser.SpecParser).Parse(specText, gauge.NewConceptDictionary())
+
+	c.Assert(result.Ok, Equals, true)
+	c.Assert(spec.Tags.Values, DeepEquals, []string{"api", "login", "regression"})
+	c.Assert(FormatSpecification(spec, config), Equals, specText)
+}
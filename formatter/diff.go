@@ -0,0 +1,153 @@
+// Copyright 2015 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+
+package formatter
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+)
+
+const diffContextLines = 3
+
+type diffOp struct {
+	kind byte
+	text string
+	// Line numbers, starting from 0, of the line in the original and the formatted text
+	aLine, bLine int
+}
+
+// unifiedDiff returns the changes from the original to the formatted text in the unified diff format, or an empty
+// string if they are the same.
+func unifiedDiff(fileName, original, formatted string) string {
+	if original == formatted {
+		return ""
+	}
+	ops := diffLines(splitLines(original), splitLines(formatted))
+	var b bytes.Buffer
+	fileName = strings.TrimPrefix(fileName, "/")
+	b.WriteString(fmt.Sprintf("--- a/%s\n+++ b/%s\n", fileName, fileName))
+	for start := 0; start < len(ops); {
+		first := nextChange(ops, start)
+		if first == -1 {
+			break
+		}
+		hunkStart := max(first-diffContextLines, start)
+		hunkEnd := first
+		for next := nextChange(ops, hunkEnd+1); next != -1 && next-hunkEnd <= 2*diffContextLines; next = nextChange(ops, hunkEnd+1) {
+			hunkEnd = next
+		}
+		hunkEnd = min(hunkEnd+diffContextLines, len(ops)-1)
+		writeHunk(&b, ops[hunkStart:hunkEnd+1])
+		start = hunkEnd + 1
+	}
+	return b.String()
+}
+
+func writeHunk(b *bytes.Buffer, ops []diffOp) {
+	aCount, bCount := 0, 0
+	for _, op := range ops {
+		if op.kind != '+' {
+			aCount++
+		}
+		if op.kind != '-' {
+			bCount++
+		}
+	}
+	b.WriteString(fmt.Sprintf("@@ -%s +%s @@\n", hunkRange(ops[0].aLine, aCount), hunkRange(ops[0].bLine, bCount)))
+	for _, op := range ops {
+		b.WriteByte(op.kind)
+		b.WriteString(op.text)
+		if !strings.HasSuffix(op.text, "\n") {
+			b.WriteString("\n\\ No newline at end of file\n")
+		}
+	}
+}
+
+// hunkRange formats the lines of a hunk, where an empty range is given by the line before it.
+func hunkRange(line, count int) string {
+	if count == 0 {
+		return fmt.Sprintf("%d,0", line)
+	}
+	return fmt.Sprintf("%d,%d", line+1, count)
+}
+
+func nextChange(ops []diffOp, from int) int {
+	for i := from; i < len(ops); i++ {
+		if ops[i].kind != ' ' {
+			return i
+		}
+	}
+	return -1
+}
+
+func splitLines(text string) []string {
+	lines := strings.SplitAfter(text, "\n")
+	if lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+	return lines
+}
+
+// diffLines finds the edits turning a into b through their longest common subsequence of lines.
+func diffLines(a, b []string) []diffOp {
+	lcs := make([][]int, len(a)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else {
+				lcs[i][j] = max(lcs[i+1][j], lcs[i][j+1])
+			}
+		}
+	}
+	var ops []diffOp
+	i, j := 0, 0
+	for i < len(a) || j < len(b) {
+		switch {
+		case i < len(a) && j < len(b) && a[i] == b[j]:
+			ops = append(ops, diffOp{' ', a[i], i, j})
+			i++
+			j++
+		case j == len(b) || (i < len(a) && lcs[i+1][j] >= lcs[i][j+1]):
+			ops = append(ops, diffOp{'-', a[i], i, j})
+			i++
+		default:
+			ops = append(ops, diffOp{'+', b[j], i, j})
+			j++
+		}
+	}
+	return ops
+}
+
+func max(a, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
+
+func min(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
@@ -19,28 +19,61 @@ package formatter
 
 import (
 	"bytes"
+	"strings"
 
 	"github.com/getgauge/gauge/gauge"
 )
 
 type formatter struct {
 	buffer bytes.Buffer
+	config *Config
+	// Blank lines are held back until the next item, as the rules decide how many of them are kept
+	pendingBlankLines int
+	lastWritten       gauge.TokenKind
+}
+
+func (formatter *formatter) write(kind gauge.TokenKind, text string) {
+	blankLines := formatter.pendingBlankLines
+	if formatter.buffer.Len() > 0 {
+		if kind == gauge.ScenarioKind && formatter.config.BlankLinesBeforeScenario != preserve {
+			blankLines = formatter.config.BlankLinesBeforeScenario
+		} else if kind == gauge.StepKind && formatter.lastWritten == gauge.StepKind && formatter.config.BlankLinesBetweenSteps != preserve {
+			blankLines = formatter.config.BlankLinesBetweenSteps
+		}
+	}
+	formatter.buffer.WriteString(getRepeatedChars("\n", blankLines))
+	formatter.buffer.WriteString(text)
+	formatter.pendingBlankLines = 0
+	formatter.lastWritten = kind
+}
+
+// end returns the formatted spec, with the trailing newline the rules ask for.
+func (formatter *formatter) end() string {
+	formatted := formatter.buffer.String()
+	if formatter.config.TrailingNewline == nil {
+		return formatted + getRepeatedChars("\n", formatter.pendingBlankLines)
+	}
+	formatted = strings.TrimRight(formatted, "\n")
+	if *formatter.config.TrailingNewline {
+		return formatted + "\n"
+	}
+	return formatted
 }
 
 func (formatter *formatter) SpecHeading(specHeading *gauge.Heading) {
-	formatter.buffer.WriteString(FormatHeading(specHeading.Value, "="))
+	formatter.write(gauge.SpecKind, formatSpecHeading(specHeading.Value, formatter.config))
 }
 
 func (formatter *formatter) SpecTags(tags *gauge.Tags) {
-	formatter.buffer.WriteString(FormatTags(tags))
+	formatter.write(gauge.TagKind, formatTags(tags, formatter.config))
 }
 
 func (formatter *formatter) DataTable(table *gauge.Table) {
-	formatter.buffer.WriteString(FormatTable(table))
+	formatter.write(gauge.TableHeader, formatTable(table, formatter.config))
 }
 
 func (formatter *formatter) ExternalDataTable(extDataTable *gauge.DataTable) {
-	formatter.buffer.WriteString(FormatExternalDataTable(extDataTable))
+	formatter.write(gauge.DataTableKind, FormatExternalDataTable(extDataTable))
 }
 
 func (formatter *formatter) ContextStep(step *gauge.Step) {
@@ -48,14 +81,14 @@ func (formatter *formatter) ContextStep(step *gauge.Step) {
 }
 
 func (formatter *formatter) TearDown(t *gauge.TearDown) {
-	formatter.buffer.WriteString(t.Value + "\n")
+	formatter.write(gauge.TearDownKind, t.Value+"\n")
 }
 
 func (formatter *formatter) Scenario(scenario *gauge.Scenario) {
 }
 
 func (formatter *formatter) ScenarioHeading(scenarioHeading *gauge.Heading) {
-	formatter.buffer.WriteString(FormatHeading(scenarioHeading.Value, "-"))
+	formatter.write(gauge.ScenarioKind, formatScenarioHeading(scenarioHeading.Value, formatter.config))
 }
 
 func (formatter *formatter) ScenarioTags(scenarioTags *gauge.Tags) {
@@ -63,9 +96,13 @@ func (formatter *formatter) ScenarioTags(scenarioTags *gauge.Tags) {
 }
 
 func (formatter *formatter) Step(step *gauge.Step) {
-	formatter.buffer.WriteString(FormatStep(step))
+	formatter.write(gauge.StepKind, formatStep(step, formatter.config))
 }
 
 func (formatter *formatter) Comment(comment *gauge.Comment) {
-	formatter.buffer.WriteString(FormatComment(comment))
+	if strings.TrimSpace(comment.Value) == "" {
+		formatter.pendingBlankLines++
+		return
+	}
+	formatter.write(gauge.CommentKind, FormatComment(comment))
 }
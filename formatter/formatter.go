@@ -26,6 +26,7 @@ import (
 	"github.com/getgauge/common"
 	"github.com/getgauge/gauge/gauge"
 	"github.com/getgauge/gauge/gauge_messages"
+	"github.com/getgauge/gauge/logger"
 	"github.com/getgauge/gauge/parser"
 	"github.com/getgauge/gauge/util"
 )
@@ -34,32 +35,57 @@ const (
 	tableLeftSpacing = 5
 )
 
-func FormatSpecFiles(specFiles ...string) []*parser.ParseResult {
+// FormatSpecFiles formats and saves the given spec files with the given formatting rules.
+func FormatSpecFiles(c *Config, specFiles ...string) []*parser.ParseResult {
+	if c.Mode == preserveMode {
+		return formatAndSavePreserving(specFiles, c)
+	}
 	specs, results := parser.ParseSpecFiles(specFiles, &gauge.ConceptDictionary{})
 	for i, spec := range specs {
-		if err := formatAndSave(spec); err != nil {
+		if err := formatAndSave(spec, c); err != nil {
 			results[i].ParseError = &parser.ParseError{Message: err.Error()}
 		}
 	}
 	return results
 }
 
+// FormatSpecHeading formats a spec heading with the default formatting rules.
 func FormatSpecHeading(specHeading string) string {
+	return formatSpecHeading(specHeading, DefaultConfig())
+}
+
+func formatSpecHeading(specHeading string, c *Config) string {
+	if c.HeadingStyle == hashHeading {
+		return fmt.Sprintf("# %s\n", strings.TrimSpace(specHeading))
+	}
 	return FormatHeading(specHeading, "=")
 }
 
+// FormatScenarioHeading formats a scenario heading with the default formatting rules.
 func FormatScenarioHeading(scenarioHeading string) string {
+	return formatScenarioHeading(scenarioHeading, DefaultConfig())
+}
+
+func formatScenarioHeading(scenarioHeading string, c *Config) string {
+	if c.HeadingStyle == hashHeading {
+		return fmt.Sprintf("## %s\n", strings.TrimSpace(scenarioHeading))
+	}
 	return fmt.Sprintf("%s", FormatHeading(scenarioHeading, "-"))
 }
 
+// FormatStep formats a step with the default formatting rules, e.g. to show it in the console.
 func FormatStep(step *gauge.Step) string {
+	return formatStep(step, DefaultConfig())
+}
+
+func formatStep(step *gauge.Step, c *Config) string {
 	text := step.Value
 	paramCount := strings.Count(text, gauge.ParameterPlaceholder)
 	for i := 0; i < paramCount; i++ {
 		argument := step.Args[i]
 		formattedArg := ""
 		if argument.ArgType == gauge.TableArg {
-			formattedTable := FormatTable(&argument.Table)
+			formattedTable := formatTable(&argument.Table, c)
 			formattedArg = fmt.Sprintf("\n%s", formattedTable)
 		} else if argument.ArgType == gauge.Dynamic {
 			formattedArg = fmt.Sprintf("<%s>", parser.GetUnescapedString(argument.Value))
@@ -101,19 +127,27 @@ func FormatHeading(heading, headingChar string) string {
 	return fmt.Sprintf("%s\n%s\n", trimmedHeading, getRepeatedChars(headingChar, length))
 }
 
+// FormatTable formats a table with the default formatting rules.
 func FormatTable(table *gauge.Table) string {
+	return formatTable(table, DefaultConfig())
+}
+
+func formatTable(table *gauge.Table, c *Config) string {
 	columnToWidthMap := make(map[int]int)
 	for i, header := range table.Headers {
 		//table.get(header) returns a list of cells in that particular column
 		cells := table.Get(header)
 		columnToWidthMap[i] = findLongestCellWidth(cells, len(header))
+		if c.MaxColumnWidth > 0 && columnToWidthMap[i] > c.MaxColumnWidth {
+			columnToWidthMap[i] = c.MaxColumnWidth
+		}
 	}
 
 	var tableStringBuffer bytes.Buffer
 	tableStringBuffer.WriteString(fmt.Sprintf("%s|", getRepeatedChars(" ", tableLeftSpacing)))
 	for i, header := range table.Headers {
 		width := columnToWidthMap[i]
-		tableStringBuffer.WriteString(fmt.Sprintf("%s|", addPaddingToCell(header, width)))
+		tableStringBuffer.WriteString(fmt.Sprintf("%s|", alignCell(header, width, c.TableAlignment)))
 	}
 
 	tableStringBuffer.WriteString("\n")
@@ -129,7 +163,7 @@ func FormatTable(table *gauge.Table) string {
 		tableStringBuffer.WriteString(fmt.Sprintf("%s|", getRepeatedChars(" ", tableLeftSpacing)))
 		for i, cell := range row {
 			width := columnToWidthMap[i]
-			tableStringBuffer.WriteString(fmt.Sprintf("%s|", addPaddingToCell(cell, width)))
+			tableStringBuffer.WriteString(fmt.Sprintf("%s|", alignCell(cell, width, c.TableAlignment)))
 		}
 		tableStringBuffer.WriteString("\n")
 	}
@@ -142,6 +176,18 @@ func addPaddingToCell(cellValue string, width int) string {
 	return fmt.Sprintf("%s%s", cellValue, padding)
 }
 
+func alignCell(cellValue string, width int, alignment string) string {
+	padding := width - len(cellValue)
+	switch alignment {
+	case alignRight:
+		return fmt.Sprintf("%s%s", getRepeatedChars(" ", padding), cellValue)
+	case alignCenter:
+		return fmt.Sprintf("%s%s%s", getRepeatedChars(" ", padding/2), cellValue, getRepeatedChars(" ", padding-padding/2))
+	default:
+		return addPaddingToCell(cellValue, width)
+	}
+}
+
 func findLongestCellWidth(columnCells []gauge.TableCell, minValue int) int {
 	longestLength := minValue
 	for _, cellValue := range columnCells {
@@ -160,18 +206,33 @@ func FormatComment(comment *gauge.Comment) string {
 	return fmt.Sprintf("%s\n", comment.Value)
 }
 
+// FormatTags formats tags with the default formatting rules.
 func FormatTags(tags *gauge.Tags) string {
+	return formatTags(tags, DefaultConfig())
+}
+
+func formatTags(tags *gauge.Tags, c *Config) string {
 	if tags == nil || len(tags.Values) == 0 {
 		return ""
 	}
+	values := tags.Values
+	if c.SortTags {
+		values = append([]string{}, values...)
+		sort.Strings(values)
+	}
 	var b bytes.Buffer
-	b.WriteString("tags: ")
-	for i, tag := range tags.Values {
-		b.WriteString(tag)
-		if (i + 1) != len(tags.Values) {
-			b.WriteString(", ")
+	line := "tags: "
+	for i, tag := range values {
+		if c.TagsLineLength > 0 && line != "tags: " && len(line)+len(tag)+1 > c.TagsLineLength {
+			b.WriteString(strings.TrimRight(line, " ") + "\n")
+			line = "tags: "
+		}
+		line += tag
+		if (i + 1) != len(values) {
+			line += ", "
 		}
 	}
+	b.WriteString(line)
 	b.WriteString("\n")
 	return string(b.Bytes())
 }
@@ -186,19 +247,19 @@ func FormatExternalDataTable(dataTable *gauge.DataTable) string {
 	return string(b.Bytes())
 }
 
-func formatAndSave(spec *gauge.Specification) error {
-	formatted := FormatSpecification(spec)
+func formatAndSave(spec *gauge.Specification, c *Config) error {
+	formatted := FormatSpecification(spec, c)
 	if err := common.SaveFile(spec.FileName, formatted, true); err != nil {
 		return err
 	}
 	return nil
 }
 
-func FormatSpecification(specification *gauge.Specification) string {
-	var formattedSpec bytes.Buffer
-	formatter := &formatter{buffer: formattedSpec}
+// FormatSpecification returns the text of the spec formatted with the given formatting rules.
+func FormatSpecification(specification *gauge.Specification, c *Config) string {
+	formatter := &formatter{config: c}
 	specification.Traverse(formatter)
-	return string(formatter.buffer.Bytes())
+	return formatter.end()
 }
 
 func sortConcepts(conceptDictionary *gauge.ConceptDictionary, conceptMap map[string]string) []*gauge.Concept {
@@ -211,25 +272,26 @@ func sortConcepts(conceptDictionary *gauge.ConceptDictionary, conceptMap map[str
 	return concepts
 }
 
-func formatConceptSteps(conceptMap map[string]string, concept *gauge.Concept) {
-	conceptMap[concept.FileName] += strings.TrimSpace(strings.Replace(FormatStep(concept.ConceptStep), "*", "#", 1)) + "\n"
+func formatConceptSteps(conceptMap map[string]string, concept *gauge.Concept, c *Config) {
+	conceptMap[concept.FileName] += strings.TrimSpace(strings.Replace(formatStep(concept.ConceptStep, c), "*", "#", 1)) + "\n"
 	for i := 1; i < len(concept.ConceptStep.Items); i++ {
-		conceptMap[concept.FileName] += formatItem(concept.ConceptStep.Items[i])
+		conceptMap[concept.FileName] += formatItem(concept.ConceptStep.Items[i], c)
 	}
 }
 
-func FormatConcepts(conceptDictionary *gauge.ConceptDictionary) map[string]string {
+// FormatConcepts returns the text of the concept files formatted with the given formatting rules, by file name.
+func FormatConcepts(conceptDictionary *gauge.ConceptDictionary, c *Config) map[string]string {
 	conceptMap := make(map[string]string)
 	for _, concept := range sortConcepts(conceptDictionary, conceptMap) {
 		for _, comment := range concept.ConceptStep.PreComments {
 			conceptMap[concept.FileName] += FormatComment(comment)
 		}
-		formatConceptSteps(conceptMap, concept)
+		formatConceptSteps(conceptMap, concept, c)
 	}
 	return conceptMap
 }
 
-func formatItem(item gauge.Item) string {
+func formatItem(item gauge.Item, c *Config) string {
 	switch item.Kind() {
 	case gauge.CommentKind:
 		comment := item.(*gauge.Comment)
@@ -239,13 +301,13 @@ func formatItem(item gauge.Item) string {
 		return fmt.Sprintf("%s\n", comment.Value)
 	case gauge.StepKind:
 		step := item.(*gauge.Step)
-		return FormatStep(step)
+		return formatStep(step, c)
 	case gauge.DataTableKind:
 		dataTable := item.(*gauge.DataTable)
-		return FormatTable(&dataTable.Table)
+		return formatTable(&dataTable.Table, c)
 	case gauge.TagKind:
 		tags := item.(*gauge.Tags)
-		return FormatTags(tags)
+		return formatTags(tags, c)
 	}
 	return ""
 }
@@ -258,8 +320,66 @@ func getRepeatedChars(character string, repeatCount int) string {
 	return formatted
 }
 
+// FormatSpecFilesIn formats the spec and concept files in the given location.
 func FormatSpecFilesIn(filesLocation string) {
-	specFiles := util.GetSpecFiles(filesLocation)
-	parseResults := FormatSpecFiles(specFiles...)
+	c, err := ProjectConfig()
+	if err != nil {
+		logger.Fatalf("Failed to read the formatting rules. %s", err.Error())
+	}
+	formatted, parseResults := formatFiles(util.GetSpecFiles(filesLocation), util.GetConceptFiles(filesLocation), c)
+	for _, fileName := range sortedFileNames(formatted) {
+		if err := common.SaveFile(fileName, formatted[fileName], true); err != nil {
+			parseResults = append(parseResults, &parser.ParseResult{ParseError: &parser.ParseError{Message: err.Error()}, FileName: fileName})
+		}
+	}
 	parser.HandleParseResult(parseResults...)
 }
+
+// formatFiles formats the spec and concept files which could be parsed, returning the formatted contents by file name.
+func formatFiles(specFiles, conceptFiles []string, c *Config) (map[string]string, []*parser.ParseResult) {
+	if c.Mode == preserveMode {
+		return formatFilesPreserving(specFiles, conceptFiles, c)
+	}
+	formatted := make(map[string]string)
+	specs, parseResults := parser.ParseSpecFiles(specFiles, &gauge.ConceptDictionary{})
+	for _, spec := range specs {
+		formatted[spec.FileName] = FormatSpecification(spec, c)
+	}
+	conceptDictionary := gauge.NewConceptDictionary()
+	definedIn := make(map[string]string)
+	duplicated := make(map[string]bool)
+	for _, conceptFile := range conceptFiles {
+		fileDictionary := gauge.NewConceptDictionary()
+		if err := parser.AddConcepts(conceptFile, fileDictionary); err != nil {
+			parseResults = append(parseResults, &parser.ParseResult{ParseError: err, FileName: conceptFile})
+			continue
+		}
+		for key, concept := range fileDictionary.ConceptsMap {
+			// A concept defined in two files would be written to only one of them, so neither file is formatted
+			if otherFile, exists := definedIn[key]; exists {
+				message := fmt.Sprintf("Duplicate concept definition found, it is also defined in %s", otherFile)
+				parseResults = append(parseResults, &parser.ParseResult{ParseError: &parser.ParseError{Message: message, LineNo: concept.ConceptStep.LineNo, LineText: concept.ConceptStep.LineText}, FileName: conceptFile})
+				duplicated[conceptFile] = true
+				duplicated[otherFile] = true
+				continue
+			}
+			definedIn[key] = conceptFile
+			conceptDictionary.ConceptsMap[key] = concept
+		}
+	}
+	for fileName, content := range FormatConcepts(conceptDictionary, c) {
+		if !duplicated[fileName] {
+			formatted[fileName] = content
+		}
+	}
+	return formatted, parseResults
+}
+
+func sortedFileNames(files map[string]string) []string {
+	var fileNames []string
+	for fileName := range files {
+		fileNames = append(fileNames, fileName)
+	}
+	sort.Strings(fileNames)
+	return fileNames
+}
@@ -44,7 +44,7 @@ func (s *MySuite) TestFormatSpecification(c *C) {
 
 	spec, _ := new(parser.SpecParser).CreateSpecification(tokens, gauge.NewConceptDictionary())
 
-	formatted := FormatSpecification(spec)
+	formatted := FormatSpecification(spec, DefaultConfig())
 
 	c.Assert(formatted, Equals,
 		`Spec Heading
@@ -68,7 +68,7 @@ func (s *MySuite) TestFormatConcepts(c *C) {
 	dictionary.ConceptsMap[step1.Value] = &gauge.Concept{ConceptStep: step1, FileName: "file.cpt"}
 	dictionary.ConceptsMap[step2.Value] = &gauge.Concept{ConceptStep: step2, FileName: "file.cpt"}
 
-	formatted := FormatConcepts(dictionary)
+	formatted := FormatConcepts(dictionary, DefaultConfig())
 	c.Assert(formatted["file.cpt"], Equals, `COMMENT
 # sdsf
 # dsfdsfdsf
@@ -89,7 +89,7 @@ func (s *MySuite) TestFormatSpecificationWithTags(c *C) {
 	}
 
 	spec, _ := new(parser.SpecParser).CreateSpecification(tokens, gauge.NewConceptDictionary())
-	formatted := FormatSpecification(spec)
+	formatted := FormatSpecification(spec, DefaultConfig())
 	c.Assert(formatted, Equals,
 		`My Spec Heading
 ===============
@@ -122,7 +122,7 @@ func (s *MySuite) TestFormatSpecificationWithTeardownSteps(c *C) {
 	}
 
 	spec, _ := new(parser.SpecParser).CreateSpecification(tokens, gauge.NewConceptDictionary())
-	formatted := FormatSpecification(spec)
+	formatted := FormatSpecification(spec, DefaultConfig())
 	c.Assert(formatted, Equals,
 		`My Spec Heading
 ===============
@@ -163,7 +163,7 @@ func (s *MySuite) TestFormattingWithTableAsAComment(c *C) {
 	}
 
 	spec, _ := new(parser.SpecParser).CreateSpecification(tokens, gauge.NewConceptDictionary())
-	formatted := FormatSpecification(spec)
+	formatted := FormatSpecification(spec, DefaultConfig())
 	c.Assert(formatted, Equals,
 		`My Spec Heading
 ===============
@@ -191,7 +191,7 @@ func (s *MySuite) TestFormatSpecificationWithTableContainingDynamicParameters(c
 
 	spec, _ := new(parser.SpecParser).CreateSpecification(tokens, gauge.NewConceptDictionary())
 
-	formatted := FormatSpecification(spec)
+	formatted := FormatSpecification(spec, DefaultConfig())
 
 	c.Assert(formatted, Equals,
 		`Spec Heading
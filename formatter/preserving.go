@@ -0,0 +1,271 @@
+// Copyright 2015 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+
+package formatter
+
+import (
+	"reflect"
+	"strings"
+
+	"github.com/getgauge/common"
+	"github.com/getgauge/gauge/gauge"
+	"github.com/getgauge/gauge/parser"
+)
+
+// formatFilesPreserving formats the spec and concept files in the preserve mode. Files which cannot be read, parsed or
+// formatted without changing how they are parsed are reported and left out of the formatted contents.
+func formatFilesPreserving(specFiles, conceptFiles []string, c *Config) (map[string]string, []*parser.ParseResult) {
+	formatted := make(map[string]string)
+	var parseResults []*parser.ParseResult
+	format := func(files []string, formatText func(string, *Config) (string, *parser.ParseError)) {
+		for _, file := range files {
+			text, err := common.ReadFileContents(file)
+			if err != nil {
+				parseResults = append(parseResults, &parser.ParseResult{ParseError: &parser.ParseError{Message: err.Error()}, FileName: file})
+				continue
+			}
+			content, parseErr := formatText(text, c)
+			if parseErr != nil {
+				parseResults = append(parseResults, &parser.ParseResult{ParseError: parseErr, FileName: file})
+				continue
+			}
+			formatted[file] = content
+		}
+	}
+	format(specFiles, formatSpecPreserving)
+	format(conceptFiles, formatConceptPreserving)
+	return formatted, parseResults
+}
+
+func formatAndSavePreserving(specFiles []string, c *Config) []*parser.ParseResult {
+	formatted, results := formatFilesPreserving(specFiles, nil, c)
+	for _, fileName := range sortedFileNames(formatted) {
+		result := &parser.ParseResult{Ok: true, FileName: fileName}
+		if err := common.SaveFile(fileName, formatted[fileName], true); err != nil {
+			result = &parser.ParseResult{ParseError: &parser.ParseError{Message: err.Error()}, FileName: fileName}
+		}
+		results = append(results, result)
+	}
+	return results
+}
+
+// formatSpecPreserving formats a spec keeping its text as written wherever the format has no rule for it. The
+// formatted spec is parsed again and the spec is left unchanged, with an error, if it does not parse the same.
+func formatSpecPreserving(text string, c *Config) (string, *parser.ParseError) {
+	original, result := new(parser.SpecParser).Parse(text, gauge.NewConceptDictionary())
+	if !result.Ok {
+		return text, result.ParseError
+	}
+	formatted, err := formatLinesPreserving(text, c)
+	if err != nil {
+		return text, err
+	}
+	reparsed, result := new(parser.SpecParser).Parse(formatted, gauge.NewConceptDictionary())
+	if !result.Ok || !reflect.DeepEqual(original, reparsed) {
+		return text, &parser.ParseError{Message: "Formatting would change how the spec is parsed, it is left unchanged"}
+	}
+	return formatted, nil
+}
+
+// formatConceptPreserving formats a concept file the way formatSpecPreserving formats a spec.
+func formatConceptPreserving(text string, c *Config) (string, *parser.ParseError) {
+	original, result := new(parser.ConceptParser).Parse(text)
+	if result != nil && result.Error != nil {
+		return text, result.Error
+	}
+	formatted, err := formatLinesPreserving(text, c)
+	if err != nil {
+		return text, err
+	}
+	reparsed, result := new(parser.ConceptParser).Parse(formatted)
+	if (result != nil && result.Error != nil) || !reflect.DeepEqual(original, reparsed) {
+		return text, &parser.ParseError{Message: "Formatting would change how the concepts are parsed, they are left unchanged"}
+	}
+	return formatted, nil
+}
+
+// formatLinesPreserving rewrites the text line by line, guided by the token of each line. Every line stays on its
+// line number, comments are kept verbatim and only the layout of headings, steps, tags and tables is normalised.
+func formatLinesPreserving(text string, c *Config) (string, *parser.ParseError) {
+	tokens, err := new(parser.SpecParser).GenerateTokens(text)
+	if err != nil {
+		return "", err
+	}
+	tokenOfLine := make(map[int]*parser.Token)
+	for _, token := range tokens {
+		tokenOfLine[token.LineNo] = token
+	}
+	lines := strings.Split(strings.Replace(text, "\r\n", "\n", -1), "\n")
+	endsWithNewline := lines[len(lines)-1] == ""
+	if endsWithNewline {
+		lines = lines[:len(lines)-1]
+	}
+	var formatted []string
+	for i := 0; i < len(lines); i++ {
+		lineNo := i + 1
+		token, ok := tokenOfLine[lineNo]
+		if !ok {
+			formatted = append(formatted, formatUnderline(lines[i], tokenOfLine[lineNo-1]))
+			continue
+		}
+		if token.Kind == gauge.TableHeader || token.Kind == gauge.TableRow {
+			end := i + 1
+			for ; end < len(lines); end++ {
+				if next, ok := tokenOfLine[end+1]; !ok || next.Kind != gauge.TableRow {
+					break
+				}
+			}
+			formatted = append(formatted, formatTableLines(lines[i:end], c)...)
+			i = end - 1
+			continue
+		}
+		formatted = append(formatted, formatLine(lines[i], token))
+	}
+	result := strings.Join(formatted, "\n")
+	if endsWithNewline {
+		result += "\n"
+	}
+	return result, nil
+}
+
+func formatLine(line string, token *parser.Token) string {
+	trimmedLine := strings.TrimSpace(line)
+	switch token.Kind {
+	case gauge.SpecKind:
+		if strings.HasPrefix(trimmedLine, "#") {
+			return strings.TrimSpace("# " + token.Value)
+		}
+		return token.Value
+	case gauge.ScenarioKind:
+		if strings.HasPrefix(trimmedLine, "##") {
+			return strings.TrimSpace("## " + token.Value)
+		}
+		return token.Value
+	case gauge.StepKind:
+		return strings.TrimSpace("* " + token.LineText)
+	case gauge.TagKind:
+		if len(token.Args) == 0 {
+			return trimmedLine
+		}
+		return "tags: " + strings.Join(token.Args, ", ")
+	case gauge.CommentKind:
+		if trimmedLine == "" {
+			return ""
+		}
+		return line
+	default:
+		return trimmedLine
+	}
+}
+
+// formatUnderline regenerates the underline of a heading to the length of the heading. Lines without a token which
+// do not underline a heading are kept as they are.
+func formatUnderline(line string, heading *parser.Token) string {
+	if heading == nil {
+		return line
+	}
+	switch heading.Kind {
+	case gauge.SpecKind:
+		return getRepeatedChars("=", len(heading.Value))
+	case gauge.ScenarioKind:
+		return getRepeatedChars("-", len(heading.Value))
+	}
+	return line
+}
+
+// formatTableLines aligns the cells of a table, keeping the text of the cells, escape sequences included, as written.
+// The table is kept as it is if its cells cannot be split without changing them.
+func formatTableLines(lines []string, c *Config) []string {
+	var rows [][]string
+	widths := make(map[int]int)
+	for _, line := range lines {
+		cells, ok := splitRawCells(strings.TrimSpace(line))
+		if !ok {
+			return lines
+		}
+		rows = append(rows, cells)
+		if isSeparatorRow(cells) {
+			continue
+		}
+		for i, cell := range cells {
+			if len(cell) > widths[i] {
+				widths[i] = len(cell)
+			}
+		}
+	}
+	for i := range widths {
+		if c.MaxColumnWidth > 0 && widths[i] > c.MaxColumnWidth {
+			widths[i] = c.MaxColumnWidth
+		}
+	}
+	var formatted []string
+	for _, cells := range rows {
+		row := getRepeatedChars(" ", tableLeftSpacing) + "|"
+		separator := isSeparatorRow(cells)
+		for i, cell := range cells {
+			if separator {
+				row += getRepeatedChars("-", max(widths[i], 1)) + "|"
+			} else {
+				row += alignCell(cell, widths[i], c.TableAlignment) + "|"
+			}
+		}
+		formatted = append(formatted, row)
+	}
+	return formatted
+}
+
+// splitRawCells splits a table row on the unescaped pipes, keeping the escape sequences of the cells.
+func splitRawCells(row string) ([]string, bool) {
+	var cells []string
+	var cell []rune
+	escaped := false
+	for i, r := range row {
+		if i == 0 {
+			continue
+		}
+		if escaped {
+			cell = append(cell, r)
+			escaped = false
+			continue
+		}
+		if r == '\\' {
+			cell = append(cell, r)
+			escaped = true
+			continue
+		}
+		if r == '|' {
+			trimmed := strings.TrimSpace(string(cell))
+			if strings.HasSuffix(trimmed, "\\") {
+				return nil, false
+			}
+			cells = append(cells, trimmed)
+			cell = nil
+			continue
+		}
+		cell = append(cell, r)
+	}
+	return cells, len(strings.TrimSpace(string(cell))) == 0
+}
+
+func isSeparatorRow(cells []string) bool {
+	for _, cell := range cells {
+		if cell == "" || strings.Trim(cell, "-") != "" {
+			return false
+		}
+	}
+	return len(cells) > 0
+}
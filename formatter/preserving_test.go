@@ -0,0 +1,117 @@
+// Copyright 2015 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+
+package formatter
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+
+	"github.com/getgauge/gauge/gauge"
+	"github.com/getgauge/gauge/parser"
+	. "gopkg.in/check.v1"
+)
+
+// preservingCorpus has a spec for every construct the spec parser supports.
+var preservingCorpus = []string{
+	"Spec Heading\n============\n\nScenario Heading\n----------------\n* step\n",
+	"# Spec Heading\n## Scenario Heading\n* step\n",
+	"#Spec Heading\n\n##   Scenario Heading\n*   step with spaces   \n",
+	"Spec Heading\n=====\ntags: a,b ,  c\n* context step\n\nScenario\n--\n   tags:   d\n* step\n",
+	"# Spec\n\nThis comment   \n  is kept verbatim\t\n|name|\n|----|\n|foo|\n\n## Scenario\n  a comment before the step\n* step \"param\" and <name>\n* step with \"an \\\"escaped\\\" quote\"\n",
+	"# Spec\n\ncomment before the table\n|id|name|\n|--|----|\n|1|foo|\n|2|a \\| pipe|\ncomment after the table\n\n## Scenario\n* step <name>\n",
+	"# Spec\n## Scenario\n* step with table\n     |heading 1|heading 2|\n     |row 1|row \\\\ 2|\n* step \"a\" and \"b\"\n",
+	"# Spec\n## Scenario\n* step\n___\n* teardown step\n\n",
+	"# Spec\n## Scenario\n* step\n\n\n\n## Another Scenario\n\n* step",
+	"Spec\r\n====\r\n## Scenario\r\n* step\r\n",
+	"# Spec\n## Scenario\n* step\n|a|b\\|\n",
+}
+
+func parseSpec(c *C, text string) *gauge.Specification {
+	spec, result := new(parser.SpecParser).Parse(text, gauge.NewConceptDictionary())
+	c.Assert(result.Ok, Equals, true)
+	return spec
+}
+
+func (s *MySuite) TestPreservingFormatParsesTheSame(c *C) {
+	for _, text := range preservingCorpus {
+		formatted, err := formatSpecPreserving(text, DefaultConfig())
+
+		c.Assert(err, IsNil)
+		c.Assert(reflect.DeepEqual(parseSpec(c, formatted), parseSpec(c, text)), Equals, true, Commentf("%q", text))
+	}
+}
+
+func (s *MySuite) TestPreservingFormatIsIdempotent(c *C) {
+	for _, text := range preservingCorpus {
+		once, err := formatSpecPreserving(text, DefaultConfig())
+		c.Assert(err, IsNil)
+
+		twice, err := formatSpecPreserving(once, DefaultConfig())
+
+		c.Assert(err, IsNil)
+		c.Assert(twice, Equals, once, Commentf("%q", text))
+	}
+}
+
+func (s *MySuite) TestPreservingFormatWithExternalFiles(c *C) {
+	// Data table lines are lower cased by the parser, so the path of the table should be in lower case
+	dir, err := ioutil.TempDir("", "gauge_format")
+	c.Assert(err, IsNil)
+	defer os.RemoveAll(dir)
+	csvFile := filepath.Join(dir, "data.csv")
+	textFile := filepath.Join(dir, "foo.txt")
+	c.Assert(ioutil.WriteFile(csvFile, []byte("id,name\n1,foo\n"), 0644), IsNil)
+	c.Assert(ioutil.WriteFile(textFile, []byte("foo"), 0644), IsNil)
+	text := fmt.Sprintf("# Spec\n   table:   %s\n## Scenario\n*  step <name> and <file:%s>\n", csvFile, textFile)
+
+	formatted, err := formatSpecPreserving(text, DefaultConfig())
+
+	c.Assert(err, IsNil)
+	c.Assert(formatted, Equals, fmt.Sprintf("# Spec\ntable:   %s\n## Scenario\n* step <name> and <file:%s>\n", csvFile, textFile))
+	c.Assert(reflect.DeepEqual(parseSpec(c, formatted), parseSpec(c, text)), Equals, true)
+}
+
+func (s *MySuite) TestPreservingFormatKeepsCommentsAndHeadingStyle(c *C) {
+	text := "#Spec Heading\nSome  comment  \n|id|name|\n|--|---|\n|1|a \\| b|\n  another comment\nScenario\n---\ntags:a,b\n*step \"a\\\"b\"\n"
+
+	formatted, err := formatSpecPreserving(text, DefaultConfig())
+
+	c.Assert(err, IsNil)
+	c.Assert(formatted, Equals, "# Spec Heading\nSome  comment  \n     |id|name  |\n     |--|------|\n     |1 |a \\| b|\n  another comment\nScenario\n--------\ntags: a, b\n* step \"a\\\"b\"\n")
+}
+
+func (s *MySuite) TestPreservingFormatOfConcepts(c *C) {
+	text := "# concept heading with <arg>\ncomment about the concept\n*step with <arg>\n\n#another concept\n* step\n"
+
+	formatted, err := formatConceptPreserving(text, DefaultConfig())
+
+	c.Assert(err, IsNil)
+	c.Assert(formatted, Equals, "# concept heading with <arg>\ncomment about the concept\n* step with <arg>\n\n# another concept\n* step\n")
+}
+
+func (s *MySuite) TestPreservingFormatOfInvalidSpec(c *C) {
+	text := "## Scenario\n* step\n"
+
+	formatted, err := formatSpecPreserving(text, DefaultConfig())
+
+	c.Assert(err, NotNil)
+	c.Assert(formatted, Equals, text)
+}
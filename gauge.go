@@ -24,8 +24,10 @@ import (
 	"path/filepath"
 	"time"
 
+	"github.com/getgauge/gauge/analyzer"
 	"github.com/getgauge/gauge/api"
 	"github.com/getgauge/gauge/config"
+	"github.com/getgauge/gauge/docs"
 	"github.com/getgauge/gauge/env"
 	"github.com/getgauge/gauge/execution"
 	"github.com/getgauge/gauge/filter"
@@ -48,7 +50,9 @@ var gaugeVersion = flag.Bool([]string{"v", "-version", "version"}, false, "Print
 var verbosity = flag.Bool([]string{"-verbose"}, false, "Enable step level reporting on console, default being scenario level. Eg: gauge --verbose specs")
 var logLevel = flag.String([]string{"-log-level"}, "", "Set level of logging to debug, info, warning, error or critical")
 var simpleConsoleOutput = flag.Bool([]string{"-simple-console"}, false, "Removes colouring and simplifies from the console output")
-var initialize = flag.String([]string{"-init"}, "", "Initializes project structure in the current directory. Eg: gauge --init java")
+var initialize = flag.String([]string{"-init"}, "", "Initializes project structure in the current directory from a template name or a local template directory or zip. Eg: gauge --init java")
+var templateArgs = flag.String([]string{"-template-args"}, "", "Specifies values of the variables of a template. This is used together with --init. Eg: gauge --init java_maven --template-args \"packageName=com.example\"")
+var registerTemplate = flag.String([]string{"-register-template"}, "", "Registers a local template directory or zip, or the URL of a template zip, by a name. Eg: gauge --register-template my_template path/to/template")
 var installPlugin = flag.String([]string{"-install"}, "", "Downloads and installs a plugin. Eg: gauge --install java")
 var uninstallPlugin = flag.String([]string{"-uninstall"}, "", "Uninstalls a plugin. Eg: gauge --uninstall java")
 var installAll = flag.Bool([]string{"-install-all"}, false, "Installs all the plugins specified in project manifest, if not installed. Eg: gauge --install-all")
@@ -58,22 +62,38 @@ var installZip = flag.String([]string{"-file", "f"}, "", "Installs the plugin fr
 var currentEnv = flag.String([]string{"-env"}, "default", "Specifies the environment. If not specified, default will be used")
 var addPlugin = flag.String([]string{"-add-plugin"}, "", "Adds the specified non-language plugin to the current project")
 var pluginArgs = flag.String([]string{"-plugin-args"}, "", "Specified additional arguments to the plugin. This is used together with --add-plugin")
-var specFilesToFormat = flag.String([]string{"-format"}, "", "Formats the specified spec files")
+var specFilesToFormat = flag.String([]string{"-format"}, "", "Formats the specified spec and concept files")
+var specFilesToCheckFormat = flag.String([]string{"-check-format"}, "", "Checks that the specified spec and concept files are formatted, printing a diff of the files which are not. Eg: gauge --check-format specs")
 var executeTags = flag.String([]string{"-tags"}, "", "Executes the specs and scenarios tagged with given tags. Eg: gauge --tags tag1,tag2 specs")
 var tableRows = flag.String([]string{"-table-rows"}, "", "Executes the specs and scenarios only for the selected rows. Eg: gauge --table-rows \"1-3\" specs/hello.spec")
 var apiPort = flag.String([]string{"-api-port"}, "", "Specifies the api port to be used. Eg: gauge --daemonize --api-port 7777")
+var apiSocket = flag.String([]string{"-api-socket"}, "", "Specifies the unix domain socket the api listens on instead of a port. Eg: gauge --daemonize --api-socket /tmp/gauge.sock")
 var refactorSteps = flag.String([]string{"-refactor"}, "", "Refactor steps")
+var renameConcept = flag.String([]string{"-rename-concept"}, "", "Renames a concept and all its usages. Eg: gauge --rename-concept {old concept} {new concept}")
+var inlineConcept = flag.String([]string{"-inline-concept"}, "", "Replaces all usages of a concept with its steps and removes the concept. Eg: gauge --inline-concept {concept}")
+var tableDriven = flag.String([]string{"-table-driven"}, "", "Converts scenarios differing only in their parameters into a table driven scenario. Eg: gauge --table-driven specs/example.spec")
 var parallel = flag.Bool([]string{"-parallel", "p"}, false, "Execute specs in parallel")
 var numberOfExecutionStreams = flag.Int([]string{"n"}, util.NumberOfCores(), "Specify number of parallel execution streams")
 var distribute = flag.Int([]string{"g", "-group"}, -1, "Specify which group of specification to execute based on -n flag")
 var workingDir = flag.String([]string{"-dir"}, ".", "Set the working directory for the current command, accepts a path relative to current directory.")
 var strategy = flag.String([]string{"-strategy"}, "lazy", "Set the parallelization strategy for execution. Possible options are: `eager`, `lazy`. Ex: gauge -p --strategy=\"eager\"")
 var doNotRandomize = flag.Bool([]string{"-sort", "s"}, false, "Run specs in Alphabetical Order. Eg: gauge -s specs")
+var analyzeDuplicates = flag.Bool([]string{"-analyze-duplicates"}, false, "Reports duplicate scenarios, repeated step sequences and similar steps. Eg: gauge --analyze-duplicates specs")
+var stepInventory = flag.Bool([]string{"-step-inventory"}, false, "Reports unused steps, unimplemented steps and unused concepts. Use with `--machine-readable` for JSON output. Eg: gauge --step-inventory specs")
+var docsDir = flag.String([]string{"-docs"}, "", "Exports the specs as HTML documentation with a tag index and a concept glossary to the given directory. Eg: gauge --docs docs specs")
+var docsMarkdown = flag.Bool([]string{"-docs-markdown"}, false, "Used with --docs to also export the documentation as Markdown. Eg: gauge --docs docs --docs-markdown specs")
+var docsLastRun = flag.Bool([]string{"-docs-last-run"}, false, "Used with --docs to show the status of specs and scenarios in the last run. Eg: gauge --docs docs --docs-last-run specs")
 var validate = flag.Bool([]string{"-validate", "#-check"}, false, "Check for validation and parse errors. Eg: gauge --validate specs")
 var updateAll = flag.Bool([]string{"-update-all"}, false, "Updates all the installed Gauge plugins. Eg: gauge --update-all")
 var checkUpdates = flag.Bool([]string{"#-check-updates"}, false, "Checks for Gauge and plugins updates. Eg: gauge --check-updates")
+var mirror = flag.String([]string{"-mirror"}, "", "Copies the installed plugins and the templates to a directory which can be used as an offline repository. Eg: gauge --mirror /path/to/mirror")
+var listPlugins = flag.Bool([]string{"-list-plugins"}, false, "Lists all the installed versions of plugins with their scopes and compatibility. Use with `--machine-readable` for JSON output. Eg: gauge --list-plugins")
+var pluginInfo = flag.String([]string{"-plugin-info"}, "", "Shows the details of an installed plugin. Use with --plugin-version for a specific version. Eg: gauge --plugin-info html-report")
+var prunePlugins = flag.Bool([]string{"-prune-plugins"}, false, "Uninstalls the plugin versions which are neither the latest nor used by the given projects. Eg: gauge --prune-plugins path/to/project1 path/to/project2")
+var dryRun = flag.Bool([]string{"-dry-run"}, false, "Used with --prune-plugins to list the plugin versions which would be uninstalled, without uninstalling them. Eg: gauge --prune-plugins --dry-run")
+var keepLatestOnly = flag.Bool([]string{"-keep-latest-only"}, false, "Used with --prune-plugins outside a project and without project paths, to uninstall every plugin version but the latest. Without it, nothing is uninstalled. Eg: gauge --prune-plugins --keep-latest-only")
 var listTemplates = flag.Bool([]string{"-list-templates"}, false, "Lists all the Gauge templates available. Eg: gauge --list-templates")
-var machineReadable = flag.Bool([]string{"-machine-readable"}, false, "Used with `--version` to produce JSON output of currently installed Gauge and plugin versions. e.g: gauge --version --machine-readable")
+var machineReadable = flag.Bool([]string{"-machine-readable"}, false, "Used with `--version`, `--step-inventory` or `--list-plugins` to produce JSON output. e.g: gauge --version --machine-readable")
 
 func main() {
 	flag.Parse()
@@ -88,15 +108,15 @@ func main() {
 	logger.Initialize(*logLevel)
 	if *gaugeVersion && *machineReadable {
 		printJSONVersion()
-	} else if *machineReadable {
-		fmt.Printf("flag '--machine-readable' can only be used with '--version' or '-v'\n\n")
+	} else if *machineReadable && !*stepInventory && !*listPlugins {
+		fmt.Printf("flag '--machine-readable' can only be used with '--version', '-v', '--step-inventory' or '--list-plugins'\n\n")
 		fmt.Printf("Usage:\n\n")
 		flag.PrintDefaults()
 		os.Exit(1)
 	} else if *gaugeVersion {
 		printVersion()
 	} else if *initialize != "" {
-		projectInit.InitializeProject(*initialize)
+		projectInit.InitializeProject(*initialize, *templateArgs)
 	} else if *installZip != "" && *installPlugin != "" {
 		install.HandleInstallResult(install.InstallPluginFromZipFile(*installZip, *installPlugin), *installPlugin, true)
 	} else if *installPlugin != "" {
@@ -113,8 +133,24 @@ func main() {
 		install.PrintUpdateInfoWithDetails()
 	} else if *addPlugin != "" {
 		install.AddPluginToProject(*addPlugin, *pluginArgs)
+	} else if *listPlugins {
+		install.ListPlugins(*machineReadable)
+	} else if *pluginInfo != "" {
+		install.ShowPluginInfo(*pluginInfo, *pluginVersion)
+	} else if *prunePlugins {
+		prune(flag.Args(), validGaugeProject)
+	} else if *registerTemplate != "" {
+		if len(flag.Args()) != 1 {
+			logger.Fatalf("Template to register should be given. Eg: gauge --register-template my_template path/to/template")
+		}
+		if err := projectInit.RegisterTemplate(*registerTemplate, flag.Args()[0]); err != nil {
+			logger.Fatalf("Failed to register template %s. %s", *registerTemplate, err.Error())
+		}
+		logger.Info("Registered template %s.", *registerTemplate)
 	} else if *listTemplates {
 		projectInit.ListTemplates()
+	} else if *mirror != "" {
+		createMirror(*mirror)
 	} else if flag.NFlag() == 0 && len(flag.Args()) == 0 {
 		printUsage()
 		os.Exit(0)
@@ -125,12 +161,29 @@ func main() {
 				logger.Fatalf("flag needs two arguments: --refactor\n.Usage : gauge --refactor {old step} {new step}")
 			}
 			refactor.RefactorSteps(*refactorSteps, flag.Args()[0], startChan)
+		} else if *renameConcept != "" {
+			if len(flag.Args()) != 1 {
+				logger.Fatalf("flag needs two arguments: --rename-concept\n.Usage : gauge --rename-concept {old concept} {new concept}")
+			}
+			refactor.RenameConcept(*renameConcept, flag.Args()[0])
+		} else if *inlineConcept != "" {
+			refactor.InlineConcept(*inlineConcept)
+		} else if *tableDriven != "" {
+			refactor.ConvertToTableDrivenScenario(*tableDriven)
 		} else if *daemonize {
-			api.RunInBackground(*apiPort)
+			api.RunInBackground(*apiPort, *apiSocket)
 		} else if *specFilesToFormat != "" {
 			formatter.FormatSpecFilesIn(*specFilesToFormat)
+		} else if *specFilesToCheckFormat != "" {
+			formatter.CheckFormatIn(*specFilesToCheckFormat)
 		} else if *validate {
 			execution.Validate(flag.Args())
+		} else if *analyzeDuplicates {
+			analyzer.AnalyzeDuplicates(flag.Args())
+		} else if *stepInventory {
+			analyzer.StepInventory(flag.Args(), api.StartAPI(), *machineReadable)
+		} else if *docsDir != "" {
+			docs.Export(*docsDir, flag.Args())
 		} else {
 			exitCode := execution.ExecuteSpecs(flag.Args())
 			os.Exit(exitCode)
@@ -175,6 +228,48 @@ func printVersion() {
 	}
 }
 
+func createMirror(mirrorDir string) {
+	mirrorDir, err := filepath.Abs(mirrorDir)
+	if err != nil {
+		logger.Fatalf("Failed to create mirror. %s", err.Error())
+	}
+	if err := install.MirrorPlugins(mirrorDir); err != nil {
+		logger.Fatalf("Failed to mirror plugins. %s", err.Error())
+	}
+	if err := projectInit.MirrorTemplates(mirrorDir); err != nil {
+		logger.Fatalf("Failed to mirror templates. %s", err.Error())
+	}
+	logger.Info("Mirror created at %s. To use it, set these properties in gauge.properties:", mirrorDir)
+	logger.Info("gauge_repository_url = %s", util.FileURLOf(mirrorDir))
+	logger.Info("gauge_templates_url = %s", util.FileURLOf(filepath.Join(mirrorDir, "templates")))
+}
+
+func prune(projectDirs []string, validGaugeProject bool) {
+	var projectRoots []string
+	for _, dir := range projectDirs {
+		projectRoot, err := filepath.Abs(dir)
+		if err != nil {
+			logger.Fatalf("Failed to prune plugins. %s", err.Error())
+		}
+		projectRoots = append(projectRoots, projectRoot)
+	}
+	if len(projectRoots) == 0 && validGaugeProject {
+		projectRoots = append(projectRoots, config.ProjectRoot)
+	}
+	listOnly := *dryRun
+	if len(projectRoots) == 0 {
+		if *keepLatestOnly {
+			logger.Warning("No projects given, only the latest version of each plugin is kept.")
+		} else {
+			logger.Warning("No projects given, listing the plugin versions which are not the latest without uninstalling them. Give the projects using the plugins, or use --keep-latest-only to uninstall them.")
+			listOnly = true
+		}
+	}
+	if err := install.PrunePlugins(projectRoots, listOnly); err != nil {
+		logger.Fatalf("Failed to prune plugins. %s", err.Error())
+	}
+}
+
 func printUsage() {
 	fmt.Printf("gauge -version %s\n", version.FullVersion())
 	fmt.Printf("Copyright %d ThoughtWorks, Inc.\n\n", time.Now().Year())
@@ -199,6 +294,8 @@ func initPackageFlags() {
 	filter.DoNotRandomize = *doNotRandomize
 	filter.Distribute = *distribute
 	filter.NumberOfExecutionStreams = *numberOfExecutionStreams
+	docs.Markdown = *docsMarkdown
+	docs.WithLastRun = *docsLastRun
 	execution.Strategy = *strategy
 	if *distribute != -1 {
 		execution.Strategy = execution.Eager
@@ -1,6 +1,9 @@
 package gauge
 
-import "fmt"
+import (
+	"fmt"
+	"reflect"
+)
 
 type ArgType string
 
@@ -108,3 +111,14 @@ type StepArg struct {
 func (stepArg *StepArg) String() string {
 	return fmt.Sprintf("{Name: %s,value %s,argType %s,table %v}", stepArg.Name, stepArg.Value, string(stepArg.ArgType), stepArg.Table)
 }
+
+// IsSameAs tells if both args pass the same value, comparing the headers and rows of table args.
+func (stepArg *StepArg) IsSameAs(another *StepArg) bool {
+	if stepArg.ArgType != another.ArgType || stepArg.Value != another.Value || stepArg.Name != another.Name {
+		return false
+	}
+	if stepArg.ArgType == TableArg || stepArg.ArgType == SpecialTable {
+		return reflect.DeepEqual(stepArg.Table.Headers, another.Table.Headers) && reflect.DeepEqual(stepArg.Table.Rows(), another.Table.Rows())
+	}
+	return true
+}
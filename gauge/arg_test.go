@@ -94,3 +94,20 @@ func (s *MySuite) TestGetLookupFromTableRow(c *C) {
 	c.Assert(lookup2.GetArg("name").Value, Equals, "root")
 	c.Assert(lookup2.GetArg("name").ArgType, Equals, Static)
 }
+
+func (s *MySuite) TestStepArgsWithSameTableAreSame(c *C) {
+	table := func(rows ...string) Table {
+		t := new(Table)
+		t.AddHeaders([]string{"id"})
+		for _, row := range rows {
+			t.AddRowValues([]string{row})
+		}
+		return *t
+	}
+	arg := &StepArg{ArgType: TableArg, Table: table("1", "2")}
+
+	c.Assert(arg.IsSameAs(&StepArg{ArgType: TableArg, Table: table("1", "2")}), Equals, true)
+	c.Assert(arg.IsSameAs(&StepArg{ArgType: TableArg, Table: table("1")}), Equals, false)
+	c.Assert(arg.IsSameAs(&StepArg{ArgType: Static, Value: "1"}), Equals, false)
+	c.Assert((&StepArg{ArgType: Static, Value: "1"}).IsSameAs(&StepArg{ArgType: Static, Value: "1"}), Equals, true)
+}
@@ -36,6 +36,11 @@ func (scenario *Scenario) AddStep(step *Step) {
 }
 
 func (scenario *Scenario) AddTags(tags *Tags) {
+	if scenario.Tags != nil {
+		// Tags can be written over several tag lines
+		scenario.Tags.Values = append(scenario.Tags.Values, tags.Values...)
+		return
+	}
 	scenario.Tags = tags
 	scenario.AddItem(tags)
 }
@@ -116,6 +116,11 @@ func (spec *Specification) AddExternalDataTable(externalTable *DataTable) {
 }
 
 func (spec *Specification) AddTags(tags *Tags) {
+	if spec.Tags != nil {
+		// Tags can be written over several tag lines
+		spec.Tags.Values = append(spec.Tags.Values, tags.Values...)
+		return
+	}
 	spec.Tags = tags
 	spec.AddItem(tags)
 }
@@ -0,0 +1,36 @@
+// Copyright 2015 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+
+package gauge
+
+import . "gopkg.in/check.v1"
+
+func (s *MySuite) TestAddTagsMergesTagLines(c *C) {
+	spec := &Specification{}
+	spec.AddTags(&Tags{Values: []string{"tag1", "tag2"}})
+	spec.AddTags(&Tags{Values: []string{"tag3"}})
+
+	c.Assert(spec.Tags.Values, DeepEquals, []string{"tag1", "tag2", "tag3"})
+	c.Assert(len(spec.Items), Equals, 1)
+
+	scenario := &Scenario{}
+	scenario.AddTags(&Tags{Values: []string{"tag4"}})
+	scenario.AddTags(&Tags{Values: []string{"tag5"}})
+
+	c.Assert(scenario.Tags.Values, DeepEquals, []string{"tag4", "tag5"})
+	c.Assert(len(scenario.Items), Equals, 1)
+}
@@ -39,6 +39,14 @@ It has these top-level messages:
 	FormatSpecsResponse
 	UnsupportedApiMessageResponse
 	APIMessage
+	RenameConceptRequest
+	InlineConceptRequest
+	ConvertToTableDrivenScenarioRequest
+	SearchStepsRequest
+	SearchStepsResponse
+	StepDocumentation
+	StepLocation
+	ExpandedStep
 	ExecutionRequest
 	ScenarioExecutionResult
 	KillProcessRequest
@@ -104,28 +112,33 @@ var _ = math.Inf
 type APIMessage_APIMessageType int32
 
 const (
-	APIMessage_GetProjectRootRequest            APIMessage_APIMessageType = 1
-	APIMessage_GetProjectRootResponse           APIMessage_APIMessageType = 2
-	APIMessage_GetInstallationRootRequest       APIMessage_APIMessageType = 3
-	APIMessage_GetInstallationRootResponse      APIMessage_APIMessageType = 4
-	APIMessage_GetAllStepsRequest               APIMessage_APIMessageType = 5
-	APIMessage_GetAllStepResponse               APIMessage_APIMessageType = 6
-	APIMessage_GetAllSpecsRequest               APIMessage_APIMessageType = 7
-	APIMessage_GetAllSpecsResponse              APIMessage_APIMessageType = 8
-	APIMessage_GetStepValueRequest              APIMessage_APIMessageType = 9
-	APIMessage_GetStepValueResponse             APIMessage_APIMessageType = 10
-	APIMessage_GetLanguagePluginLibPathRequest  APIMessage_APIMessageType = 11
-	APIMessage_GetLanguagePluginLibPathResponse APIMessage_APIMessageType = 12
-	APIMessage_ErrorResponse                    APIMessage_APIMessageType = 13
-	APIMessage_GetAllConceptsRequest            APIMessage_APIMessageType = 14
-	APIMessage_GetAllConceptsResponse           APIMessage_APIMessageType = 15
-	APIMessage_PerformRefactoringRequest        APIMessage_APIMessageType = 16
-	APIMessage_PerformRefactoringResponse       APIMessage_APIMessageType = 17
-	APIMessage_ExtractConceptRequest            APIMessage_APIMessageType = 18
-	APIMessage_ExtractConceptResponse           APIMessage_APIMessageType = 19
-	APIMessage_FormatSpecsRequest               APIMessage_APIMessageType = 20
-	APIMessage_FormatSpecsResponse              APIMessage_APIMessageType = 21
-	APIMessage_UnsupportedApiMessageResponse    APIMessage_APIMessageType = 22
+	APIMessage_GetProjectRootRequest               APIMessage_APIMessageType = 1
+	APIMessage_GetProjectRootResponse              APIMessage_APIMessageType = 2
+	APIMessage_GetInstallationRootRequest          APIMessage_APIMessageType = 3
+	APIMessage_GetInstallationRootResponse         APIMessage_APIMessageType = 4
+	APIMessage_GetAllStepsRequest                  APIMessage_APIMessageType = 5
+	APIMessage_GetAllStepResponse                  APIMessage_APIMessageType = 6
+	APIMessage_GetAllSpecsRequest                  APIMessage_APIMessageType = 7
+	APIMessage_GetAllSpecsResponse                 APIMessage_APIMessageType = 8
+	APIMessage_GetStepValueRequest                 APIMessage_APIMessageType = 9
+	APIMessage_GetStepValueResponse                APIMessage_APIMessageType = 10
+	APIMessage_GetLanguagePluginLibPathRequest     APIMessage_APIMessageType = 11
+	APIMessage_GetLanguagePluginLibPathResponse    APIMessage_APIMessageType = 12
+	APIMessage_ErrorResponse                       APIMessage_APIMessageType = 13
+	APIMessage_GetAllConceptsRequest               APIMessage_APIMessageType = 14
+	APIMessage_GetAllConceptsResponse              APIMessage_APIMessageType = 15
+	APIMessage_PerformRefactoringRequest           APIMessage_APIMessageType = 16
+	APIMessage_PerformRefactoringResponse          APIMessage_APIMessageType = 17
+	APIMessage_ExtractConceptRequest               APIMessage_APIMessageType = 18
+	APIMessage_ExtractConceptResponse              APIMessage_APIMessageType = 19
+	APIMessage_FormatSpecsRequest                  APIMessage_APIMessageType = 20
+	APIMessage_FormatSpecsResponse                 APIMessage_APIMessageType = 21
+	APIMessage_UnsupportedApiMessageResponse       APIMessage_APIMessageType = 22
+	APIMessage_RenameConceptRequest                APIMessage_APIMessageType = 23
+	APIMessage_InlineConceptRequest                APIMessage_APIMessageType = 24
+	APIMessage_ConvertToTableDrivenScenarioRequest APIMessage_APIMessageType = 25
+	APIMessage_SearchStepsRequest                  APIMessage_APIMessageType = 26
+	APIMessage_SearchStepsResponse                 APIMessage_APIMessageType = 27
 )
 
 var APIMessage_APIMessageType_name = map[int32]string{
@@ -151,30 +164,40 @@ var APIMessage_APIMessageType_name = map[int32]string{
 	20: "FormatSpecsRequest",
 	21: "FormatSpecsResponse",
 	22: "UnsupportedApiMessageResponse",
+	23: "RenameConceptRequest",
+	24: "InlineConceptRequest",
+	25: "ConvertToTableDrivenScenarioRequest",
+	26: "SearchStepsRequest",
+	27: "SearchStepsResponse",
 }
 var APIMessage_APIMessageType_value = map[string]int32{
-	"GetProjectRootRequest":            1,
-	"GetProjectRootResponse":           2,
-	"GetInstallationRootRequest":       3,
-	"GetInstallationRootResponse":      4,
-	"GetAllStepsRequest":               5,
-	"GetAllStepResponse":               6,
-	"GetAllSpecsRequest":               7,
-	"GetAllSpecsResponse":              8,
-	"GetStepValueRequest":              9,
-	"GetStepValueResponse":             10,
-	"GetLanguagePluginLibPathRequest":  11,
-	"GetLanguagePluginLibPathResponse": 12,
-	"ErrorResponse":                    13,
-	"GetAllConceptsRequest":            14,
-	"GetAllConceptsResponse":           15,
-	"PerformRefactoringRequest":        16,
-	"PerformRefactoringResponse":       17,
-	"ExtractConceptRequest":            18,
-	"ExtractConceptResponse":           19,
-	"FormatSpecsRequest":               20,
-	"FormatSpecsResponse":              21,
-	"UnsupportedApiMessageResponse":    22,
+	"GetProjectRootRequest":               1,
+	"GetProjectRootResponse":              2,
+	"GetInstallationRootRequest":          3,
+	"GetInstallationRootResponse":         4,
+	"GetAllStepsRequest":                  5,
+	"GetAllStepResponse":                  6,
+	"GetAllSpecsRequest":                  7,
+	"GetAllSpecsResponse":                 8,
+	"GetStepValueRequest":                 9,
+	"GetStepValueResponse":                10,
+	"GetLanguagePluginLibPathRequest":     11,
+	"GetLanguagePluginLibPathResponse":    12,
+	"ErrorResponse":                       13,
+	"GetAllConceptsRequest":               14,
+	"GetAllConceptsResponse":              15,
+	"PerformRefactoringRequest":           16,
+	"PerformRefactoringResponse":          17,
+	"ExtractConceptRequest":               18,
+	"ExtractConceptResponse":              19,
+	"FormatSpecsRequest":                  20,
+	"FormatSpecsResponse":                 21,
+	"UnsupportedApiMessageResponse":       22,
+	"RenameConceptRequest":                23,
+	"InlineConceptRequest":                24,
+	"ConvertToTableDrivenScenarioRequest": 25,
+	"SearchStepsRequest":                  26,
+	"SearchStepsResponse":                 27,
 }
 
 func (x APIMessage_APIMessageType) Enum() *APIMessage_APIMessageType {
@@ -844,7 +867,19 @@ type APIMessage struct {
 	FormatSpecsResponse *FormatSpecsResponse `protobuf:"bytes,23,opt,name=formatSpecsResponse" json:"formatSpecsResponse,omitempty"`
 	// / [UnsupportedApiMessageResponse] (#gauge.messages.UnsupportedApiMessageResponse)
 	UnsupportedApiMessageResponse *UnsupportedApiMessageResponse `protobuf:"bytes,24,opt,name=unsupportedApiMessageResponse" json:"unsupportedApiMessageResponse,omitempty"`
-	XXX_unrecognized              []byte                         `json:"-"`
+	// / [RenameConceptRequest](#gauge.messages.RenameConceptRequest)
+	RenameConceptRequest *RenameConceptRequest `protobuf:"bytes,25,opt,name=renameConceptRequest" json:"renameConceptRequest,omitempty"`
+	// / [InlineConceptRequest](#gauge.messages.InlineConceptRequest)
+	InlineConceptRequest *InlineConceptRequest `protobuf:"bytes,26,opt,name=inlineConceptRequest" json:"inlineConceptRequest,omitempty"`
+	// / [ConvertToTableDrivenScenarioRequest](#gauge.messages.ConvertToTableDrivenScenarioRequest)
+	ConvertToTableDrivenScenarioRequest *ConvertToTableDrivenScenarioRequest `protobuf:"bytes,27,opt,name=convertToTableDrivenScenarioRequest" json:"convertToTableDrivenScenarioRequest,omitempty"`
+	// / Secret token authenticating the client, required in the first message of a connection to the Gauge daemon
+	Token *string `protobuf:"bytes,28,opt,name=token" json:"token,omitempty"`
+	// / [SearchStepsRequest](#gauge.messages.SearchStepsRequest)
+	SearchStepsRequest *SearchStepsRequest `protobuf:"bytes,29,opt,name=searchStepsRequest" json:"searchStepsRequest,omitempty"`
+	// / [SearchStepsResponse](#gauge.messages.SearchStepsResponse)
+	SearchStepsResponse *SearchStepsResponse `protobuf:"bytes,30,opt,name=searchStepsResponse" json:"searchStepsResponse,omitempty"`
+	XXX_unrecognized    []byte               `json:"-"`
 }
 
 func (m *APIMessage) Reset()                    { *m = APIMessage{} }
@@ -1020,6 +1055,302 @@ func (m *APIMessage) GetUnsupportedApiMessageResponse() *UnsupportedApiMessageRe
 	return nil
 }
 
+func (m *APIMessage) GetRenameConceptRequest() *RenameConceptRequest {
+	if m != nil {
+		return m.RenameConceptRequest
+	}
+	return nil
+}
+
+func (m *APIMessage) GetInlineConceptRequest() *InlineConceptRequest {
+	if m != nil {
+		return m.InlineConceptRequest
+	}
+	return nil
+}
+
+func (m *APIMessage) GetConvertToTableDrivenScenarioRequest() *ConvertToTableDrivenScenarioRequest {
+	if m != nil {
+		return m.ConvertToTableDrivenScenarioRequest
+	}
+	return nil
+}
+
+func (m *APIMessage) GetToken() string {
+	if m != nil && m.Token != nil {
+		return *m.Token
+	}
+	return ""
+}
+
+func (m *APIMessage) GetSearchStepsRequest() *SearchStepsRequest {
+	if m != nil {
+		return m.SearchStepsRequest
+	}
+	return nil
+}
+
+func (m *APIMessage) GetSearchStepsResponse() *SearchStepsResponse {
+	if m != nil {
+		return m.SearchStepsResponse
+	}
+	return nil
+}
+
+// / Request to rename a concept and all its usages. Responds with a PerformRefactoringResponse
+type RenameConceptRequest struct {
+	// / Concept to rename
+	OldConcept *string `protobuf:"bytes,1,req,name=oldConcept" json:"oldConcept,omitempty"`
+	// / New heading of the concept
+	NewConcept       *string `protobuf:"bytes,2,req,name=newConcept" json:"newConcept,omitempty"`
+	XXX_unrecognized []byte  `json:"-"`
+}
+
+func (m *RenameConceptRequest) Reset()                    { *m = RenameConceptRequest{} }
+func (m *RenameConceptRequest) String() string            { return proto.CompactTextString(m) }
+func (*RenameConceptRequest) ProtoMessage()               {}
+func (*RenameConceptRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{27} }
+
+func (m *RenameConceptRequest) GetOldConcept() string {
+	if m != nil && m.OldConcept != nil {
+		return *m.OldConcept
+	}
+	return ""
+}
+
+func (m *RenameConceptRequest) GetNewConcept() string {
+	if m != nil && m.NewConcept != nil {
+		return *m.NewConcept
+	}
+	return ""
+}
+
+// / Request to replace all usages of a concept with its steps. Responds with a PerformRefactoringResponse
+type InlineConceptRequest struct {
+	// / Concept to inline
+	Concept          *string `protobuf:"bytes,1,req,name=concept" json:"concept,omitempty"`
+	XXX_unrecognized []byte  `json:"-"`
+}
+
+func (m *InlineConceptRequest) Reset()                    { *m = InlineConceptRequest{} }
+func (m *InlineConceptRequest) String() string            { return proto.CompactTextString(m) }
+func (*InlineConceptRequest) ProtoMessage()               {}
+func (*InlineConceptRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{28} }
+
+func (m *InlineConceptRequest) GetConcept() string {
+	if m != nil && m.Concept != nil {
+		return *m.Concept
+	}
+	return ""
+}
+
+// / Request to convert scenarios differing only in their parameters into a table driven scenario. Responds with a PerformRefactoringResponse
+type ConvertToTableDrivenScenarioRequest struct {
+	// / Spec file whose scenarios are to be converted
+	SpecFile         *string `protobuf:"bytes,1,req,name=specFile" json:"specFile,omitempty"`
+	XXX_unrecognized []byte  `json:"-"`
+}
+
+func (m *ConvertToTableDrivenScenarioRequest) Reset()         { *m = ConvertToTableDrivenScenarioRequest{} }
+func (m *ConvertToTableDrivenScenarioRequest) String() string { return proto.CompactTextString(m) }
+func (*ConvertToTableDrivenScenarioRequest) ProtoMessage()    {}
+func (*ConvertToTableDrivenScenarioRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{29}
+}
+
+func (m *ConvertToTableDrivenScenarioRequest) GetSpecFile() string {
+	if m != nil && m.SpecFile != nil {
+		return *m.SpecFile
+	}
+	return ""
+}
+
+// / Request to search the steps and concepts of the project by fuzzy text. Responds with a SearchStepsResponse
+type SearchStepsRequest struct {
+	// / Text to search for. Steps containing its characters in the same order match, the best matches first.
+	Query *string `protobuf:"bytes,1,req,name=query" json:"query,omitempty"`
+	// / Maximum number of steps in the response, all matching steps if not set
+	Limit            *int32 `protobuf:"varint,2,opt,name=limit" json:"limit,omitempty"`
+	XXX_unrecognized []byte `json:"-"`
+}
+
+func (m *SearchStepsRequest) Reset()                    { *m = SearchStepsRequest{} }
+func (m *SearchStepsRequest) String() string            { return proto.CompactTextString(m) }
+func (*SearchStepsRequest) ProtoMessage()               {}
+func (*SearchStepsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{30} }
+
+func (m *SearchStepsRequest) GetQuery() string {
+	if m != nil && m.Query != nil {
+		return *m.Query
+	}
+	return ""
+}
+
+func (m *SearchStepsRequest) GetLimit() int32 {
+	if m != nil && m.Limit != nil {
+		return *m.Limit
+	}
+	return 0
+}
+
+// / Response to SearchStepsRequest
+type SearchStepsResponse struct {
+	// / Matching steps and concepts, the best matches first
+	Steps            []*StepDocumentation `protobuf:"bytes,1,rep,name=steps" json:"steps,omitempty"`
+	XXX_unrecognized []byte               `json:"-"`
+}
+
+func (m *SearchStepsResponse) Reset()                    { *m = SearchStepsResponse{} }
+func (m *SearchStepsResponse) String() string            { return proto.CompactTextString(m) }
+func (*SearchStepsResponse) ProtoMessage()               {}
+func (*SearchStepsResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{31} }
+
+func (m *SearchStepsResponse) GetSteps() []*StepDocumentation {
+	if m != nil {
+		return m.Steps
+	}
+	return nil
+}
+
+// / Where a step or concept is used, implemented and defined
+type StepDocumentation struct {
+	// / The step, or the heading of the concept
+	StepValue *ProtoStepValue `protobuf:"bytes,1,req,name=stepValue" json:"stepValue,omitempty"`
+	// / Flag indicating if this is a concept
+	IsConcept *bool `protobuf:"varint,2,req,name=isConcept" json:"isConcept,omitempty"`
+	// / Places in specs and concepts where the step is used
+	Usages []*StepLocation `protobuf:"bytes,3,rep,name=usages" json:"usages,omitempty"`
+	// / Where the step is implemented, as reported by the runner. Not set for concepts, unimplemented steps and
+	// / runners which do not report it.
+	Implementation *StepLocation `protobuf:"bytes,4,opt,name=implementation" json:"implementation,omitempty"`
+	// / Where the concept is defined. Only set for concepts.
+	Definition *StepLocation `protobuf:"bytes,5,opt,name=definition" json:"definition,omitempty"`
+	// / Steps of the concept, with the steps of nested concepts following them. Only set for concepts.
+	ExpandedSteps    []*ExpandedStep `protobuf:"bytes,6,rep,name=expandedSteps" json:"expandedSteps,omitempty"`
+	XXX_unrecognized []byte          `json:"-"`
+}
+
+func (m *StepDocumentation) Reset()                    { *m = StepDocumentation{} }
+func (m *StepDocumentation) String() string            { return proto.CompactTextString(m) }
+func (*StepDocumentation) ProtoMessage()               {}
+func (*StepDocumentation) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{32} }
+
+func (m *StepDocumentation) GetStepValue() *ProtoStepValue {
+	if m != nil {
+		return m.StepValue
+	}
+	return nil
+}
+
+func (m *StepDocumentation) GetIsConcept() bool {
+	if m != nil && m.IsConcept != nil {
+		return *m.IsConcept
+	}
+	return false
+}
+
+func (m *StepDocumentation) GetUsages() []*StepLocation {
+	if m != nil {
+		return m.Usages
+	}
+	return nil
+}
+
+func (m *StepDocumentation) GetImplementation() *StepLocation {
+	if m != nil {
+		return m.Implementation
+	}
+	return nil
+}
+
+func (m *StepDocumentation) GetDefinition() *StepLocation {
+	if m != nil {
+		return m.Definition
+	}
+	return nil
+}
+
+func (m *StepDocumentation) GetExpandedSteps() []*ExpandedStep {
+	if m != nil {
+		return m.ExpandedSteps
+	}
+	return nil
+}
+
+// / A line in a file
+type StepLocation struct {
+	// / The absolute path to the file
+	FileName *string `protobuf:"bytes,1,req,name=fileName" json:"fileName,omitempty"`
+	// / The line number in the file
+	LineNumber       *int32 `protobuf:"varint,2,req,name=lineNumber" json:"lineNumber,omitempty"`
+	XXX_unrecognized []byte `json:"-"`
+}
+
+func (m *StepLocation) Reset()                    { *m = StepLocation{} }
+func (m *StepLocation) String() string            { return proto.CompactTextString(m) }
+func (*StepLocation) ProtoMessage()               {}
+func (*StepLocation) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{33} }
+
+func (m *StepLocation) GetFileName() string {
+	if m != nil && m.FileName != nil {
+		return *m.FileName
+	}
+	return ""
+}
+
+func (m *StepLocation) GetLineNumber() int32 {
+	if m != nil && m.LineNumber != nil {
+		return *m.LineNumber
+	}
+	return 0
+}
+
+// / A step of a concept
+type ExpandedStep struct {
+	// / The step
+	StepValue *ProtoStepValue `protobuf:"bytes,1,req,name=stepValue" json:"stepValue,omitempty"`
+	// / Nesting of the step, 1 for the steps of the concept itself, 2 for the steps of its nested concepts and so on
+	Depth *int32 `protobuf:"varint,2,req,name=depth" json:"depth,omitempty"`
+	// / Flag indicating if the step is a nested concept, whose steps follow it
+	IsConcept *bool `protobuf:"varint,3,req,name=isConcept" json:"isConcept,omitempty"`
+	// / The line number of the step in the concept file
+	LineNumber       *int32 `protobuf:"varint,4,req,name=lineNumber" json:"lineNumber,omitempty"`
+	XXX_unrecognized []byte `json:"-"`
+}
+
+func (m *ExpandedStep) Reset()                    { *m = ExpandedStep{} }
+func (m *ExpandedStep) String() string            { return proto.CompactTextString(m) }
+func (*ExpandedStep) ProtoMessage()               {}
+func (*ExpandedStep) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{34} }
+
+func (m *ExpandedStep) GetStepValue() *ProtoStepValue {
+	if m != nil {
+		return m.StepValue
+	}
+	return nil
+}
+
+func (m *ExpandedStep) GetDepth() int32 {
+	if m != nil && m.Depth != nil {
+		return *m.Depth
+	}
+	return 0
+}
+
+func (m *ExpandedStep) GetIsConcept() bool {
+	if m != nil && m.IsConcept != nil {
+		return *m.IsConcept
+	}
+	return false
+}
+
+func (m *ExpandedStep) GetLineNumber() int32 {
+	if m != nil && m.LineNumber != nil {
+		return *m.LineNumber
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*GetProjectRootRequest)(nil), "gauge.messages.GetProjectRootRequest")
 	proto.RegisterType((*GetProjectRootResponse)(nil), "gauge.messages.GetProjectRootResponse")
@@ -1048,84 +1379,113 @@ func init() {
 	proto.RegisterType((*FormatSpecsResponse)(nil), "gauge.messages.FormatSpecsResponse")
 	proto.RegisterType((*UnsupportedApiMessageResponse)(nil), "gauge.messages.UnsupportedApiMessageResponse")
 	proto.RegisterType((*APIMessage)(nil), "gauge.messages.APIMessage")
+	proto.RegisterType((*RenameConceptRequest)(nil), "gauge.messages.RenameConceptRequest")
+	proto.RegisterType((*InlineConceptRequest)(nil), "gauge.messages.InlineConceptRequest")
+	proto.RegisterType((*ConvertToTableDrivenScenarioRequest)(nil), "gauge.messages.ConvertToTableDrivenScenarioRequest")
+	proto.RegisterType((*SearchStepsRequest)(nil), "gauge.messages.SearchStepsRequest")
+	proto.RegisterType((*SearchStepsResponse)(nil), "gauge.messages.SearchStepsResponse")
+	proto.RegisterType((*StepDocumentation)(nil), "gauge.messages.StepDocumentation")
+	proto.RegisterType((*StepLocation)(nil), "gauge.messages.StepLocation")
+	proto.RegisterType((*ExpandedStep)(nil), "gauge.messages.ExpandedStep")
 	proto.RegisterEnum("gauge.messages.APIMessage_APIMessageType", APIMessage_APIMessageType_name, APIMessage_APIMessageType_value)
 }
 
 var fileDescriptor0 = []byte{
-	// 1185 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0x94, 0x57, 0x5d, 0x53, 0x1b, 0x37,
-	0x14, 0x1d, 0x30, 0x4e, 0xec, 0x6b, 0xb0, 0x85, 0x6c, 0x8c, 0x20, 0x05, 0xd2, 0x25, 0xed, 0x40,
-	0x5b, 0x28, 0xa5, 0x0f, 0x7d, 0xc8, 0x24, 0x29, 0x43, 0x03, 0xe3, 0x29, 0x61, 0x3c, 0x40, 0xfb,
-	0x2e, 0x8c, 0x6c, 0xb6, 0xb3, 0xec, 0x6e, 0x57, 0xeb, 0x49, 0xfa, 0x0b, 0xfb, 0xdc, 0xdf, 0xd3,
-	0x97, 0x5e, 0xc9, 0x5a, 0xb3, 0x1f, 0xda, 0x25, 0x7d, 0xb3, 0xaf, 0x74, 0xcf, 0x95, 0x8e, 0xce,
-	0xd5, 0xd1, 0x42, 0x93, 0x87, 0xee, 0x61, 0x18, 0x05, 0x71, 0x40, 0xdb, 0x13, 0x3e, 0x9d, 0x88,
-	0xc3, 0x07, 0x21, 0x25, 0x9f, 0x08, 0xb9, 0x09, 0x32, 0x14, 0xa3, 0xd9, 0x98, 0xb3, 0x0e, 0x6b,
-	0xe7, 0x22, 0x1e, 0x46, 0xc1, 0x1f, 0x62, 0x14, 0x5f, 0x05, 0x41, 0x7c, 0x25, 0xfe, 0x9c, 0x0a,
-	0x19, 0x3b, 0x07, 0xd0, 0xcf, 0x0f, 0xc8, 0x30, 0xf0, 0xa5, 0xa0, 0x5d, 0x68, 0x85, 0x8f, 0x61,
-	0xb6, 0xf0, 0x72, 0x71, 0xaf, 0xe9, 0x7c, 0x01, 0x9b, 0x38, 0x7d, 0xe0, 0xcb, 0x98, 0x7b, 0x1e,
-	0x8f, 0xdd, 0xc0, 0x4f, 0x83, 0xfd, 0x04, 0x2f, 0xac, 0xa3, 0x06, 0x91, 0x01, 0x71, 0x73, 0x63,
-	0x06, 0xb6, 0x07, 0x14, 0x13, 0x4f, 0x3c, 0xef, 0x3a, 0x16, 0xa1, 0x4c, 0xe0, 0xce, 0xa1, 0x9b,
-	0x89, 0x1a, 0x98, 0x23, 0x68, 0x70, 0x13, 0xc3, 0xf4, 0xda, 0x5e, 0xeb, 0x78, 0xfb, 0x30, 0xbb,
-	0xf5, 0xc3, 0xa1, 0xda, 0xb4, 0x9a, 0xf1, 0x3b, 0xf7, 0xa6, 0x22, 0x05, 0x8f, 0x8c, 0xcc, 0xe1,
-	0xdf, 0xcd, 0xe1, 0x67, 0x51, 0x03, 0xbf, 0x07, 0x75, 0x45, 0x5c, 0x82, 0xbd, 0x61, 0xc7, 0xc6,
-	0x19, 0x86, 0x54, 0x04, 0x38, 0x0d, 0xfc, 0x91, 0x08, 0xe3, 0xd4, 0xc2, 0xfb, 0xf9, 0x01, 0x03,
-	0x7e, 0x00, 0x8d, 0x91, 0x89, 0x19, 0xfc, 0x17, 0x79, 0x7c, 0x93, 0x33, 0xf0, 0xc7, 0x81, 0x33,
-	0x86, 0x56, 0xea, 0x2f, 0xfd, 0x01, 0x9a, 0x32, 0xd9, 0x94, 0x66, 0xee, 0xc9, 0xad, 0x53, 0x02,
-	0x8d, 0xb1, 0xeb, 0x89, 0x90, 0xc7, 0xf7, 0x6c, 0x51, 0x71, 0x4d, 0x29, 0x80, 0xe7, 0xfa, 0xe2,
-	0x72, 0xfa, 0x70, 0x2b, 0x22, 0x56, 0xc3, 0x58, 0xdd, 0x50, 0x31, 0xcf, 0x32, 0xfb, 0x50, 0xc9,
-	0xaa, 0xde, 0x8d, 0xf8, 0x64, 0x0e, 0x8a, 0xf6, 0xa1, 0x7d, 0xcf, 0xe5, 0xc0, 0x57, 0x08, 0x37,
-	0xfc, 0xd6, 0x13, 0x08, 0xba, 0xb0, 0xd7, 0x70, 0x06, 0xd0, 0xcb, 0x02, 0x98, 0xfd, 0xfe, 0xff,
-	0x15, 0x3b, 0x3f, 0xc2, 0x0e, 0x42, 0x5d, 0x70, 0x7f, 0x32, 0xc5, 0xe1, 0xa1, 0x37, 0x9d, 0xb8,
-	0xfe, 0x85, 0x7b, 0x3b, 0xc4, 0x1d, 0xa4, 0xd6, 0xe5, 0x99, 0x71, 0x23, 0xa0, 0x23, 0x78, 0x59,
-	0x9e, 0x64, 0xd6, 0xb2, 0x0c, 0x4b, 0x9a, 0x86, 0x59, 0xc6, 0x36, 0xac, 0xbc, 0x8f, 0xa2, 0x20,
-	0x9a, 0x0f, 0xaf, 0x40, 0x5d, 0xa8, 0x80, 0x19, 0x7f, 0x03, 0x1b, 0x43, 0x11, 0x8d, 0x83, 0xe8,
-	0xe1, 0x4a, 0x8c, 0xf9, 0x28, 0x0e, 0x22, 0xd7, 0x9f, 0x24, 0x0b, 0xe8, 0xc0, 0xf3, 0xc0, 0xbb,
-	0x53, 0x6b, 0x36, 0xbc, 0x60, 0xc0, 0x17, 0x1f, 0x75, 0x40, 0xb3, 0xec, 0x5c, 0xc3, 0xa6, 0x2d,
-	0xdd, 0xd4, 0xc2, 0xe9, 0x72, 0x3a, 0x1a, 0x21, 0x03, 0x3a, 0xbf, 0x41, 0xdb, 0xf0, 0x4c, 0x17,
-	0x97, 0x98, 0x5e, 0x43, 0xbc, 0x1e, 0x2c, 0xab, 0x63, 0x93, 0xa7, 0xf7, 0xb8, 0x25, 0x71, 0x87,
-	0xc7, 0x84, 0x51, 0x67, 0x1f, 0x36, 0xde, 0x7f, 0x8a, 0x23, 0x84, 0x4b, 0xa9, 0x22, 0x59, 0x13,
-	0x6e, 0x2f, 0x9e, 0x1f, 0x94, 0xf3, 0xcf, 0x02, 0xac, 0x65, 0xe7, 0x26, 0xf3, 0xf6, 0xa1, 0x65,
-	0x24, 0x78, 0xc9, 0x1f, 0x92, 0x43, 0xe9, 0xe5, 0x0f, 0x45, 0x9d, 0x1a, 0xdd, 0xc5, 0x56, 0xd0,
-	0x6d, 0xb6, 0xa8, 0xa5, 0x6a, 0x9f, 0xf4, 0x02, 0xba, 0x23, 0xbd, 0xca, 0x93, 0x51, 0x14, 0x48,
-	0x69, 0xae, 0x12, 0x2d, 0xac, 0x06, 0x5d, 0x87, 0x8e, 0x29, 0x76, 0x86, 0xdb, 0xd1, 0x05, 0x97,
-	0x34, 0x61, 0xc7, 0x40, 0xa4, 0xf0, 0x70, 0xa2, 0xb8, 0x53, 0xf2, 0x52, 0x1b, 0x61, 0x75, 0x94,
-	0x52, 0xeb, 0x98, 0xe5, 0xab, 0xc4, 0x66, 0x1c, 0xdb, 0xaa, 0x91, 0xfc, 0x4e, 0x74, 0x3d, 0xdf,
-	0x82, 0x96, 0x26, 0xde, 0x2d, 0x51, 0x8c, 0x3c, 0x5f, 0x28, 0x7d, 0x07, 0xfa, 0x24, 0xea, 0x74,
-	0x15, 0x9a, 0xc2, 0xbf, 0x33, 0xa1, 0x99, 0xdc, 0x5f, 0xc3, 0x92, 0x5e, 0x3a, 0x52, 0xe6, 0x3f,
-	0x02, 0xa0, 0x00, 0xe2, 0xb9, 0xa4, 0x35, 0x5e, 0xc8, 0x23, 0xfe, 0xa0, 0x65, 0xae, 0xeb, 0xd4,
-	0x54, 0xdc, 0x19, 0x42, 0x3f, 0x4f, 0xac, 0x39, 0x55, 0xac, 0xe4, 0xca, 0xeb, 0xcc, 0xb9, 0xce,
-	0x45, 0x35, 0xc3, 0xb4, 0x1f, 0xeb, 0x2e, 0xd0, 0x33, 0x14, 0x0a, 0x8f, 0xd3, 0xd7, 0x93, 0x4a,
-	0x7d, 0xbc, 0x87, 0x9a, 0x78, 0xb7, 0x76, 0x33, 0x93, 0x4c, 0xcd, 0x47, 0xe1, 0xe8, 0x69, 0x8a,
-	0x97, 0x8f, 0x3c, 0xf2, 0x91, 0x04, 0x23, 0x25, 0x67, 0x07, 0xb6, 0x7e, 0xf3, 0xe5, 0x34, 0x0c,
-	0x83, 0x08, 0xc9, 0x3e, 0x09, 0xdd, 0x0f, 0x33, 0x62, 0x13, 0x08, 0xe7, 0xef, 0x55, 0x80, 0x93,
-	0xe1, 0xc0, 0x84, 0xe9, 0x5b, 0x68, 0x19, 0xea, 0x6f, 0xfe, 0x0a, 0x67, 0xdc, 0xb4, 0x8f, 0xf7,
-	0xf3, 0x87, 0xf2, 0x98, 0x90, 0xfa, 0xa9, 0x12, 0x14, 0x0b, 0x66, 0xd6, 0xe0, 0x4e, 0x1f, 0x41,
-	0x8d, 0x9e, 0x00, 0x0d, 0x0b, 0xd6, 0xa3, 0xe9, 0x6c, 0x1d, 0x7f, 0x95, 0x47, 0xb6, 0xfa, 0x14,
-	0x3d, 0x85, 0x6e, 0x58, 0x34, 0x29, 0x14, 0x93, 0xc2, 0xf8, 0xfa, 0x29, 0x0c, 0x43, 0xd6, 0xaf,
-	0xb0, 0xee, 0xda, 0xad, 0xcb, 0x68, 0xef, 0x1b, 0x0b, 0x50, 0x89, 0xd9, 0xd1, 0x0f, 0xc0, 0xdc,
-	0x12, 0xa7, 0x63, 0xcf, 0x34, 0xda, 0xb7, 0x9f, 0x85, 0x66, 0xd6, 0xf6, 0x1a, 0x3a, 0x3c, 0xeb,
-	0x7f, 0xec, 0xb9, 0x46, 0x71, 0x2c, 0x28, 0x39, 0xa7, 0xa4, 0x6f, 0x80, 0xf0, 0x9c, 0x4d, 0xb2,
-	0x86, 0xce, 0xde, 0xad, 0xcc, 0xce, 0xd6, 0x4e, 0xa9, 0x8f, 0x35, 0x2b, 0x6b, 0xa7, 0x75, 0x6a,
-	0x6a, 0xa7, 0x55, 0xc9, 0xa0, 0xb2, 0x76, 0x46, 0xc0, 0x98, 0x2e, 0x73, 0xbe, 0xc3, 0x5a, 0xa5,
-	0xe9, 0x05, 0x8b, 0x7a, 0x07, 0xab, 0x32, 0xef, 0x3a, 0x6c, 0x59, 0xe7, 0xbf, 0xaa, 0xce, 0x37,
-	0xf5, 0xcf, 0xa1, 0xed, 0x65, 0xdc, 0x85, 0xad, 0xe8, 0xec, 0xef, 0x2d, 0xd9, 0x95, 0xa6, 0x34,
-	0x80, 0x8e, 0x97, 0x75, 0x1c, 0xd6, 0xd6, 0x48, 0x47, 0x9f, 0x8f, 0x64, 0xd6, 0xf4, 0x5d, 0x72,
-	0x6b, 0x74, 0x34, 0xc0, 0x56, 0x1e, 0x20, 0x6b, 0x5c, 0xd8, 0x5d, 0xbc, 0xf0, 0x06, 0x61, 0xa4,
-	0xb4, 0xbb, 0x8a, 0x0f, 0x16, 0xd5, 0x5d, 0xbc, 0xf8, 0x5a, 0x61, 0xab, 0xa5, 0xdd, 0x65, 0x7b,
-	0xdb, 0x5c, 0xc0, 0x46, 0x58, 0xe6, 0x98, 0x8c, 0x6a, 0xa8, 0xc2, 0x35, 0x52, 0x6e, 0xb1, 0x97,
-	0xb0, 0x19, 0x96, 0x1a, 0x28, 0xeb, 0xda, 0xdb, 0xb5, 0xc2, 0x72, 0x7f, 0x81, 0x35, 0x61, 0xf3,
-	0x43, 0xd6, 0xb3, 0x13, 0x65, 0x37, 0xcf, 0x33, 0xe8, 0x0b, 0xeb, 0xe5, 0xcf, 0xd6, 0xec, 0x5c,
-	0x95, 0x58, 0xc5, 0x5b, 0xa0, 0xe3, 0xc2, 0x95, 0xcf, 0xfa, 0xf6, 0xa6, 0xb3, 0x98, 0xc3, 0xcf,
-	0xd0, 0x1d, 0x17, 0xdd, 0x80, 0xad, 0xdb, 0x1b, 0xc7, 0x66, 0x1c, 0x37, 0xb0, 0x35, 0xad, 0xb2,
-	0x05, 0xc6, 0x34, 0xd6, 0x41, 0x1e, 0xab, 0xda, 0x4b, 0xfe, 0x5d, 0x82, 0x76, 0xce, 0x0f, 0x36,
-	0x4a, 0x3e, 0x3d, 0xc8, 0x02, 0xdd, 0x2c, 0xfb, 0xf8, 0x20, 0x8b, 0x74, 0xbb, 0xea, 0x4b, 0x83,
-	0xd4, 0xe8, 0x4e, 0xe5, 0xb7, 0x06, 0x59, 0x42, 0xff, 0xb6, 0x7c, 0x53, 0x90, 0x7a, 0x36, 0x3e,
-	0x9f, 0xff, 0x2c, 0x15, 0x4f, 0x11, 0x4d, 0x9e, 0xe3, 0x13, 0xc6, 0xf6, 0x99, 0x40, 0x1a, 0x66,
-	0x20, 0x7f, 0x23, 0x91, 0x26, 0x7e, 0xe7, 0x58, 0x1f, 0xc3, 0x04, 0xf0, 0x41, 0xf5, 0xd4, 0xdb,
-	0x96, 0xb4, 0xe8, 0xab, 0xa7, 0xdf, 0xb2, 0x64, 0x19, 0x6d, 0x36, 0xfb, 0x7e, 0x25, 0x2b, 0x86,
-	0xe9, 0x62, 0x7b, 0x93, 0xb6, 0x61, 0xda, 0xd2, 0xb5, 0xa4, 0x43, 0xb7, 0x2a, 0x5e, 0xba, 0x84,
-	0xa8, 0x83, 0x28, 0x6f, 0x2b, 0xb2, 0xaa, 0xaa, 0x5a, 0x7b, 0x85, 0x50, 0x55, 0xd5, 0xae, 0x7f,
-	0xd2, 0x55, 0x74, 0x17, 0x75, 0x4d, 0x7a, 0x8a, 0x55, 0x8b, 0x5c, 0xc9, 0x1a, 0xfd, 0xf2, 0x89,
-	0x77, 0x0c, 0xe9, 0xff, 0x17, 0x00, 0x00, 0xff, 0xff, 0x90, 0x9e, 0x6a, 0xfb, 0x0d, 0x0f, 0x00,
-	0x00,
+	// 1531 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x58, 0x6d, 0x73, 0x1a, 0x37,
+	0x10, 0x1e, 0x83, 0xb1, 0x61, 0x79, 0x93, 0x85, 0x8d, 0x65, 0x1c, 0x3b, 0xe4, 0x9c, 0x36, 0xa4,
+	0x4d, 0x5c, 0x97, 0x64, 0x26, 0x1f, 0x32, 0x71, 0x4a, 0xf3, 0xc2, 0x30, 0x75, 0x32, 0x8c, 0xed,
+	0xf6, 0x73, 0x95, 0x43, 0xe0, 0x6b, 0x8f, 0xbb, 0xcb, 0x9d, 0x88, 0x93, 0x9f, 0xd0, 0xff, 0xd1,
+	0x1f, 0xd4, 0xfe, 0xa3, 0x8e, 0x84, 0x0e, 0xee, 0x45, 0x87, 0xdd, 0x7c, 0x83, 0x95, 0xf6, 0xd9,
+	0xd5, 0xee, 0x6a, 0x1f, 0xed, 0x41, 0x89, 0x7a, 0xd6, 0xb1, 0xe7, 0xbb, 0xdc, 0xc5, 0xb5, 0x09,
+	0x9d, 0x4d, 0xd8, 0xf1, 0x94, 0x05, 0x01, 0x9d, 0xb0, 0xa0, 0x05, 0x81, 0xc7, 0xcc, 0xf9, 0x9a,
+	0xb1, 0x0b, 0x3b, 0x7d, 0xc6, 0x87, 0xbe, 0xfb, 0x07, 0x33, 0xf9, 0xb9, 0xeb, 0xf2, 0x73, 0xf6,
+	0x71, 0xc6, 0x02, 0x6e, 0x3c, 0x86, 0x66, 0x72, 0x21, 0xf0, 0x5c, 0x27, 0x60, 0xb8, 0x01, 0x65,
+	0x6f, 0x29, 0x26, 0x6b, 0xed, 0x5c, 0xa7, 0x64, 0xdc, 0x81, 0x56, 0x9f, 0xf1, 0x81, 0x13, 0x70,
+	0x6a, 0xdb, 0x94, 0x5b, 0xae, 0x13, 0x05, 0x7b, 0x06, 0xfb, 0xda, 0x55, 0x85, 0x48, 0x00, 0x59,
+	0x89, 0x35, 0x05, 0xbb, 0x0d, 0xb8, 0xcf, 0x78, 0xcf, 0xb6, 0x2f, 0x38, 0xf3, 0x82, 0x10, 0xae,
+	0x0f, 0x8d, 0x98, 0x54, 0xc1, 0x9c, 0x40, 0x91, 0x2a, 0x19, 0x59, 0x6b, 0xe7, 0x3b, 0xe5, 0xee,
+	0xe1, 0x71, 0xfc, 0xe8, 0xc7, 0x43, 0x71, 0x68, 0xb1, 0xe3, 0x37, 0x6a, 0xcf, 0x58, 0x04, 0xde,
+	0x63, 0xe6, 0x02, 0xfe, 0x25, 0x34, 0x62, 0x52, 0x05, 0xdf, 0x81, 0x82, 0x08, 0x5c, 0x88, 0xbd,
+	0xa7, 0xc7, 0xf6, 0x98, 0xa9, 0x82, 0xda, 0xb3, 0xed, 0x57, 0xae, 0x63, 0x32, 0x8f, 0x47, 0x1c,
+	0x6f, 0x26, 0x17, 0x14, 0xf8, 0x63, 0x28, 0x9a, 0x4a, 0xa6, 0xf0, 0xf7, 0x93, 0xf8, 0x4a, 0x67,
+	0xe0, 0x8c, 0x5d, 0x63, 0x0c, 0xe5, 0xc8, 0x5f, 0xfc, 0x23, 0x94, 0x82, 0xf0, 0x50, 0x32, 0x72,
+	0x37, 0x1e, 0x1d, 0x23, 0x28, 0x8e, 0x2d, 0x9b, 0x79, 0x94, 0x5f, 0x91, 0x9c, 0x88, 0x35, 0xc6,
+	0x00, 0xb6, 0xe5, 0xb0, 0xf7, 0xb3, 0xe9, 0x07, 0xe6, 0x93, 0x7c, 0x3b, 0xd7, 0x29, 0xa8, 0x50,
+	0x2c, 0xb4, 0xd4, 0x39, 0x84, 0xb2, 0xb0, 0x77, 0xc9, 0x3e, 0xab, 0x44, 0xe1, 0x26, 0xd4, 0xae,
+	0x68, 0x30, 0x70, 0x04, 0xc2, 0x25, 0xfd, 0x60, 0x33, 0x92, 0x6b, 0xaf, 0x75, 0x8a, 0xc6, 0x00,
+	0xb6, 0xe3, 0x00, 0xea, 0xbc, 0xff, 0xdf, 0x63, 0xe3, 0x09, 0xdc, 0xed, 0x33, 0x7e, 0x46, 0x9d,
+	0xc9, 0x8c, 0x4e, 0xd8, 0xd0, 0x9e, 0x4d, 0x2c, 0xe7, 0xcc, 0xfa, 0x30, 0xa4, 0xfc, 0x2a, 0xe2,
+	0x97, 0xad, 0xd6, 0x55, 0x01, 0x9d, 0x40, 0x3b, 0x5b, 0x49, 0xf9, 0x52, 0x81, 0x75, 0x19, 0x86,
+	0xb9, 0xc6, 0x21, 0x54, 0xdf, 0xf8, 0xbe, 0xeb, 0x2f, 0x96, 0xab, 0x50, 0x60, 0x42, 0xa0, 0xd6,
+	0x5f, 0xc0, 0xde, 0x90, 0xf9, 0x63, 0xd7, 0x9f, 0x9e, 0xb3, 0x31, 0x35, 0xb9, 0xeb, 0x5b, 0xce,
+	0x24, 0x74, 0xa0, 0x0e, 0x9b, 0xae, 0x3d, 0x12, 0x3e, 0xab, 0xb8, 0xd4, 0x61, 0xd3, 0x61, 0xd7,
+	0x52, 0x20, 0xa3, 0x6c, 0x5c, 0x40, 0x4b, 0xa7, 0xae, 0x6c, 0xd5, 0x61, 0x33, 0x98, 0x99, 0x26,
+	0x0b, 0x02, 0xa9, 0x5f, 0xc4, 0x35, 0xd8, 0x90, 0xc6, 0x03, 0x92, 0x6b, 0xe7, 0x3b, 0x25, 0xbc,
+	0x0d, 0x15, 0x91, 0xb6, 0xe0, 0xd5, 0x15, 0x75, 0x26, 0x6c, 0x44, 0xf2, 0x42, 0x6a, 0x3c, 0x84,
+	0xbd, 0x37, 0x9f, 0xb9, 0x4f, 0x4d, 0x1e, 0xa9, 0x8a, 0xd0, 0xa7, 0x0a, 0xac, 0xf3, 0x45, 0xa2,
+	0x8c, 0x7f, 0xd7, 0x60, 0x27, 0xbe, 0x37, 0xdc, 0xf7, 0x10, 0xca, 0xaa, 0x04, 0xdf, 0xd3, 0x69,
+	0x98, 0x94, 0xed, 0x64, 0x52, 0x44, 0xd6, 0xf0, 0x11, 0x14, 0x02, 0x79, 0xcd, 0x72, 0xed, 0x7c,
+	0xe6, 0xa6, 0x7d, 0x68, 0x98, 0xd2, 0xcb, 0x9e, 0xe9, 0xbb, 0x41, 0xa0, 0x5a, 0x89, 0x2c, 0xac,
+	0x22, 0xde, 0x85, 0xba, 0x32, 0xf6, 0xd6, 0xb2, 0x99, 0x34, 0xb8, 0x2e, 0x03, 0xd6, 0x05, 0x14,
+	0x30, 0x9b, 0x99, 0x9c, 0x8d, 0x44, 0x79, 0x89, 0x83, 0x90, 0x42, 0x7b, 0xad, 0x53, 0xee, 0x92,
+	0xa4, 0x15, 0xae, 0xd6, 0x8d, 0x3e, 0x14, 0xc3, 0xdf, 0x61, 0x5d, 0x2f, 0x8e, 0x20, 0x4b, 0x33,
+	0xe0, 0xd4, 0xe7, 0x96, 0x33, 0x39, 0x13, 0xf5, 0xed, 0xca, 0x4c, 0x14, 0xf0, 0x16, 0x94, 0x98,
+	0x33, 0x52, 0xa2, 0x79, 0xb9, 0x3f, 0x87, 0x75, 0xe9, 0x7a, 0x05, 0xd6, 0x9d, 0x25, 0x40, 0x15,
+	0x0a, 0x7c, 0x51, 0xd2, 0x12, 0xcf, 0xa3, 0x3e, 0x9d, 0xca, 0x32, 0x97, 0x76, 0xf2, 0x42, 0x6e,
+	0x0c, 0xa1, 0x99, 0x0c, 0xac, 0xca, 0xea, 0x16, 0x94, 0xac, 0xe0, 0x22, 0x96, 0xd7, 0x45, 0x51,
+	0xcd, 0x31, 0xf5, 0x69, 0x3d, 0x02, 0xfc, 0xd6, 0xf5, 0xa7, 0x94, 0x47, 0xdb, 0x13, 0xae, 0x46,
+	0xfb, 0x50, 0xc9, 0x78, 0x06, 0x8d, 0xd8, 0x26, 0x65, 0x73, 0x59, 0x38, 0x72, 0x9b, 0x88, 0xcb,
+	0x35, 0xf5, 0x1d, 0xcb, 0x99, 0xa8, 0x52, 0x32, 0xee, 0xc2, 0xc1, 0xaf, 0x4e, 0x30, 0xf3, 0x3c,
+	0xd7, 0xe7, 0x6c, 0xd4, 0xf3, 0xac, 0x77, 0xf3, 0xc0, 0x86, 0x10, 0xc6, 0x3f, 0x3b, 0x00, 0xbd,
+	0xe1, 0x40, 0x89, 0xf1, 0x29, 0x94, 0x55, 0xe8, 0x2f, 0xbf, 0x78, 0xf3, 0xd8, 0xd4, 0xba, 0x0f,
+	0x93, 0x49, 0x59, 0x2a, 0x44, 0x7e, 0x0a, 0x05, 0x11, 0x05, 0xb5, 0x6b, 0x30, 0x92, 0x29, 0xc8,
+	0xe3, 0x1e, 0x60, 0x2f, 0x45, 0x3d, 0x32, 0x9c, 0xe5, 0xee, 0x37, 0x49, 0x64, 0x2d, 0x4f, 0xe1,
+	0x57, 0xd0, 0xf0, 0xd2, 0x24, 0x45, 0xd6, 0x25, 0xc6, 0xb7, 0x37, 0x61, 0xa8, 0x60, 0xfd, 0x02,
+	0xbb, 0x96, 0x9e, 0xba, 0x54, 0xed, 0x7d, 0xa7, 0x01, 0xca, 0x20, 0x3b, 0xfc, 0x0e, 0x88, 0x95,
+	0xc1, 0x74, 0x64, 0x43, 0xa2, 0x7d, 0x7f, 0x2b, 0x34, 0xe5, 0xdb, 0x73, 0xa8, 0xd3, 0x38, 0xff,
+	0x91, 0x4d, 0x89, 0x62, 0x68, 0x50, 0x12, 0x4c, 0x89, 0x5f, 0x00, 0xa2, 0x09, 0x9a, 0x24, 0x45,
+	0xa9, 0x7d, 0xb4, 0x52, 0x3b, 0x6e, 0x3b, 0x52, 0x7d, 0xa4, 0xb4, 0xd2, 0x76, 0xb4, 0x4e, 0x95,
+	0xed, 0x68, 0x55, 0x12, 0x58, 0x69, 0x3b, 0x56, 0xc0, 0x2f, 0x00, 0x05, 0x09, 0xde, 0x21, 0xe5,
+	0x4c, 0xf5, 0x14, 0x45, 0xbd, 0x84, 0xad, 0x20, 0xc9, 0x3a, 0xa4, 0x22, 0xf5, 0xef, 0xaf, 0xd6,
+	0x57, 0xf6, 0xfb, 0x50, 0xb3, 0x63, 0xec, 0x42, 0xaa, 0x52, 0xfb, 0x07, 0x8d, 0xf6, 0x4a, 0x52,
+	0x1a, 0x40, 0xdd, 0x8e, 0x33, 0x0e, 0xa9, 0x49, 0xa4, 0x93, 0xdb, 0x23, 0x29, 0x9f, 0x1e, 0x85,
+	0x5d, 0xa3, 0x2e, 0x01, 0x0e, 0x92, 0x00, 0x71, 0xe2, 0xea, 0x01, 0xa6, 0xa9, 0x37, 0x08, 0x41,
+	0x99, 0xb7, 0x2b, 0xfd, 0x60, 0x11, 0xb7, 0x8b, 0xa6, 0x5f, 0x2b, 0x64, 0x2b, 0xf3, 0x76, 0xe9,
+	0xde, 0x36, 0x67, 0xb0, 0xe7, 0x65, 0x31, 0x26, 0xc1, 0x12, 0x2a, 0xd5, 0x46, 0xb2, 0x29, 0xf6,
+	0x3d, 0xb4, 0xbc, 0x4c, 0x02, 0x25, 0x0d, 0xfd, 0x75, 0x5d, 0x41, 0xb9, 0xaf, 0x61, 0x87, 0xe9,
+	0xf8, 0x90, 0x6c, 0xeb, 0x03, 0xa5, 0x27, 0xcf, 0xb7, 0xd0, 0x64, 0xda, 0xe6, 0x4f, 0x76, 0xf4,
+	0xb1, 0xca, 0xa0, 0x8a, 0x53, 0xc0, 0xe3, 0x54, 0xcb, 0x27, 0x4d, 0xfd, 0xa5, 0xd3, 0x90, 0xc3,
+	0x4f, 0xd0, 0x18, 0xa7, 0xd9, 0x80, 0xec, 0xea, 0x2f, 0x8e, 0x8e, 0x38, 0x2e, 0xe1, 0x60, 0xb6,
+	0x8a, 0x16, 0x08, 0x91, 0x58, 0x8f, 0x93, 0x58, 0x2b, 0xb9, 0x04, 0xff, 0x0c, 0xdb, 0x3e, 0x13,
+	0x9c, 0x9a, 0x08, 0xf2, 0x9e, 0xfe, 0x46, 0x9e, 0x6b, 0xf6, 0x0a, 0x0c, 0x4b, 0x3e, 0x30, 0x13,
+	0x18, 0x2d, 0x3d, 0xc6, 0x40, 0xb3, 0x17, 0xff, 0x0e, 0x47, 0xa6, 0xeb, 0x7c, 0x62, 0x3e, 0xbf,
+	0x74, 0x25, 0x81, 0xbf, 0xf6, 0xad, 0x4f, 0xcc, 0xb9, 0x30, 0x99, 0x43, 0x7d, 0x2b, 0x7c, 0x33,
+	0x91, 0x7d, 0x09, 0xf9, 0x44, 0xf3, 0x04, 0xbf, 0x49, 0x55, 0xbe, 0x16, 0xdc, 0x3f, 0x99, 0x43,
+	0xee, 0x48, 0x66, 0x3f, 0x05, 0x1c, 0x30, 0xea, 0x9b, 0x57, 0xb1, 0x0e, 0x7e, 0xa0, 0x4f, 0xe8,
+	0x45, 0x6a, 0xa7, 0x48, 0x68, 0x4c, 0x5f, 0x25, 0xe1, 0x50, 0x9f, 0xd0, 0x8b, 0xf4, 0x56, 0xe3,
+	0xaf, 0x0d, 0xa8, 0x25, 0xa8, 0x78, 0x2f, 0x63, 0xea, 0x43, 0x6b, 0xb8, 0x95, 0x35, 0xf7, 0xa1,
+	0x1c, 0x3e, 0x5c, 0x35, 0xe4, 0xa1, 0x3c, 0xbe, 0xbb, 0x72, 0xcc, 0x43, 0xeb, 0xb8, 0xa9, 0x1b,
+	0xe7, 0x50, 0x21, 0x2e, 0x5f, 0xec, 0xdf, 0x88, 0xc8, 0x23, 0x35, 0x8e, 0x36, 0xf1, 0xae, 0x76,
+	0x42, 0x43, 0x45, 0xb5, 0x90, 0x24, 0x03, 0x54, 0xc2, 0x44, 0x3f, 0x87, 0x20, 0xc0, 0x47, 0x37,
+	0x8e, 0x15, 0xa8, 0x8c, 0xef, 0xdf, 0x3c, 0x46, 0xa0, 0x0a, 0xde, 0x4a, 0x8c, 0x0e, 0xa8, 0xaa,
+	0x22, 0x9d, 0xee, 0xac, 0xa8, 0xa6, 0x22, 0xad, 0x69, 0x98, 0xa8, 0x8e, 0x0f, 0x56, 0x0c, 0x19,
+	0x08, 0x89, 0x44, 0x64, 0x77, 0x34, 0xb4, 0x25, 0xac, 0x6a, 0xdb, 0x14, 0xc2, 0xc2, 0xaa, 0xbe,
+	0xf5, 0xa0, 0x86, 0x08, 0x77, 0xba, 0xa5, 0xa0, 0x6d, 0x11, 0x55, 0x4d, 0xa7, 0x40, 0x3b, 0xf8,
+	0xde, 0x0d, 0x4f, 0x48, 0xd4, 0x14, 0x81, 0xd7, 0x5d, 0x66, 0xb4, 0x2b, 0x56, 0x74, 0x57, 0x14,
+	0x11, 0xfc, 0x00, 0x8e, 0x6e, 0x71, 0xd3, 0xd0, 0x9e, 0x70, 0x38, 0x7d, 0x65, 0x50, 0x4b, 0x38,
+	0xac, 0xb9, 0x09, 0x68, 0xdf, 0x38, 0xd5, 0x7b, 0x23, 0x66, 0x5f, 0xd7, 0x1e, 0x29, 0xa1, 0x7a,
+	0xf6, 0x63, 0x00, 0x87, 0x5d, 0x87, 0xb2, 0xf9, 0xf4, 0xf6, 0x40, 0xef, 0xb3, 0x98, 0xdb, 0xcc,
+	0xa8, 0xb2, 0xf1, 0xec, 0x56, 0x47, 0x90, 0x83, 0xb4, 0xc7, 0x4c, 0x31, 0x03, 0x29, 0xc5, 0xae,
+	0xee, 0x48, 0xa2, 0xa9, 0x7c, 0x9c, 0x31, 0xff, 0xcb, 0x72, 0x22, 0xb1, 0xad, 0xa9, 0xc5, 0xe5,
+	0xf4, 0x50, 0x10, 0xdf, 0x43, 0x34, 0xc7, 0xc5, 0x27, 0xe1, 0x94, 0x36, 0xff, 0xa0, 0x70, 0x2f,
+	0xd5, 0x2c, 0x38, 0xf3, 0x5e, 0xbb, 0xe6, 0x6c, 0xca, 0x1c, 0x2e, 0x6f, 0xab, 0xf1, 0x77, 0x0e,
+	0xb6, 0x52, 0xd2, 0xaf, 0xf9, 0xba, 0x20, 0x27, 0x9e, 0x68, 0xe8, 0x8a, 0xf8, 0x11, 0x6c, 0xcc,
+	0xe4, 0x5e, 0x39, 0xdc, 0x94, 0xbb, 0x77, 0x74, 0xee, 0x9c, 0xb9, 0xe6, 0xdc, 0xe6, 0x53, 0xa8,
+	0x59, 0x53, 0xcf, 0x66, 0x0b, 0x2f, 0xd4, 0x8b, 0x7e, 0xb5, 0xd6, 0x09, 0xc0, 0x88, 0x8d, 0x2d,
+	0xc7, 0x92, 0x1a, 0x85, 0x5b, 0x68, 0x3c, 0x81, 0x2a, 0xfb, 0xec, 0x51, 0x67, 0xc4, 0x46, 0xf3,
+	0x0f, 0x47, 0x1b, 0x7a, 0xe7, 0xde, 0x44, 0x36, 0x19, 0x4f, 0xa1, 0x12, 0x03, 0x49, 0xcf, 0x9c,
+	0xf1, 0x6f, 0x29, 0x72, 0xde, 0x34, 0xae, 0xa1, 0x12, 0x45, 0xf9, 0x9a, 0xb0, 0x56, 0xa1, 0x30,
+	0x62, 0x1e, 0xbf, 0x5a, 0x4e, 0xb0, 0xcb, 0x28, 0xcf, 0xe7, 0xea, 0xb8, 0x61, 0x31, 0x52, 0x17,
+	0xfe, 0x1b, 0x00, 0xf6, 0xbc, 0x1b, 0x25, 0x0b, 0x14, 0x00, 0x00,
 }
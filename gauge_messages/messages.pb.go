@@ -801,7 +801,11 @@ type StepNameResponse struct {
 	// / The Step name of the given step.
 	StepName []string `protobuf:"bytes,2,rep,name=stepName" json:"stepName,omitempty"`
 	// / Flag indicating if the given Step is an alias.
-	HasAlias         *bool  `protobuf:"varint,3,req,name=hasAlias" json:"hasAlias,omitempty"`
+	HasAlias *bool `protobuf:"varint,3,req,name=hasAlias" json:"hasAlias,omitempty"`
+	// / File in which the step is implemented, if the runner knows it.
+	FileName *string `protobuf:"bytes,4,opt,name=fileName" json:"fileName,omitempty"`
+	// / Line at which the step is implemented in the file, if the runner knows it.
+	LineNumber       *int32 `protobuf:"varint,5,opt,name=lineNumber" json:"lineNumber,omitempty"`
 	XXX_unrecognized []byte `json:"-"`
 }
 
@@ -831,6 +835,20 @@ func (m *StepNameResponse) GetHasAlias() bool {
 	return false
 }
 
+func (m *StepNameResponse) GetFileName() string {
+	if m != nil && m.FileName != nil {
+		return *m.FileName
+	}
+	return ""
+}
+
+func (m *StepNameResponse) GetLineNumber() int32 {
+	if m != nil && m.LineNumber != nil {
+		return *m.LineNumber
+	}
+	return 0
+}
+
 // / Response when a unsupported message request is sent.
 type UnsupportedMessageResponse struct {
 	Message          *string `protobuf:"bytes,1,opt,name=message" json:"message,omitempty"`
@@ -1131,90 +1149,91 @@ func init() {
 }
 
 var fileDescriptor2 = []byte{
-	// 1359 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0xb4, 0x58, 0x6f, 0x6f, 0xdb, 0xb6,
-	0x13, 0x6e, 0x9c, 0xa4, 0xb1, 0x4f, 0x89, 0x4d, 0xd3, 0x4e, 0xcc, 0xb8, 0x6d, 0x7e, 0xa9, 0x5a,
-	0xfc, 0xd6, 0x0d, 0xab, 0x51, 0x18, 0x1b, 0xd0, 0x6d, 0xed, 0xb0, 0xae, 0x75, 0xd1, 0x74, 0x8d,
-	0x63, 0xd4, 0x6e, 0xf7, 0x0f, 0x43, 0xa0, 0xd9, 0x8c, 0x2b, 0xd4, 0x91, 0x3c, 0x51, 0xc2, 0xba,
-	0xef, 0xb0, 0x0f, 0xb3, 0x97, 0xfb, 0x32, 0xfb, 0x2a, 0xc3, 0x48, 0x9a, 0x92, 0x2d, 0x89, 0x54,
-	0xfb, 0x22, 0x79, 0x15, 0x98, 0xbc, 0x7b, 0xee, 0x78, 0x3c, 0x3e, 0xcf, 0x29, 0x50, 0x3d, 0xa7,
-	0x8c, 0x39, 0x53, 0xca, 0x3a, 0xf3, 0xc0, 0x0f, 0x7d, 0x5c, 0x9d, 0x3a, 0xd1, 0x94, 0x76, 0xe2,
-	0xd5, 0x36, 0xb0, 0x39, 0x1d, 0x2f, 0xf6, 0xec, 0x26, 0xe0, 0xef, 0xdc, 0xd9, 0x6c, 0x10, 0xf8,
-	0x63, 0xbe, 0xfd, 0x92, 0xfe, 0x16, 0x51, 0x16, 0xda, 0x3f, 0x40, 0xab, 0xf7, 0x8e, 0x8e, 0xa3,
-	0xd0, 0xf5, 0xbd, 0x61, 0xe8, 0x84, 0x11, 0xdf, 0x61, 0x73, 0xdf, 0x63, 0x14, 0x3f, 0x84, 0x1a,
-	0x8d, 0xb7, 0xf8, 0x62, 0x34, 0x0b, 0xc9, 0xda, 0x61, 0xe9, 0x8e, 0xd5, 0xbd, 0xdd, 0x49, 0x87,
-	0xe9, 0x0c, 0x44, 0x80, 0x5e, 0xda, 0xd6, 0xfe, 0x1e, 0xc8, 0x2a, 0x72, 0x10, 0xba, 0xde, 0x54,
-	0x45, 0xc5, 0x5f, 0x41, 0x73, 0x1c, 0x05, 0x01, 0xf5, 0xc2, 0xc4, 0xe4, 0xc8, 0x3b, 0xf3, 0x39,
-	0xfe, 0x1a, 0xc7, 0xbf, 0x91, 0xc5, 0x4f, 0x19, 0xd9, 0xaf, 0x60, 0x2f, 0x59, 0xe8, 0x79, 0x93,
-	0x8b, 0x82, 0xfd, 0x19, 0xae, 0x0f, 0x79, 0xb5, 0x2e, 0x27, 0xe7, 0x1f, 0xa1, 0x9d, 0x02, 0xbf,
-	0xc0, 0xbc, 0x4f, 0xe1, 0x70, 0x38, 0xa6, 0x9e, 0x13, 0xb8, 0xfe, 0xe5, 0xe4, 0xfe, 0x0b, 0x1c,
-	0xe4, 0x02, 0x5c, 0x70, 0xdd, 0x43, 0x3a, 0xbf, 0xbc, 0xba, 0xaf, 0x82, 0x5f, 0x60, 0xde, 0x7f,
-	0xaf, 0xc1, 0x4e, 0x6a, 0x05, 0xdf, 0x05, 0x4b, 0xc1, 0x89, 0xbb, 0x56, 0x28, 0x24, 0x8b, 0x22,
-	0xf6, 0xa4, 0xf9, 0xe7, 0x50, 0x8b, 0xcd, 0x55, 0x79, 0x49, 0x49, 0xba, 0x5c, 0xcf, 0xb9, 0xa8,
-	0xfd, 0x6c, 0x14, 0x7e, 0x32, 0xb2, 0x6e, 0x88, 0xc2, 0xf7, 0xa4, 0x39, 0x06, 0x60, 0xa1, 0x33,
-	0x7e, 0x1b, 0x06, 0xce, 0x98, 0x92, 0x0d, 0x6e, 0x5d, 0xb1, 0x9f, 0x43, 0x39, 0xc9, 0x62, 0x1b,
-	0x36, 0x3c, 0xe7, 0x9c, 0xca, 0xa7, 0x5d, 0xc1, 0x08, 0xca, 0x67, 0xee, 0x8c, 0xf6, 0xc5, 0x4a,
-	0x29, 0x5e, 0x71, 0xd9, 0x53, 0x87, 0x2f, 0x4e, 0x78, 0xac, 0xd2, 0x9d, 0xb2, 0xf0, 0x08, 0x9d,
-	0x29, 0xe3, 0x58, 0xeb, 0x1c, 0xeb, 0x01, 0x6c, 0xa7, 0xd2, 0xcb, 0xe1, 0x25, 0xde, 0xa5, 0x94,
-	0xf7, 0xba, 0xf4, 0xee, 0xf3, 0x4c, 0xe2, 0x4c, 0xef, 0xc1, 0x06, 0x13, 0x27, 0x5a, 0x90, 0x8c,
-	0xad, 0xaf, 0x3e, 0x15, 0xe6, 0xf1, 0xfd, 0xe5, 0xd0, 0xed, 0x3f, 0xd7, 0x00, 0x6b, 0x0c, 0xf7,
-	0xa0, 0xea, 0x8c, 0xc3, 0xc8, 0x99, 0x89, 0xc5, 0x11, 0x7d, 0x17, 0xaa, 0xf4, 0xf8, 0xfa, 0xdc,
-	0x09, 0x18, 0x9d, 0x24, 0xeb, 0x8b, 0x43, 0xb7, 0xa0, 0xc6, 0xd4, 0xa1, 0x04, 0x3c, 0x6f, 0x19,
-	0x59, 0xe7, 0x32, 0x2f, 0x3e, 0x70, 0x07, 0x7e, 0xbe, 0x90, 0x06, 0x8b, 0x0a, 0x58, 0xdd, 0xfd,
-	0x1c, 0x1d, 0xc6, 0x16, 0xf6, 0x63, 0x68, 0x08, 0xe4, 0xd7, 0xce, 0xcc, 0x9d, 0x38, 0x21, 0x5d,
-	0xc9, 0x9b, 0xa5, 0x13, 0x69, 0x03, 0xf6, 0xa2, 0xf3, 0x5f, 0x69, 0x70, 0x72, 0x36, 0x58, 0xe2,
-	0x8b, 0x64, 0x36, 0xed, 0xbf, 0xd6, 0xa0, 0x99, 0x46, 0x51, 0x04, 0x5d, 0x83, 0x2d, 0x97, 0xc9,
-	0x55, 0x89, 0x52, 0xc6, 0x4d, 0xd8, 0xa6, 0x41, 0xe0, 0x07, 0xc7, 0x8b, 0x4c, 0x64, 0x3b, 0x55,
-	0xf0, 0x23, 0xa8, 0xc8, 0xd5, 0xd1, 0x1f, 0x73, 0x2a, 0x8f, 0x51, 0xed, 0x76, 0x74, 0xed, 0x92,
-	0xc5, 0xef, 0xf4, 0x62, 0x2f, 0xbb, 0x03, 0x95, 0xe4, 0x07, 0xbe, 0x09, 0x37, 0x86, 0xa3, 0xde,
-	0xe0, 0xf4, 0xe8, 0x78, 0xf0, 0xa2, 0x77, 0xdc, 0xeb, 0x8f, 0x1e, 0x8d, 0x8e, 0x4e, 0xfa, 0xa7,
-	0xfd, 0x93, 0xd1, 0xe9, 0xd3, 0x93, 0x57, 0xfd, 0x27, 0xe8, 0x8a, 0x7d, 0xcc, 0x33, 0x8e, 0xdc,
-	0x90, 0x66, 0x34, 0x81, 0xb7, 0xbc, 0xc5, 0xc4, 0x7a, 0x4a, 0x4e, 0x0e, 0xb5, 0x72, 0x32, 0x5c,
-	0xda, 0xd9, 0x18, 0x90, 0x48, 0x50, 0x74, 0x65, 0x22, 0x5c, 0x36, 0xd4, 0x57, 0xd6, 0x54, 0x45,
-	0x76, 0x60, 0x53, 0x14, 0x96, 0x71, 0x64, 0xd1, 0x5d, 0x07, 0x9c, 0x5a, 0xd4, 0x35, 0x3e, 0x71,
-	0x42, 0x67, 0x18, 0xfa, 0x01, 0x3d, 0xf2, 0xdc, 0x30, 0xc6, 0x68, 0x03, 0x11, 0xef, 0x40, 0xbb,
-	0x77, 0x0d, 0xf6, 0x65, 0x0a, 0xda, 0xcd, 0x87, 0x50, 0x4f, 0xae, 0x69, 0xe0, 0x33, 0x57, 0x1c,
-	0x11, 0x37, 0xc0, 0xf2, 0x67, 0x93, 0xf8, 0xa7, 0x3c, 0xdc, 0xa6, 0x58, 0xf4, 0xe8, 0xef, 0xc9,
-	0xe2, 0xe2, 0x46, 0x39, 0x75, 0xd4, 0x5e, 0xd2, 0x33, 0xde, 0x92, 0x7e, 0x10, 0xf7, 0xc4, 0x67,
-	0xb0, 0xcd, 0xbd, 0xd5, 0x3d, 0x44, 0x54, 0xd5, 0xe6, 0x40, 0x5f, 0x9b, 0xd8, 0x4a, 0x78, 0x71,
-	0xf8, 0xa5, 0x57, 0xe9, 0x83, 0xbc, 0xbe, 0x90, 0x6d, 0xef, 0x9c, 0xc7, 0x69, 0x2d, 0x5e, 0xa3,
-	0xd5, 0xbd, 0x69, 0xec, 0xe4, 0xd8, 0xd2, 0x7e, 0x06, 0x68, 0x99, 0xf9, 0xb2, 0x0f, 0x59, 0x34,
-	0x16, 0x53, 0x85, 0xea, 0x43, 0x7e, 0x0d, 0xb2, 0xe3, 0x54, 0x03, 0xf2, 0xb6, 0x14, 0xa4, 0xc2,
-	0x1e, 0xbf, 0x71, 0xbc, 0xa9, 0xa4, 0x11, 0x71, 0x39, 0xb7, 0xa1, 0x16, 0x5f, 0x60, 0x5c, 0x83,
-	0x3a, 0x54, 0x58, 0xaa, 0x00, 0x15, 0xde, 0x49, 0x68, 0x69, 0xa5, 0xe2, 0xed, 0xc2, 0x8e, 0xcb,
-	0xc4, 0xea, 0x20, 0xa0, 0x8c, 0xf3, 0xa0, 0x8a, 0xaa, 0x5e, 0x95, 0xe2, 0xae, 0xf5, 0x05, 0xfb,
-	0xbc, 0x71, 0xd8, 0xa3, 0x99, 0xeb, 0xb0, 0x05, 0x77, 0xd9, 0x77, 0xa1, 0xfd, 0xca, 0x63, 0xd1,
-	0x7c, 0xee, 0x07, 0x21, 0x9d, 0xa8, 0x77, 0xb2, 0x7a, 0x10, 0x75, 0x74, 0x49, 0xde, 0x15, 0xfb,
-	0x5f, 0x0c, 0x5b, 0xca, 0x08, 0xdf, 0x07, 0x4b, 0x6d, 0xca, 0x87, 0x24, 0x62, 0x56, 0xbb, 0xb7,
-	0xb2, 0x15, 0x53, 0xd6, 0xf1, 0x5f, 0xf9, 0x60, 0xf8, 0xb1, 0xd4, 0xfe, 0xd1, 0x82, 0xa7, 0xd6,
-	0xf1, 0x73, 0x20, 0xd4, 0x20, 0x78, 0x8a, 0xd1, 0xef, 0x18, 0xd5, 0x27, 0x2b, 0x90, 0x2f, 0xe1,
-	0x3a, 0x2b, 0x18, 0x5c, 0x24, 0xe7, 0x5b, 0xdd, 0x4f, 0x75, 0x3a, 0x64, 0xc4, 0xec, 0x43, 0x9b,
-	0x19, 0xe7, 0x15, 0xb2, 0x29, 0x11, 0x3f, 0x29, 0x44, 0x4c, 0x2b, 0xed, 0x4f, 0x70, 0xc8, 0xde,
-	0x33, 0xa4, 0x90, 0xab, 0x12, 0xf5, 0x9e, 0x49, 0xfc, 0x8c, 0xb9, 0xbe, 0x86, 0x03, 0x56, 0x38,
-	0x9f, 0x90, 0x2d, 0x89, 0xdc, 0x79, 0x2f, 0x72, 0x3a, 0x67, 0x51, 0xd7, 0x82, 0xc1, 0x84, 0x94,
-	0x0d, 0x75, 0x2d, 0x1a, 0x66, 0x44, 0x5d, 0x8d, 0xf3, 0x08, 0xa9, 0x18, 0xea, 0x6a, 0x9e, 0x60,
-	0xbe, 0x06, 0x4c, 0x73, 0x72, 0x47, 0x40, 0xe2, 0x7c, 0x88, 0x82, 0x3e, 0x85, 0x3d, 0xaa, 0xcf,
-	0xc5, 0x92, 0x18, 0xff, 0x37, 0x76, 0x61, 0x3a, 0x8f, 0x6f, 0xa0, 0xc1, 0xf2, 0x42, 0x47, 0xb6,
-	0x25, 0xc8, 0xad, 0x62, 0xb5, 0x59, 0x20, 0x7c, 0x0b, 0x4d, 0xa6, 0x11, 0x21, 0xb2, 0x23, 0x21,
-	0x6e, 0x7f, 0x88, 0x60, 0xe1, 0x67, 0xd0, 0xa2, 0xfa, 0x8f, 0x19, 0x52, 0x95, 0x30, 0x1f, 0x15,
-	0x3d, 0xaa, 0xd5, 0x6f, 0x9f, 0x2f, 0x01, 0xb1, 0x8c, 0xe2, 0x90, 0x9a, 0x84, 0x38, 0xd4, 0x65,
-	0xb2, 0x6a, 0x87, 0x1f, 0x40, 0x9d, 0x65, 0x95, 0x89, 0x20, 0xe9, 0x7c, 0xb3, 0xc0, 0x59, 0x45,
-	0x16, 0x75, 0xd0, 0x48, 0x27, 0xa9, 0x1b, 0xea, 0xa0, 0x93, 0x59, 0xde, 0x15, 0x6f, 0x73, 0x9f,
-	0x7a, 0x04, 0xeb, 0xbb, 0x22, 0xff, 0x51, 0x28, 0x3b, 0xbf, 0x40, 0x37, 0x49, 0xc3, 0xd0, 0xf9,
-	0x05, 0x3e, 0x82, 0xf1, 0x98, 0x41, 0x6b, 0x49, 0x53, 0xcf, 0x78, 0x26, 0x6d, 0xc6, 0x2f, 0x60,
-	0x9f, 0x99, 0xb4, 0x99, 0xec, 0x4a, 0xb0, 0x8f, 0xb5, 0x85, 0xd2, 0xa2, 0xdd, 0xe7, 0xc3, 0x5e,
-	0x5a, 0x88, 0xc8, 0x9e, 0xc4, 0xf8, 0x9f, 0xe9, 0xb6, 0x62, 0xcf, 0x95, 0x2e, 0x49, 0x2e, 0xba,
-	0x55, 0xdc, 0x25, 0xc9, 0x3d, 0xf3, 0xa8, 0x41, 0x7a, 0x04, 0x20, 0x44, 0x1f, 0x35, 0x3b, 0x29,
-	0xf0, 0xa8, 0x41, 0x46, 0x82, 0xc9, 0xbe, 0x3e, 0x6a, 0x4e, 0xaa, 0x39, 0xff, 0x44, 0x46, 0xfd,
-	0x23, 0x6d, 0x3d, 0xff, 0x98, 0x15, 0xd3, 0xfe, 0x67, 0x03, 0xac, 0x55, 0xa9, 0xdb, 0x85, 0x7a,
-	0x8e, 0xfb, 0xd0, 0x15, 0xbc, 0x0f, 0xbb, 0x5a, 0xb9, 0x41, 0x6b, 0x7c, 0xd4, 0x6e, 0x68, 0x74,
-	0x03, 0x95, 0xf0, 0x0d, 0x3e, 0x80, 0x99, 0xa8, 0x1f, 0xad, 0xe3, 0x6b, 0xd0, 0x32, 0xf0, 0x37,
-	0xda, 0x90, 0xf1, 0x74, 0x34, 0x8c, 0x36, 0x65, 0xbc, 0x3c, 0x9f, 0xa2, 0xab, 0x5c, 0xfc, 0xad,
-	0x15, 0x82, 0x44, 0x5b, 0x7c, 0x74, 0xab, 0x65, 0xad, 0xca, 0xb1, 0x7b, 0x86, 0xbd, 0x50, 0x05,
-	0x13, 0xfd, 0x90, 0x8e, 0x40, 0x64, 0x6a, 0xa0, 0x19, 0x64, 0xf1, 0xd9, 0x28, 0x37, 0xda, 0xa2,
-	0x6d, 0x51, 0xc6, 0x1c, 0x33, 0xa0, 0x1d, 0xfe, 0xb9, 0xa2, 0xf9, 0x17, 0x0e, 0xaa, 0xca, 0xd8,
-	0x1a, 0x1e, 0x40, 0x35, 0x59, 0x08, 0xdd, 0xab, 0x44, 0x48, 0xc6, 0xc8, 0x3e, 0x30, 0x54, 0x17,
-	0x31, 0xf2, 0x4f, 0x05, 0x61, 0x51, 0x8d, 0x4c, 0xfb, 0xa3, 0xc6, 0x6a, 0xf6, 0x49, 0x9a, 0x4d,
-	0x61, 0x9a, 0xe9, 0x59, 0xb4, 0x2b, 0x4c, 0xb3, 0xdd, 0x88, 0xf6, 0xf0, 0x41, 0xd1, 0x3c, 0x86,
-	0x5a, 0xff, 0x05, 0x00, 0x00, 0xff, 0xff, 0xb3, 0x2a, 0xa4, 0x15, 0xe1, 0x12, 0x00, 0x00,
+	// 1375 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0x6f, 0x8f, 0xd3, 0xc6,
+	0x13, 0xc6, 0xc9, 0x85, 0x4b, 0xc6, 0xb9, 0x64, 0xb3, 0xc9, 0x25, 0x7b, 0xe1, 0xb8, 0x9f, 0x31,
+	0xe8, 0xd7, 0xb4, 0x2a, 0x11, 0x8a, 0x8a, 0x44, 0x29, 0x54, 0xa5, 0x10, 0xc4, 0x51, 0x2e, 0x17,
+	0x5d, 0x0e, 0xfa, 0x4f, 0xd5, 0xc9, 0x4d, 0xf6, 0x82, 0x45, 0xce, 0x76, 0xbd, 0x6b, 0x41, 0xbf,
+	0x43, 0x3f, 0x4c, 0x5f, 0xf2, 0x65, 0xfa, 0x55, 0xaa, 0x6a, 0x37, 0xb6, 0x13, 0xdb, 0xeb, 0xc0,
+	0x8b, 0xe3, 0x65, 0x66, 0x67, 0x9e, 0x99, 0x9d, 0x9d, 0x7d, 0x9e, 0x75, 0xa0, 0x76, 0x41, 0x19,
+	0xb3, 0xe6, 0x94, 0xf5, 0x3d, 0xdf, 0xe5, 0x2e, 0xae, 0xcd, 0xad, 0x60, 0x4e, 0xfb, 0x91, 0xb5,
+	0x0b, 0xcc, 0xa3, 0xd3, 0xe5, 0x9a, 0xd9, 0x02, 0xfc, 0x83, 0xbd, 0x58, 0x8c, 0x7d, 0x77, 0x4a,
+	0x19, 0x3b, 0xa1, 0x7f, 0x04, 0x94, 0x71, 0xf3, 0x27, 0xe8, 0x0c, 0xdf, 0xd1, 0x69, 0xc0, 0x6d,
+	0xd7, 0x99, 0x70, 0x8b, 0x07, 0xec, 0x84, 0x32, 0xcf, 0x75, 0x18, 0xc5, 0x0f, 0xa1, 0x4e, 0xa3,
+	0xa5, 0x13, 0xca, 0x82, 0x05, 0x27, 0x9a, 0x51, 0xe8, 0xe9, 0x83, 0x5b, 0xfd, 0x64, 0x9a, 0xfe,
+	0x58, 0x24, 0x18, 0x26, 0x7d, 0xcd, 0x1f, 0x81, 0xac, 0x23, 0xfb, 0xdc, 0x76, 0xe6, 0x61, 0x56,
+	0xfc, 0x0d, 0xb4, 0xa6, 0x81, 0xef, 0x53, 0x87, 0xc7, 0x2e, 0x87, 0xce, 0xb9, 0x4b, 0x34, 0x43,
+	0xeb, 0xe9, 0x83, 0xeb, 0x69, 0xfc, 0x84, 0x93, 0xf9, 0x12, 0xda, 0xb1, 0x61, 0xe8, 0xcc, 0x2e,
+	0x0b, 0xf6, 0x57, 0xd8, 0x9f, 0x78, 0x74, 0xfa, 0x69, 0x6a, 0xfe, 0x19, 0xba, 0x09, 0xf0, 0x4b,
+	0xac, 0xfb, 0x0c, 0x8c, 0xc9, 0x94, 0x3a, 0x96, 0x6f, 0xbb, 0x9f, 0xa6, 0xf6, 0xdf, 0xe0, 0x20,
+	0x93, 0xe0, 0x92, 0xfb, 0xce, 0xa9, 0xf7, 0xe9, 0xfa, 0xbe, 0x0e, 0x7e, 0x89, 0x75, 0xbf, 0xd7,
+	0x60, 0x27, 0x61, 0xc1, 0xb7, 0x41, 0x0f, 0xe1, 0xc4, 0x59, 0x87, 0x28, 0x24, 0x8d, 0x22, 0xd6,
+	0xa4, 0xfb, 0x5d, 0xa8, 0x47, 0xee, 0x61, 0x7b, 0x49, 0x41, 0x86, 0xec, 0x67, 0x42, 0xc2, 0xf5,
+	0x74, 0x16, 0x4e, 0x3d, 0x52, 0xcc, 0xc9, 0xc2, 0xa9, 0x27, 0xdd, 0x31, 0x00, 0xe3, 0xd6, 0xf4,
+	0x0d, 0xf7, 0xad, 0x29, 0x25, 0x5b, 0x86, 0xd6, 0xab, 0x98, 0xcf, 0xa1, 0x1c, 0x57, 0x51, 0x85,
+	0x2d, 0xc7, 0xba, 0xa0, 0xf2, 0x6a, 0x57, 0x30, 0x82, 0xf2, 0xb9, 0xbd, 0xa0, 0x23, 0x61, 0x29,
+	0x44, 0x16, 0x9b, 0x3d, 0xb5, 0xec, 0x05, 0x9d, 0x91, 0xa2, 0x51, 0xe8, 0x95, 0x45, 0x04, 0xb7,
+	0xe6, 0x8c, 0x6c, 0x19, 0xc5, 0x5e, 0xc5, 0x7c, 0x00, 0xd5, 0x44, 0x79, 0x19, 0xbc, 0x38, 0xba,
+	0x90, 0x88, 0x2e, 0xca, 0xe8, 0x11, 0x94, 0xe3, 0x4a, 0xef, 0xc0, 0x16, 0x13, 0x3b, 0x5a, 0x92,
+	0x8c, 0xa9, 0xee, 0x3e, 0x15, 0xee, 0xd1, 0xf9, 0x65, 0xd0, 0xcd, 0xbf, 0x34, 0xc0, 0x0a, 0xc7,
+	0x36, 0xd4, 0xac, 0x29, 0x0f, 0xac, 0x85, 0x30, 0x9e, 0xd2, 0x77, 0x3c, 0x2c, 0xaf, 0x0d, 0x35,
+	0xcf, 0xf2, 0x19, 0x9d, 0xc5, 0xf6, 0xe5, 0xa6, 0x3b, 0x50, 0x67, 0xe1, 0xa6, 0x04, 0xbc, 0xed,
+	0xcc, 0x65, 0x9f, 0xcb, 0xf8, 0x36, 0x80, 0x67, 0xf9, 0xd6, 0x05, 0xe5, 0xd4, 0x5f, 0x76, 0x40,
+	0x1f, 0xec, 0x65, 0xe8, 0x30, 0xf2, 0x30, 0x1f, 0x43, 0x53, 0x20, 0xbf, 0xb2, 0x16, 0xf6, 0xcc,
+	0xe2, 0x74, 0xad, 0x6e, 0x96, 0x2c, 0xa4, 0x0b, 0xd8, 0x09, 0x2e, 0x7e, 0xa7, 0xfe, 0xf1, 0xf9,
+	0x78, 0x85, 0x2f, 0x8a, 0x29, 0x99, 0x7f, 0x6b, 0xd0, 0x4a, 0xa2, 0x84, 0x04, 0x5d, 0x87, 0x6d,
+	0x9b, 0x49, 0xab, 0x44, 0x29, 0xe3, 0x16, 0x54, 0xa9, 0xef, 0xbb, 0xfe, 0xd1, 0xb2, 0x12, 0x39,
+	0x4e, 0x15, 0xfc, 0x08, 0x2a, 0xd2, 0x7a, 0xfa, 0xa7, 0x47, 0xe5, 0x36, 0x6a, 0x83, 0xbe, 0x6a,
+	0x5c, 0xd2, 0xf8, 0xfd, 0x61, 0x14, 0x65, 0xf6, 0xa1, 0x12, 0xff, 0xc0, 0x37, 0xe0, 0xfa, 0xe4,
+	0x74, 0x38, 0x3e, 0x3b, 0x3c, 0x1a, 0xbf, 0x18, 0x1e, 0x0d, 0x47, 0xa7, 0x8f, 0x4e, 0x0f, 0x8f,
+	0x47, 0x67, 0xa3, 0xe3, 0xd3, 0xb3, 0xa7, 0xc7, 0x2f, 0x47, 0x4f, 0xd0, 0x15, 0xf3, 0x08, 0x5a,
+	0x93, 0xc0, 0xe6, 0x34, 0xa5, 0x09, 0xf8, 0x2e, 0xe8, 0x4c, 0xd8, 0x13, 0x72, 0x62, 0x28, 0xe5,
+	0x64, 0xb2, 0xf2, 0x33, 0x31, 0x20, 0x51, 0xa0, 0x98, 0xca, 0x58, 0xb8, 0x4c, 0x68, 0xac, 0xd9,
+	0xc2, 0x8e, 0xec, 0x40, 0x49, 0x34, 0x96, 0x11, 0x4d, 0x4e, 0xd7, 0x01, 0xec, 0x47, 0xb3, 0xf9,
+	0xc4, 0xe2, 0xd6, 0x84, 0xbb, 0x3e, 0x3d, 0x74, 0x6c, 0x1e, 0x61, 0x74, 0x81, 0x88, 0x7b, 0xa0,
+	0x5c, 0xbb, 0x06, 0x7b, 0xb2, 0x04, 0xe5, 0xe2, 0x43, 0x68, 0xc4, 0xc7, 0x34, 0x76, 0x99, 0x2d,
+	0xb6, 0x88, 0x9b, 0xa0, 0xbb, 0x8b, 0x59, 0xf4, 0x53, 0x6e, 0xae, 0x24, 0x8c, 0x0e, 0x7d, 0x1b,
+	0x1b, 0x97, 0x27, 0xfa, 0x5e, 0x83, 0xfa, 0x09, 0x3d, 0xb7, 0xa6, 0xdc, 0xf5, 0xa3, 0x99, 0xf8,
+	0x0a, 0xaa, 0xee, 0x62, 0x16, 0x9e, 0x43, 0x40, 0xc3, 0xde, 0x1c, 0xa8, 0x7b, 0x13, 0x79, 0x89,
+	0x28, 0x87, 0xbe, 0x5d, 0x45, 0x15, 0x3e, 0x2a, 0xea, 0x6b, 0x39, 0xf6, 0xd6, 0x45, 0x54, 0xd6,
+	0xf2, 0x36, 0xea, 0x83, 0x1b, 0xb9, 0x93, 0x1c, 0x79, 0x9a, 0xcf, 0x00, 0xad, 0x2a, 0x5f, 0xcd,
+	0x21, 0x0b, 0xa6, 0x53, 0xca, 0x58, 0x38, 0x87, 0x3b, 0x50, 0x92, 0x13, 0x17, 0x0e, 0x60, 0x0b,
+	0xaa, 0x82, 0x54, 0xd8, 0xe3, 0xd7, 0x96, 0x33, 0x97, 0x34, 0x22, 0x0e, 0xe7, 0x16, 0xd4, 0xa3,
+	0x03, 0x8c, 0x7a, 0xd0, 0x80, 0x0a, 0x4b, 0x34, 0xa0, 0x62, 0xb2, 0xd5, 0xd1, 0xc7, 0xf9, 0x76,
+	0x61, 0xc7, 0x66, 0xc2, 0x3a, 0xf6, 0x29, 0xa3, 0x0e, 0x0f, 0xb3, 0x86, 0xb7, 0x2a, 0xe4, 0xae,
+	0xe2, 0x92, 0x7d, 0x5e, 0x5b, 0xec, 0xd1, 0xc2, 0xb6, 0x58, 0xc8, 0x5d, 0xeb, 0xfc, 0x26, 0xb9,
+	0x50, 0xf0, 0xe3, 0xc2, 0x76, 0xe8, 0x48, 0xde, 0x3e, 0x52, 0x32, 0xb4, 0x5e, 0xc9, 0xbc, 0x0d,
+	0xdd, 0x97, 0x0e, 0x0b, 0x3c, 0xcf, 0xf5, 0x39, 0x9d, 0x85, 0xb7, 0x69, 0x7d, 0xbb, 0x61, 0x83,
+	0x24, 0xc5, 0x57, 0xcc, 0x7f, 0x31, 0x6c, 0x87, 0x4e, 0xf8, 0x1e, 0xe8, 0xe1, 0xa2, 0xbc, 0x6e,
+	0xa2, 0xb2, 0xda, 0xe0, 0x66, 0xba, 0xaf, 0xa1, 0x77, 0xff, 0x68, 0xe5, 0x2a, 0x36, 0x1f, 0xae,
+	0x1f, 0x2e, 0xd9, 0xac, 0x88, 0x9f, 0x03, 0xa1, 0x39, 0xb2, 0x18, 0xf2, 0x7e, 0x2f, 0x57, 0xa3,
+	0x52, 0xfe, 0xf8, 0x04, 0xf6, 0xd9, 0x86, 0xe7, 0x8d, 0xec, 0x86, 0x3e, 0xf8, 0x52, 0xa5, 0x56,
+	0xb9, 0x98, 0x23, 0xe8, 0xb2, 0xdc, 0x57, 0x8d, 0xec, 0xa5, 0x3e, 0xf8, 0x62, 0x23, 0x62, 0x22,
+	0x02, 0xff, 0x02, 0x06, 0xfb, 0xc0, 0x53, 0x86, 0x5c, 0x95, 0xa8, 0x77, 0xf2, 0x24, 0x32, 0xb7,
+	0xd6, 0x57, 0x70, 0xc0, 0x36, 0xbe, 0x62, 0xc8, 0xb6, 0x44, 0xee, 0x7f, 0x10, 0x39, 0x59, 0xb3,
+	0xe8, 0xeb, 0x86, 0xe7, 0x0b, 0x29, 0xe7, 0xf4, 0x75, 0x43, 0x8c, 0xec, 0x6b, 0xee, 0xab, 0x85,
+	0x54, 0x72, 0xfa, 0x9a, 0x1b, 0x81, 0xbf, 0x05, 0x4c, 0x33, 0xa2, 0x48, 0xc0, 0xd0, 0x3e, 0x52,
+	0x67, 0x9f, 0x42, 0x9b, 0xaa, 0x6b, 0xd1, 0x25, 0xc6, 0xff, 0x73, 0xa7, 0x30, 0x59, 0xc7, 0x77,
+	0xd0, 0x64, 0x59, 0x39, 0x24, 0x55, 0x09, 0x72, 0x73, 0xb3, 0x26, 0x2d, 0x11, 0xbe, 0x87, 0x16,
+	0x53, 0x48, 0x15, 0xd9, 0x31, 0x34, 0xd5, 0x87, 0x89, 0x52, 0x36, 0x9f, 0x41, 0x87, 0xaa, 0x3f,
+	0x79, 0x48, 0x4d, 0xc2, 0x7c, 0xb6, 0xe9, 0x52, 0xad, 0xb9, 0xe3, 0xfb, 0x80, 0x58, 0x4a, 0x97,
+	0x48, 0xdd, 0xd0, 0x54, 0x9a, 0x96, 0xd6, 0x2f, 0xfc, 0x00, 0x1a, 0x2c, 0xad, 0x5f, 0x04, 0x19,
+	0x9a, 0x8a, 0x86, 0xb3, 0x42, 0x27, 0xfa, 0xa0, 0x10, 0x58, 0xd2, 0xc8, 0xe9, 0x83, 0xc2, 0x57,
+	0x4c, 0xc5, 0x9b, 0xcc, 0x07, 0x21, 0xc1, 0xea, 0xa9, 0xc8, 0x7e, 0x3a, 0xca, 0xc9, 0xdf, 0xa0,
+	0xae, 0xa4, 0x99, 0x33, 0xf9, 0x1b, 0x62, 0x04, 0xe3, 0xb1, 0x1c, 0x45, 0x26, 0x2d, 0x35, 0xe3,
+	0xe5, 0x29, 0x38, 0x7e, 0x01, 0x7b, 0x2c, 0x4f, 0xc1, 0xc9, 0xae, 0x04, 0xfb, 0x5c, 0xd9, 0x28,
+	0x25, 0xda, 0x3d, 0xa8, 0xb3, 0xa4, 0x5c, 0x91, 0xb6, 0xc4, 0xf8, 0x5f, 0xde, 0x69, 0x45, 0x91,
+	0x6b, 0x53, 0x12, 0x1f, 0x74, 0x67, 0xf3, 0x94, 0xc4, 0xe7, 0x7c, 0x0f, 0xea, 0x7e, 0xf2, 0xa1,
+	0x40, 0x88, 0x3a, 0x6b, 0xfa, 0x3d, 0x71, 0x1f, 0x90, 0x9f, 0x12, 0x6a, 0xb2, 0xa7, 0xce, 0x9a,
+	0x11, 0xf4, 0x11, 0x74, 0x83, 0x5c, 0xfd, 0x23, 0x5d, 0x35, 0xff, 0xe4, 0x2b, 0xa6, 0xf9, 0xcf,
+	0x16, 0xe8, 0xeb, 0x52, 0xb7, 0x0b, 0x8d, 0x0c, 0xf7, 0xa1, 0x2b, 0x78, 0x0f, 0x76, 0x95, 0x72,
+	0x83, 0x34, 0xdc, 0x81, 0xa6, 0x42, 0x37, 0x50, 0x01, 0x5f, 0x87, 0xbd, 0x5c, 0xea, 0x47, 0x45,
+	0x7c, 0x0d, 0x3a, 0x39, 0xfc, 0x8d, 0xb6, 0x64, 0x3e, 0x15, 0x0d, 0xa3, 0x92, 0xcc, 0x97, 0xe5,
+	0x53, 0x74, 0x15, 0xd7, 0x41, 0x5f, 0x23, 0x48, 0xb4, 0x8d, 0x9b, 0x50, 0x4f, 0x7b, 0x95, 0xa3,
+	0xf0, 0x14, 0x7b, 0xa1, 0x0a, 0x26, 0xea, 0xa7, 0x3c, 0x02, 0x51, 0x69, 0x0e, 0xcd, 0x20, 0x1d,
+	0xb7, 0xb2, 0x0f, 0x60, 0x54, 0x15, 0x6d, 0xcc, 0x30, 0x03, 0xda, 0xc1, 0x6d, 0xd5, 0x1f, 0x3d,
+	0xa8, 0x26, 0x73, 0x2b, 0x78, 0x00, 0xd5, 0x65, 0x23, 0x54, 0xb7, 0x12, 0x21, 0x99, 0x23, 0x7d,
+	0xc1, 0x50, 0x43, 0xe4, 0xc8, 0x5e, 0x15, 0x84, 0x45, 0x37, 0x52, 0xe3, 0x8f, 0x9a, 0xeb, 0xd5,
+	0xc7, 0x65, 0xb6, 0x84, 0x6b, 0x6a, 0x66, 0xd1, 0xae, 0x70, 0x4d, 0x4f, 0x23, 0x6a, 0xe3, 0x83,
+	0x4d, 0xef, 0x31, 0xd4, 0xf9, 0x6f, 0x00, 0xd3, 0x50, 0x27, 0x4b, 0x07, 0x13, 0x00, 0x00,
 }
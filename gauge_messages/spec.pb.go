@@ -194,8 +194,12 @@ type ProtoSpec struct {
 	// / Contains the filename for that holds this specification.
 	FileName *string `protobuf:"bytes,6,req,name=fileName" json:"fileName,omitempty"`
 	// / Contains a list of tags that are defined at the specification level. Scenario tags are not present here.
-	Tags             []string `protobuf:"bytes,7,rep,name=tags" json:"tags,omitempty"`
-	XXX_unrecognized []byte   `json:"-"`
+	Tags []string `protobuf:"bytes,7,rep,name=tags" json:"tags,omitempty"`
+	// / Output written by the runner to stdout during the specification, outside of its scenarios. E.g. by the spec hooks.
+	Stdout *string `protobuf:"bytes,8,opt,name=stdout" json:"stdout,omitempty"`
+	// / Output written by the runner to stderr during the specification, outside of its scenarios. E.g. by the spec hooks.
+	Stderr           *string `protobuf:"bytes,9,opt,name=stderr" json:"stderr,omitempty"`
+	XXX_unrecognized []byte  `json:"-"`
 }
 
 func (m *ProtoSpec) Reset()                    { *m = ProtoSpec{} }
@@ -252,6 +256,20 @@ func (m *ProtoSpec) GetTags() []string {
 	return nil
 }
 
+func (m *ProtoSpec) GetStdout() string {
+	if m != nil && m.Stdout != nil {
+		return *m.Stdout
+	}
+	return ""
+}
+
+func (m *ProtoSpec) GetStderr() string {
+	if m != nil && m.Stderr != nil {
+		return *m.Stderr
+	}
+	return ""
+}
+
 // / Container for all valid Items under a Specification.
 type ProtoItem struct {
 	// / Itemtype of the current ProtoItem
@@ -357,8 +375,12 @@ type ProtoScenario struct {
 	// / Holds the unique Identifier of a scenario.
 	ID *string `protobuf:"bytes,11,opt,name=ID" json:"ID,omitempty"`
 	// / Collection of Teardown steps. The Teardown steps are executed after every run.
-	TearDownSteps    []*ProtoItem `protobuf:"bytes,12,rep,name=tearDownSteps" json:"tearDownSteps,omitempty"`
-	XXX_unrecognized []byte       `json:"-"`
+	TearDownSteps []*ProtoItem `protobuf:"bytes,12,rep,name=tearDownSteps" json:"tearDownSteps,omitempty"`
+	// / Output written by the runner to stdout during the scenario, outside of its steps. E.g. by the scenario hooks.
+	Stdout *string `protobuf:"bytes,13,opt,name=stdout" json:"stdout,omitempty"`
+	// / Output written by the runner to stderr during the scenario, outside of its steps. E.g. by the scenario hooks.
+	Stderr           *string `protobuf:"bytes,14,opt,name=stderr" json:"stderr,omitempty"`
+	XXX_unrecognized []byte  `json:"-"`
 }
 
 func (m *ProtoScenario) Reset()                    { *m = ProtoScenario{} }
@@ -450,6 +472,20 @@ func (m *ProtoScenario) GetTearDownSteps() []*ProtoItem {
 	return nil
 }
 
+func (m *ProtoScenario) GetStdout() string {
+	if m != nil && m.Stdout != nil {
+		return *m.Stdout
+	}
+	return ""
+}
+
+func (m *ProtoScenario) GetStderr() string {
+	if m != nil && m.Stderr != nil {
+		return *m.Stderr
+	}
+	return ""
+}
+
 // / A proto object representing a TableDrivenScenario
 type ProtoTableDrivenScenario struct {
 	// / Holds the Underlying scenario that is executed for every row in the table.
@@ -730,10 +766,14 @@ type ProtoStepExecutionResult struct {
 	// / Contains a 'before' hook failure message. This happens when the `before_step` hook has an error.
 	PreHookFailure *ProtoHookFailure `protobuf:"bytes,2,opt,name=preHookFailure" json:"preHookFailure,omitempty"`
 	// / Contains a 'after' hook failure message. This happens when the `after_step` hook has an error.
-	PostHookFailure  *ProtoHookFailure `protobuf:"bytes,3,opt,name=postHookFailure" json:"postHookFailure,omitempty"`
-	Skipped          *bool             `protobuf:"varint,4,req,name=skipped" json:"skipped,omitempty"`
-	SkippedReason    *string           `protobuf:"bytes,5,opt,name=skippedReason" json:"skippedReason,omitempty"`
-	XXX_unrecognized []byte            `json:"-"`
+	PostHookFailure *ProtoHookFailure `protobuf:"bytes,3,opt,name=postHookFailure" json:"postHookFailure,omitempty"`
+	Skipped         *bool             `protobuf:"varint,4,req,name=skipped" json:"skipped,omitempty"`
+	SkippedReason   *string           `protobuf:"bytes,5,opt,name=skippedReason" json:"skippedReason,omitempty"`
+	// / Output written by the runner to stdout while the step, including its hooks, was executed
+	Stdout *string `protobuf:"bytes,6,opt,name=stdout" json:"stdout,omitempty"`
+	// / Output written by the runner to stderr while the step, including its hooks, was executed
+	Stderr           *string `protobuf:"bytes,7,opt,name=stderr" json:"stderr,omitempty"`
+	XXX_unrecognized []byte  `json:"-"`
 }
 
 func (m *ProtoStepExecutionResult) Reset()                    { *m = ProtoStepExecutionResult{} }
@@ -776,6 +816,20 @@ func (m *ProtoStepExecutionResult) GetSkippedReason() string {
 	return ""
 }
 
+func (m *ProtoStepExecutionResult) GetStdout() string {
+	if m != nil && m.Stdout != nil {
+		return *m.Stdout
+	}
+	return ""
+}
+
+func (m *ProtoStepExecutionResult) GetStderr() string {
+	if m != nil && m.Stderr != nil {
+		return *m.Stderr
+	}
+	return ""
+}
+
 // / A proto object representing the result of an execution
 type ProtoExecutionResult struct {
 	// / Flag to indicate failure
@@ -923,7 +977,9 @@ type ProtoSuiteResult struct {
 	// / Timestamp of when execution started
 	Timestamp         *string `protobuf:"bytes,11,req,name=timestamp" json:"timestamp,omitempty"`
 	SpecsSkippedCount *int32  `protobuf:"varint,12,req,name=specsSkippedCount" json:"specsSkippedCount,omitempty"`
-	XXX_unrecognized  []byte  `json:"-"`
+	// / Flag to indicate that only some of the specifications or scenarios of the project were executed
+	IsPartialRun     *bool  `protobuf:"varint,13,opt,name=isPartialRun" json:"isPartialRun,omitempty"`
+	XXX_unrecognized []byte `json:"-"`
 }
 
 func (m *ProtoSuiteResult) Reset()                    { *m = ProtoSuiteResult{} }
@@ -1015,6 +1071,13 @@ func (m *ProtoSuiteResult) GetSpecsSkippedCount() int32 {
 	return 0
 }
 
+func (m *ProtoSuiteResult) GetIsPartialRun() bool {
+	if m != nil && m.IsPartialRun != nil {
+		return *m.IsPartialRun
+	}
+	return false
+}
+
 // / A proto object representing the result of Spec execution.
 type ProtoSpecResult struct {
 	// / Represents the corresponding Specification
@@ -1158,84 +1221,87 @@ func init() {
 }
 
 var fileDescriptor3 = []byte{
-	// 1253 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0xc4, 0x57, 0xcd, 0x6e, 0xdb, 0x46,
-	0x10, 0x06, 0x29, 0xca, 0x22, 0xc7, 0x92, 0x4c, 0xd3, 0x4e, 0x42, 0xb7, 0x4e, 0x6a, 0x10, 0x2d,
-	0xac, 0x22, 0xad, 0x1a, 0x18, 0x39, 0xf4, 0x07, 0x2d, 0x10, 0xd8, 0x32, 0x2c, 0x20, 0x4d, 0x03,
-	0x49, 0x48, 0x81, 0x5e, 0x0a, 0x96, 0x5e, 0x2b, 0x8c, 0x25, 0x52, 0xe0, 0xae, 0xec, 0xa4, 0xa7,
-	0x3e, 0x44, 0x9f, 0xa0, 0x97, 0x5e, 0x7a, 0xea, 0x43, 0xf5, 0xda, 0x5b, 0x81, 0xde, 0x3a, 0xb3,
-	0xdc, 0xa5, 0xfe, 0x2d, 0x37, 0x97, 0xdc, 0xb4, 0xc3, 0x99, 0xd9, 0x99, 0x6f, 0x66, 0xbe, 0x59,
-	0x01, 0xf0, 0x11, 0x8b, 0x9a, 0xa3, 0x2c, 0x15, 0xa9, 0x57, 0xef, 0x87, 0xe3, 0x3e, 0x6b, 0x0e,
-	0x19, 0xe7, 0x61, 0x9f, 0xf1, 0xe0, 0x1f, 0x03, 0x9c, 0xe7, 0xf4, 0xa5, 0x8b, 0x3a, 0xde, 0x0e,
-	0x6c, 0x92, 0xee, 0x19, 0x0b, 0xcf, 0xe3, 0xa4, 0xef, 0x1b, 0x07, 0x66, 0xc3, 0xf1, 0x1a, 0x50,
-	0x8e, 0x05, 0x1b, 0x72, 0xdf, 0x3c, 0x28, 0x35, 0x36, 0x8f, 0xf6, 0x9a, 0xb3, 0x2e, 0x9a, 0xd2,
-	0xbc, 0x8d, 0x1a, 0xde, 0x1d, 0xa8, 0xc5, 0xbc, 0x17, 0xfe, 0x34, 0x60, 0x27, 0x59, 0x7c, 0xc5,
-	0x12, 0xbf, 0x84, 0x0e, 0x6c, 0xef, 0x73, 0xa8, 0x8f, 0x32, 0x76, 0x96, 0xa6, 0x97, 0xa7, 0x61,
-	0x3c, 0x18, 0x67, 0xcc, 0xb7, 0x0e, 0x0c, 0xf4, 0x74, 0xb0, 0xd4, 0xd3, 0x94, 0x9e, 0xf7, 0x05,
-	0x6c, 0x8d, 0x52, 0x2e, 0xa6, 0x4d, 0xcb, 0xb7, 0x34, 0x75, 0xc1, 0xbe, 0x88, 0x07, 0xec, 0x59,
-	0x38, 0x64, 0xfe, 0x86, 0xcc, 0xa3, 0x0a, 0x96, 0x08, 0xfb, 0xdc, 0xaf, 0x60, 0x1a, 0x4e, 0xf0,
-	0x8b, 0xa5, 0x12, 0x97, 0x91, 0x3f, 0x06, 0x9b, 0x72, 0xec, 0xbd, 0x19, 0x31, 0x99, 0x75, 0xfd,
-	0x28, 0x58, 0x99, 0x66, 0xb3, 0xad, 0x34, 0xbd, 0x43, 0xb0, 0xb8, 0x60, 0x23, 0x04, 0xc6, 0x58,
-	0x09, 0x4c, 0x17, 0x15, 0xbc, 0x4f, 0xa1, 0x12, 0xa5, 0x49, 0xc4, 0x46, 0x02, 0x21, 0x21, 0xdd,
-	0xfd, 0xa5, 0xba, 0xc7, 0xb9, 0x8e, 0xf7, 0x19, 0xd8, 0x3c, 0x62, 0x49, 0x98, 0xc5, 0xa9, 0x82,
-	0xea, 0xfe, 0x72, 0xdf, 0x4a, 0xc9, 0x6b, 0xc1, 0x8e, 0x98, 0xc0, 0xae, 0xc5, 0x0a, 0xab, 0xc6,
-	0x52, 0xdb, 0xde, 0xa2, 0x7e, 0x1e, 0xe6, 0x70, 0xc8, 0x12, 0x81, 0x90, 0xdd, 0x14, 0xa6, 0xd4,
-	0xf1, 0x3e, 0x86, 0xb2, 0xbc, 0x15, 0x11, 0x25, 0xe5, 0xf7, 0x56, 0xdf, 0x43, 0x48, 0x49, 0xec,
-	0xed, 0x1b, 0x90, 0xea, 0xa1, 0x42, 0xf0, 0x0a, 0xec, 0x02, 0x5e, 0x1b, 0x2c, 0x42, 0xcf, 0x35,
-	0xbc, 0x4d, 0xa8, 0xa8, 0x4b, 0x5d, 0x33, 0x3f, 0x48, 0xa0, 0xdc, 0x12, 0x16, 0xd5, 0xd6, 0xe1,
-	0xbb, 0x96, 0x77, 0x0f, 0x76, 0x96, 0xe4, 0xe5, 0x96, 0x3d, 0x07, 0xca, 0xf2, 0x83, 0xbb, 0x41,
-	0x5e, 0xe9, 0x26, 0xb7, 0x12, 0xfc, 0x5a, 0x82, 0xda, 0x2c, 0x8e, 0xf7, 0x60, 0x4b, 0x03, 0x3f,
-	0x3b, 0x03, 0x75, 0xd8, 0xb8, 0xc0, 0xc6, 0x62, 0xe7, 0x58, 0x6b, 0x6a, 0xe9, 0x87, 0x60, 0x63,
-	0x41, 0x05, 0x7b, 0x2d, 0x38, 0x56, 0x74, 0xcd, 0x58, 0x3c, 0x82, 0x9a, 0xf6, 0xda, 0x96, 0x83,
-	0x64, 0xad, 0xb3, 0x58, 0x9c, 0x98, 0xf2, 0xdb, 0x4f, 0xcc, 0xc6, 0x2d, 0x4d, 0x67, 0xe6, 0x83,
-	0x66, 0x99, 0xbd, 0x66, 0xd1, 0x58, 0xc4, 0x69, 0xd2, 0x8b, 0x71, 0x88, 0xa8, 0x74, 0x25, 0x6f,
-	0x0b, 0x2a, 0xfc, 0x32, 0x1e, 0x8d, 0x10, 0x09, 0x47, 0x22, 0xe1, 0x21, 0xbd, 0xa0, 0xa0, 0x95,
-	0x65, 0x69, 0xc6, 0x7d, 0x90, 0xb6, 0x00, 0x66, 0xfb, 0xc4, 0xdf, 0x44, 0x03, 0x87, 0x92, 0x17,
-	0x2c, 0xcc, 0x4e, 0xd2, 0xeb, 0x84, 0x8a, 0xc9, 0xfd, 0xea, 0x9a, 0xe4, 0x83, 0xa7, 0xe0, 0xaf,
-	0xec, 0xd0, 0x47, 0xe0, 0x68, 0x28, 0x39, 0x96, 0xa6, 0xb4, 0x76, 0x34, 0x82, 0xdf, 0x0b, 0x82,
-	0xa3, 0x41, 0xc4, 0xd0, 0xc2, 0x48, 0x8c, 0xc3, 0x41, 0x0f, 0x4b, 0xa7, 0x6a, 0x8b, 0xb2, 0x51,
-	0x98, 0x71, 0x76, 0x2e, 0x65, 0xa6, 0x94, 0x3d, 0x04, 0xe7, 0x22, 0x0b, 0xfb, 0xd4, 0x71, 0xba,
-	0xc0, 0xfe, 0xfc, 0x3d, 0xa7, 0x4a, 0x81, 0xa6, 0x8f, 0x68, 0xa0, 0xa5, 0xe1, 0xea, 0x30, 0x3e,
-	0x1e, 0x08, 0x35, 0xb9, 0x8d, 0x95, 0xac, 0x30, 0xa7, 0x1f, 0xfc, 0x69, 0x40, 0x75, 0x86, 0x06,
-	0x9a, 0xb0, 0xa9, 0x58, 0x83, 0xd4, 0x65, 0xb4, 0x37, 0xb2, 0x0c, 0x12, 0x35, 0x97, 0x10, 0xaf,
-	0x25, 0xea, 0x33, 0xb8, 0xab, 0x3c, 0xcf, 0x07, 0x5d, 0xfa, 0x9f, 0x41, 0xef, 0x29, 0x74, 0x69,
-	0xa4, 0x8a, 0x0e, 0x32, 0x24, 0xc3, 0xfe, 0x61, 0x80, 0x5d, 0x60, 0xf4, 0x15, 0x54, 0x35, 0xa0,
-	0x53, 0x24, 0xfb, 0xd1, 0x2a, 0x4c, 0x8b, 0x1f, 0x92, 0x08, 0xc8, 0x6f, 0x5e, 0x1b, 0xea, 0xa8,
-	0x4f, 0xc0, 0xc1, 0x7a, 0x21, 0xaf, 0x0b, 0x96, 0xa9, 0x78, 0x17, 0x53, 0xd5, 0x0a, 0xc1, 0x21,
-	0x54, 0x67, 0x7c, 0xd1, 0xf8, 0xa3, 0x2f, 0x24, 0x95, 0x1a, 0x86, 0xae, 0xd5, 0x5c, 0x33, 0xf8,
-	0xdb, 0x98, 0x3a, 0x7b, 0xdf, 0x40, 0xad, 0xb8, 0x64, 0x2a, 0xe0, 0xc3, 0x95, 0x17, 0x4d, 0x7e,
-	0xc9, 0x6b, 0x6a, 0x50, 0xbe, 0x0a, 0x07, 0x63, 0xa6, 0x62, 0xc6, 0x0c, 0x12, 0xda, 0x44, 0x25,
-	0x79, 0x2a, 0x88, 0xd3, 0x5a, 0x47, 0x9c, 0xc1, 0x0f, 0x48, 0x51, 0x33, 0x8e, 0x01, 0x36, 0xba,
-	0x22, 0x14, 0x71, 0x94, 0xd3, 0xe2, 0xc9, 0x1b, 0xf4, 0x8b, 0x07, 0x13, 0xdb, 0xb8, 0x4e, 0x3b,
-	0x3c, 0x0e, 0x07, 0x3f, 0x76, 0x45, 0x86, 0xd4, 0x85, 0xec, 0xb8, 0x0d, 0x35, 0x2d, 0xcb, 0xe9,
-	0xcf, 0x9a, 0x30, 0x61, 0x39, 0xd8, 0x2f, 0xfa, 0x2d, 0xe7, 0x73, 0x0d, 0xb3, 0x1c, 0x8b, 0x20,
-	0x06, 0x98, 0x22, 0xf0, 0x26, 0x54, 0x5e, 0x22, 0x23, 0xb2, 0x8c, 0xab, 0x3e, 0xbc, 0xbf, 0x3a,
-	0xe8, 0x4e, 0x7a, 0x8d, 0x03, 0x64, 0x65, 0xe9, 0xb5, 0x6e, 0xc5, 0x9b, 0x95, 0x83, 0x07, 0x8a,
-	0x87, 0x0b, 0x6b, 0x44, 0x2f, 0x62, 0x83, 0x81, 0xee, 0x24, 0x7c, 0xa4, 0xf8, 0xab, 0x3a, 0xd0,
-	0xfb, 0x1a, 0xb6, 0xd8, 0x5c, 0x13, 0x1b, 0x12, 0xd6, 0x0f, 0x97, 0x5e, 0x3a, 0x6f, 0xbe, 0x48,
-	0xb5, 0xe6, 0xdb, 0x53, 0x6d, 0xe9, 0x96, 0xa6, 0x53, 0x2c, 0x6a, 0x49, 0x16, 0x45, 0xb6, 0x55,
-	0x82, 0x0e, 0x0b, 0x79, 0x9a, 0x48, 0xbe, 0x77, 0x82, 0xdf, 0x4c, 0xd8, 0x5d, 0x1a, 0xf5, 0x64,
-	0x1f, 0x19, 0xd2, 0xde, 0x07, 0x37, 0x63, 0x51, 0x7a, 0xc5, 0x32, 0x82, 0x50, 0x92, 0xb1, 0xcc,
-	0xc3, 0xf6, 0x76, 0xa1, 0xca, 0xe8, 0xf8, 0x6d, 0x1e, 0x8c, 0xea, 0x40, 0x62, 0x6d, 0x11, 0x46,
-	0x97, 0xbd, 0x2c, 0x8c, 0xf2, 0x36, 0xcc, 0x65, 0x51, 0xc6, 0x90, 0x6d, 0x5f, 0xa6, 0x42, 0x06,
-	0x50, 0x5d, 0xdc, 0x02, 0xf4, 0x94, 0x92, 0x5b, 0x40, 0x25, 0xa7, 0xb6, 0xc5, 0x53, 0x70, 0xe4,
-	0x2d, 0x72, 0x54, 0x68, 0x53, 0xd4, 0x8f, 0x9a, 0xb7, 0x81, 0xbf, 0xd9, 0xd2, 0x56, 0x5f, 0x3a,
-	0x4f, 0xba, 0xdd, 0x56, 0xa7, 0xd7, 0xfe, 0xee, 0x59, 0x80, 0x13, 0x5e, 0xc8, 0x69, 0x4c, 0x8b,
-	0x2f, 0xd8, 0xf3, 0x2e, 0x54, 0x5f, 0xb4, 0x3a, 0xed, 0xd3, 0xf6, 0xf1, 0x13, 0x29, 0x31, 0x83,
-	0xe7, 0xe0, 0x2e, 0x00, 0x3c, 0x9b, 0x5f, 0xce, 0xf3, 0xf3, 0x48, 0x98, 0x9a, 0xfd, 0xa7, 0xb2,
-	0x26, 0x74, 0xaa, 0xc1, 0xbf, 0xa6, 0x72, 0xd9, 0x1d, 0xe3, 0xab, 0x50, 0x41, 0xfe, 0x38, 0x7f,
-	0x1b, 0xe7, 0x27, 0xbd, 0x7c, 0x3e, 0x58, 0x4e, 0x94, 0x85, 0xde, 0xbb, 0x69, 0xaf, 0x49, 0x77,
-	0x58, 0xba, 0x3b, 0x28, 0x74, 0x7e, 0x2a, 0x85, 0xc7, 0xe9, 0x38, 0xa1, 0xfa, 0x9a, 0x8d, 0xf2,
-	0xb2, 0xfa, 0xd2, 0x96, 0xa7, 0xff, 0x01, 0xe3, 0x28, 0xc2, 0x0b, 0x3a, 0xa1, 0xa0, 0x1a, 0x9b,
-	0x0d, 0x93, 0x84, 0x2c, 0xb9, 0x8a, 0xb3, 0x34, 0x91, 0x2f, 0x44, 0x5b, 0x13, 0x9b, 0xa4, 0x7c,
-	0x47, 0x9e, 0x50, 0x05, 0xff, 0x66, 0xbc, 0x62, 0x91, 0x90, 0xef, 0x6e, 0x90, 0x08, 0x6f, 0x83,
-	0x23, 0xd0, 0x35, 0xd6, 0x63, 0x38, 0xc2, 0x47, 0x01, 0x89, 0xf6, 0x60, 0x5b, 0x06, 0xd4, 0xcd,
-	0x7b, 0x3e, 0x8f, 0xa8, 0x4a, 0x11, 0x05, 0x7f, 0x19, 0xb0, 0x35, 0x0f, 0x22, 0x31, 0xbe, 0x16,
-	0xdd, 0xbc, 0x06, 0xe9, 0x4f, 0xcc, 0x9d, 0xc9, 0x73, 0x2b, 0x77, 0x6c, 0xca, 0x54, 0xdf, 0xc7,
-	0x2d, 0xad, 0xc4, 0xd3, 0x38, 0x94, 0xe4, 0xc7, 0x79, 0xc4, 0x50, 0x39, 0x3f, 0x9f, 0x84, 0x22,
-	0xd4, 0xb4, 0xc4, 0x11, 0xb4, 0xd2, 0x6a, 0xd0, 0xa6, 0x86, 0xba, 0x22, 0x9d, 0xec, 0xc3, 0xae,
-	0xbe, 0x71, 0x26, 0x51, 0x47, 0x26, 0xfa, 0x3d, 0xd4, 0x0b, 0x4e, 0x7b, 0x41, 0xab, 0x82, 0x80,
-	0xe2, 0xfa, 0xa0, 0x7a, 0xf6, 0x01, 0xdc, 0x2d, 0xd6, 0x50, 0xfc, 0x33, 0x3b, 0x2f, 0x94, 0x27,
-	0xdd, 0x5b, 0x7c, 0xcf, 0x1f, 0x2a, 0xce, 0x7f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x8e, 0xee, 0xbb,
-	0x95, 0xef, 0x0d, 0x00, 0x00,
+	// 1299 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x57, 0x4b, 0x6f, 0xdb, 0x46,
+	0x10, 0x06, 0x1f, 0x92, 0xc8, 0x11, 0x29, 0x33, 0x6b, 0x3b, 0xd9, 0x34, 0x8f, 0x0a, 0x44, 0x0b,
+	0xab, 0x48, 0xaa, 0x06, 0x46, 0x0e, 0x7d, 0xa0, 0x05, 0x82, 0xd8, 0x46, 0x04, 0xa4, 0xa9, 0x21,
+	0x09, 0x29, 0xd0, 0x4b, 0xb1, 0xa5, 0x26, 0x0a, 0x13, 0x89, 0x24, 0x76, 0x57, 0x8e, 0xd3, 0x53,
+	0xff, 0x41, 0x6f, 0xf9, 0x01, 0xbd, 0xf4, 0xd2, 0x53, 0xd1, 0xdf, 0xd4, 0x6b, 0xff, 0x42, 0xb1,
+	0xcb, 0x87, 0xde, 0xb6, 0x9b, 0x4b, 0x8f, 0x3b, 0x9c, 0x9d, 0x99, 0xfd, 0x66, 0xe6, 0x9b, 0x21,
+	0x80, 0xc8, 0x30, 0xea, 0x66, 0x3c, 0x95, 0x29, 0x69, 0x8d, 0xd9, 0x6c, 0x8c, 0xdd, 0x29, 0x0a,
+	0xc1, 0xc6, 0x28, 0xc2, 0x77, 0x26, 0xb8, 0xa7, 0xea, 0xcb, 0x20, 0xc3, 0x88, 0xec, 0x42, 0x53,
+	0xe9, 0x3e, 0x41, 0x36, 0x8a, 0x93, 0x31, 0x35, 0xda, 0x66, 0xc7, 0x25, 0x1d, 0xa8, 0xc5, 0x12,
+	0xa7, 0x82, 0x9a, 0x6d, 0xab, 0xd3, 0x3c, 0xbc, 0xd9, 0x5d, 0x36, 0xd1, 0xd5, 0xd7, 0x7b, 0x12,
+	0xa7, 0x64, 0x1f, 0xfc, 0x58, 0x0c, 0xd9, 0x4f, 0x13, 0x3c, 0xe2, 0xf1, 0x19, 0x26, 0xd4, 0x6a,
+	0x9b, 0x1d, 0x87, 0x7c, 0x0e, 0xad, 0x8c, 0xe3, 0x93, 0x34, 0x7d, 0x7d, 0xc2, 0xe2, 0xc9, 0x8c,
+	0x23, 0xb5, 0xdb, 0x46, 0xa7, 0x79, 0xd8, 0xde, 0x68, 0x69, 0x41, 0x8f, 0x7c, 0x01, 0x3b, 0x59,
+	0x2a, 0xe4, 0xe2, 0xd5, 0xda, 0x15, 0xaf, 0x06, 0xe0, 0xbc, 0x88, 0x27, 0xf8, 0x8c, 0x4d, 0x91,
+	0xd6, 0xf5, 0x3b, 0x3c, 0xb0, 0x25, 0x1b, 0x0b, 0xda, 0x68, 0x5b, 0x1d, 0x97, 0xb4, 0xa0, 0x2e,
+	0xe4, 0x28, 0x9d, 0x49, 0xea, 0xb4, 0x8d, 0xea, 0x8c, 0x9c, 0x53, 0x57, 0x9d, 0xc3, 0x5f, 0x6c,
+	0x70, 0xe7, 0x2f, 0x7b, 0x08, 0x8e, 0xc2, 0x60, 0xf8, 0x36, 0x43, 0x8d, 0x4a, 0xeb, 0x30, 0xdc,
+	0x0a, 0x43, 0xb7, 0x57, 0x68, 0x92, 0x03, 0xb0, 0x85, 0xc4, 0x8c, 0x9a, 0x6d, 0x63, 0x2b, 0x70,
+	0x03, 0x89, 0x19, 0xf9, 0x14, 0x1a, 0x51, 0x9a, 0x44, 0x98, 0x49, 0x6a, 0x69, 0xdd, 0xdb, 0x1b,
+	0x75, 0x1f, 0xe7, 0x3a, 0xe4, 0x33, 0x70, 0x44, 0x84, 0x09, 0xe3, 0x71, 0x5a, 0x40, 0x79, 0x67,
+	0xb3, 0xed, 0x42, 0x89, 0x1c, 0xc3, 0xae, 0x9c, 0xa7, 0xa5, 0x14, 0x17, 0x58, 0x76, 0x36, 0xde,
+	0x1d, 0xae, 0xeb, 0xe7, 0x61, 0x4e, 0xa7, 0x98, 0x48, 0x5a, 0xbf, 0x30, 0x4c, 0xad, 0x43, 0x3e,
+	0x81, 0x9a, 0xf6, 0x4a, 0x1b, 0x5a, 0xf9, 0x83, 0xed, 0x7e, 0xc8, 0x41, 0x91, 0x1b, 0xe7, 0x02,
+	0xa4, 0x86, 0x6c, 0x2c, 0xc2, 0x57, 0xe0, 0x54, 0xf0, 0x3a, 0x60, 0x2b, 0xf4, 0x02, 0x83, 0x34,
+	0xa1, 0x51, 0x38, 0x0d, 0xcc, 0xfc, 0xa0, 0x81, 0x0a, 0x2c, 0xe2, 0x81, 0x53, 0x86, 0x1f, 0xd8,
+	0xe4, 0x06, 0xec, 0x6e, 0x78, 0x57, 0x50, 0x23, 0x2e, 0xd4, 0xf4, 0x87, 0xa0, 0xae, 0xac, 0x2a,
+	0x4f, 0x41, 0x23, 0xfc, 0xcb, 0x02, 0x7f, 0x19, 0xc7, 0x1b, 0xb0, 0x53, 0x02, 0xbf, 0xdc, 0x23,
+	0x2d, 0xa8, 0xbf, 0x60, 0xf1, 0x04, 0x47, 0xd4, 0xd4, 0x25, 0x7f, 0x0f, 0x9c, 0x28, 0x4d, 0x24,
+	0x9e, 0x4b, 0x41, 0xad, 0xcb, 0xda, 0xe6, 0x01, 0xf8, 0xa5, 0xd5, 0x9e, 0x6e, 0x34, 0xfb, 0xb2,
+	0x1b, 0xeb, 0x1d, 0x55, 0x7b, 0xff, 0x8e, 0xaa, 0x5f, 0xf1, 0xea, 0x72, 0xff, 0xec, 0x83, 0x8f,
+	0xe7, 0x18, 0xcd, 0x64, 0x9c, 0x26, 0xc3, 0x78, 0x8a, 0x3a, 0x75, 0x16, 0xd9, 0x81, 0x86, 0x78,
+	0x1d, 0x67, 0x19, 0x8e, 0xa8, 0xab, 0x91, 0x20, 0x00, 0x4a, 0x70, 0xcc, 0x79, 0xca, 0x05, 0x05,
+	0x7d, 0x17, 0xc0, 0xec, 0x1d, 0xd1, 0xa6, 0xee, 0xbb, 0x07, 0xe0, 0x4b, 0x64, 0xfc, 0x28, 0x7d,
+	0x93, 0xa8, 0x64, 0x0a, 0xea, 0x5d, 0xf6, 0xf8, 0x79, 0xe7, 0xfa, 0x2b, 0x9d, 0xdb, 0xd2, 0x9d,
+	0xfb, 0x14, 0xe8, 0xd6, 0x0a, 0x7e, 0x00, 0x6e, 0x09, 0xb5, 0xa0, 0x46, 0xdb, 0xba, 0xb4, 0x75,
+	0xc2, 0xdf, 0x0d, 0x70, 0xe7, 0x8d, 0x4a, 0x00, 0x58, 0x24, 0x67, 0x6c, 0x32, 0xc4, 0x73, 0x59,
+	0xe4, 0x9e, 0x00, 0x64, 0x8c, 0x0b, 0x1c, 0x69, 0x99, 0xa9, 0x65, 0xf7, 0xc0, 0x7d, 0xc1, 0xd9,
+	0x58, 0x55, 0x64, 0x59, 0x00, 0x74, 0xd5, 0xcf, 0x49, 0xa1, 0xa0, 0xba, 0x53, 0xd1, 0xc4, 0x71,
+	0x09, 0x67, 0x1f, 0xc5, 0x6c, 0x22, 0xa9, 0x7d, 0x41, 0x77, 0x0e, 0xd6, 0xf5, 0xc3, 0x3f, 0x0d,
+	0xf0, 0x96, 0x68, 0xa2, 0x0b, 0xcd, 0x82, 0x55, 0x94, 0xba, 0x8e, 0xf6, 0x42, 0x16, 0xea, 0x40,
+	0x4d, 0xe8, 0x14, 0x5c, 0x4a, 0xf4, 0x4f, 0xe0, 0x7a, 0x61, 0x79, 0x35, 0x68, 0xeb, 0x3f, 0x06,
+	0x7d, 0xb3, 0x40, 0x57, 0xb5, 0x5c, 0x55, 0x61, 0x2a, 0x31, 0x6e, 0xf8, 0x87, 0x01, 0x4e, 0x85,
+	0xd1, 0x57, 0xe0, 0x95, 0x80, 0x2e, 0x90, 0xf0, 0xc7, 0xdb, 0x30, 0xed, 0x9e, 0x2c, 0x28, 0x6b,
+	0xbb, 0x79, 0x6e, 0x54, 0xbd, 0xdc, 0x07, 0x37, 0x63, 0x9c, 0x4d, 0x51, 0x22, 0x2f, 0xe2, 0x5d,
+	0x7f, 0x6a, 0xa9, 0x10, 0x1e, 0x80, 0xb7, 0x64, 0x4b, 0xd1, 0x03, 0x9e, 0xcb, 0xc0, 0x20, 0x3e,
+	0xb8, 0x95, 0x5a, 0x60, 0x86, 0xff, 0x18, 0x0b, 0x67, 0xf2, 0x0d, 0xf8, 0x95, 0x93, 0x85, 0x80,
+	0x0f, 0xb6, 0x3a, 0xea, 0x9e, 0x2e, 0xaa, 0x13, 0x1f, 0x6a, 0x67, 0x6c, 0x32, 0xc3, 0x22, 0x66,
+	0x0f, 0xec, 0x44, 0x4d, 0x32, 0x4b, 0x9f, 0x2a, 0x62, 0xb5, 0x2f, 0x23, 0xd6, 0xf0, 0x07, 0xf0,
+	0x97, 0x0d, 0x03, 0xd4, 0x07, 0x92, 0xc9, 0x38, 0xca, 0x69, 0xf3, 0xe8, 0x6d, 0xc2, 0xa6, 0x71,
+	0x14, 0x98, 0x84, 0x40, 0x4b, 0xed, 0x00, 0x31, 0x9b, 0xfc, 0x38, 0x90, 0x3c, 0x4e, 0xc6, 0x81,
+	0x45, 0xae, 0x81, 0x5f, 0xca, 0x72, 0x7a, 0xb4, 0xe7, 0x4c, 0x59, 0x0b, 0x6f, 0x57, 0xf5, 0x96,
+	0xf3, 0x7d, 0x09, 0xb3, 0x6e, 0x8b, 0x30, 0x06, 0x58, 0x20, 0xf8, 0x2e, 0x34, 0x5e, 0x22, 0x1b,
+	0x21, 0x17, 0x45, 0x1d, 0xde, 0xd9, 0x1e, 0x74, 0x3f, 0x7d, 0x43, 0xee, 0x81, 0xcd, 0xd3, 0x37,
+	0x65, 0x29, 0x5e, 0xac, 0x1c, 0xde, 0x05, 0x7f, 0x49, 0xa0, 0xd0, 0x8b, 0x70, 0x32, 0x29, 0x2b,
+	0xe9, 0x9d, 0x09, 0x74, 0x5b, 0x05, 0x92, 0xaf, 0x61, 0x07, 0x97, 0x45, 0xd4, 0xd0, 0xb0, 0x7e,
+	0xb4, 0xd1, 0xe9, 0xea, 0xf5, 0x75, 0x2a, 0x36, 0xdf, 0x9f, 0x8a, 0xad, 0x2b, 0x5e, 0x5d, 0x60,
+	0x59, 0x5b, 0xb3, 0xec, 0x3e, 0xf8, 0x85, 0xa0, 0x8f, 0x4c, 0xa4, 0x09, 0xad, 0x2d, 0x50, 0xa3,
+	0xa2, 0xca, 0xfa, 0x0a, 0x55, 0x36, 0x34, 0x55, 0xfe, 0x66, 0xc2, 0xde, 0xc6, 0x57, 0xcd, 0xe7,
+	0x99, 0xa1, 0xed, 0x53, 0x08, 0x38, 0x46, 0xe9, 0x19, 0x72, 0x05, 0xb1, 0x26, 0x73, 0xfd, 0x4e,
+	0x87, 0xec, 0x81, 0x87, 0xea, 0xf8, 0x6d, 0x1e, 0x6c, 0x51, 0xa1, 0x8a, 0xf5, 0x25, 0x8b, 0x5e,
+	0x0f, 0x39, 0x8b, 0xf2, 0x32, 0xcd, 0x65, 0x11, 0x47, 0x4c, 0x06, 0x2f, 0x53, 0xa9, 0x03, 0xf4,
+	0xd6, 0xa7, 0x88, 0x5a, 0xd5, 0xf4, 0x14, 0x29, 0x1e, 0x5f, 0x4c, 0x9b, 0xa7, 0xe0, 0x6a, 0x2f,
+	0xba, 0x95, 0xd4, 0xa4, 0x69, 0x1d, 0x76, 0xaf, 0x92, 0x9e, 0xee, 0x71, 0x79, 0xeb, 0x4b, 0xf7,
+	0xd1, 0x60, 0x70, 0xdc, 0x1f, 0xf6, 0xbe, 0x7b, 0x16, 0xde, 0x07, 0xb7, 0x92, 0xab, 0x36, 0xae,
+	0xbe, 0x04, 0x06, 0x09, 0xc0, 0x7b, 0x7e, 0xdc, 0xef, 0x9d, 0xf4, 0x1e, 0x3f, 0xd2, 0x12, 0x33,
+	0x3c, 0x85, 0x60, 0x2d, 0x01, 0xcb, 0xef, 0xcb, 0xe7, 0xc0, 0x2a, 0x12, 0x66, 0xdb, 0x5c, 0x7b,
+	0xb5, 0x42, 0xc7, 0x0b, 0x7f, 0xb5, 0x0a, 0x93, 0x83, 0x59, 0x2c, 0xb1, 0x80, 0xfc, 0x61, 0xbe,
+	0x7b, 0xe7, 0xa7, 0x72, 0x38, 0x7d, 0xb8, 0x99, 0x48, 0x2b, 0xbd, 0xff, 0xa7, 0xfc, 0xe6, 0xd5,
+	0x61, 0x97, 0xd5, 0xa1, 0x42, 0x17, 0x27, 0x5a, 0xf8, 0x38, 0x9d, 0x25, 0x2a, 0xbf, 0x66, 0xa7,
+	0xb6, 0x29, 0xbf, 0x6a, 0x4b, 0x50, 0xff, 0x19, 0xb3, 0x28, 0x42, 0x21, 0xfa, 0x4c, 0xaa, 0x1c,
+	0x9b, 0x1d, 0x53, 0x09, 0x31, 0x39, 0x8b, 0x79, 0x9a, 0xe8, 0x0d, 0xd3, 0x29, 0x89, 0x4f, 0x8f,
+	0x04, 0xbd, 0x94, 0x2b, 0x95, 0x8c, 0xa7, 0xaf, 0x30, 0x92, 0x7a, 0xaf, 0x07, 0x8d, 0xf0, 0x35,
+	0x70, 0x65, 0x3c, 0x45, 0x21, 0xd9, 0x34, 0xa3, 0x4d, 0x2d, 0xba, 0x09, 0xd7, 0x74, 0x40, 0x83,
+	0xbc, 0x27, 0xf2, 0x88, 0x3c, 0x1d, 0xd1, 0x1e, 0x78, 0xb1, 0x38, 0x65, 0x5c, 0xc6, 0x6c, 0xd2,
+	0x9f, 0x25, 0x7a, 0x87, 0x70, 0xc2, 0xbf, 0x0d, 0xd8, 0x59, 0x85, 0x56, 0xcd, 0x89, 0x52, 0x74,
+	0xf1, 0xf0, 0x54, 0xbf, 0x4e, 0xfb, 0xf3, 0x25, 0x2e, 0x77, 0x67, 0x6a, 0x77, 0xb7, 0x60, 0xb7,
+	0x14, 0x2f, 0xa2, 0x63, 0xe9, 0x8f, 0xab, 0x38, 0xde, 0x82, 0xdd, 0xfc, 0x7c, 0xc4, 0x24, 0x2b,
+	0xc9, 0x4c, 0xd0, 0x5a, 0xdb, 0xda, 0x0e, 0xe5, 0x02, 0x15, 0x34, 0xb4, 0x91, 0xdb, 0xb0, 0x57,
+	0x7a, 0x5c, 0x7a, 0xbe, 0x5a, 0xc7, 0x6a, 0xe1, 0xf7, 0xd0, 0xaa, 0x98, 0xf0, 0xb9, 0x1a, 0x30,
+	0x0a, 0x3e, 0x51, 0x1e, 0x8a, 0x4a, 0xbe, 0x0b, 0xd7, 0xab, 0xe1, 0x15, 0xff, 0x8c, 0xa3, 0x4a,
+	0x79, 0x5e, 0xd3, 0xd5, 0xf7, 0x7c, 0xbd, 0x71, 0xff, 0x1d, 0x00, 0x6d, 0x24, 0xe1, 0xc3, 0x65,
+	0x0e, 0x00, 0x00,
 }
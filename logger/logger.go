@@ -21,6 +21,8 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"sort"
+	"strconv"
 	"strings"
 
 	"runtime"
@@ -33,9 +35,15 @@ import (
 
 const (
 	logsDirectory    = "logs_directory"
+	logsFormat       = "logs_format"
+	logsMaxSize      = "logs_max_size"
+	logsMaxBackups   = "logs_max_backups"
+	logsMaxAge       = "logs_max_age"
 	logs             = "logs"
 	gaugeLogFileName = "gauge.log"
 	apiLogFileName   = "api.log"
+	textLogFormat    = "text"
+	jsonLogFormat    = "json"
 )
 
 var level logging.Level
@@ -79,60 +87,81 @@ var GaugeLog = logging.MustGetLogger("gauge")
 // APILog is for logging API related messages
 var APILog = logging.MustGetLogger("gauge-api")
 
-var fileLogFormat = logging.MustStringFormatter("%{time:15:04:05.000} %{message}")
 var gaugeLogFile = filepath.Join(logs, gaugeLogFileName)
 var apiLogFile = filepath.Join(logs, apiLogFileName)
 
 // Initialize initializes the logger object
 func Initialize(logLevel string) {
 	level = loggingLevel(logLevel)
-	initGaugeFileLogger()
-	initAPIFileLogger()
+	settings, warnings := fileLogSettings()
+	initFileLogger(GaugeLog, gaugeLogFile, 20, settings)
+	initFileLogger(APILog, apiLogFile, 10, settings)
 	if runtime.GOOS == "windows" {
 		isWindows = true
 	}
+	HandleWarningMessages(warnings)
 }
 
-func initGaugeFileLogger() {
-	logsDir, err := filepath.Abs(os.Getenv(logsDirectory))
-	var gaugeFileLogger logging.Backend
-	if logsDir == "" || err != nil {
-		gaugeFileLogger = createFileLogger(gaugeLogFile, 20)
-	} else {
-		gaugeFileLogger = createFileLogger(filepath.Join(logsDir, gaugeLogFileName), 20)
-	}
-	fileFormatter := logging.NewBackendFormatter(gaugeFileLogger, fileLogFormat)
-	fileLoggerLeveled := logging.AddModuleLevel(fileFormatter)
-	fileLoggerLeveled.SetLevel(logging.DEBUG, "")
+// logSettings are read from the env properties next to logs_directory
+type logSettings struct {
+	format     string
+	maxSize    int // megabytes, 0 keeps the default size of each log file
+	maxBackups int
+	maxAge     int // days
+}
 
-	GaugeLog.SetBackend(fileLoggerLeveled)
+func fileLogSettings() (*logSettings, []string) {
+	var warnings []string
+	settings := &logSettings{format: textLogFormat, maxBackups: 3, maxAge: 28}
+	switch format := strings.ToLower(strings.TrimSpace(os.Getenv(logsFormat))); format {
+	case "", textLogFormat:
+	case jsonLogFormat:
+		settings.format = jsonLogFormat
+	default:
+		warnings = append(warnings, fmt.Sprintf("Invalid value for %s: %s. Expected %s or %s.", logsFormat, format, textLogFormat, jsonLogFormat))
+	}
+	for property, value := range map[string]*int{logsMaxSize: &settings.maxSize, logsMaxBackups: &settings.maxBackups, logsMaxAge: &settings.maxAge} {
+		v := strings.TrimSpace(os.Getenv(property))
+		if v == "" {
+			continue
+		}
+		n, err := strconv.Atoi(v)
+		if err != nil || n < 0 {
+			warnings = append(warnings, fmt.Sprintf("Invalid value for %s: %s. Expected a non-negative number.", property, v))
+			continue
+		}
+		*value = n
+	}
+	sort.Strings(warnings)
+	return settings, warnings
 }
 
-func initAPIFileLogger() {
+func initFileLogger(log *logging.Logger, defaultFile string, defaultSize int, settings *logSettings) {
+	logFile := defaultFile
 	logsDir, err := filepath.Abs(os.Getenv(logsDirectory))
-	var apiFileLogger logging.Backend
-	if logsDir == "" || err != nil {
-		apiFileLogger = createFileLogger(apiLogFile, 10)
-	} else {
-		apiFileLogger = createFileLogger(filepath.Join(logsDir, apiLogFileName), 10)
+	if logsDir != "" && err == nil {
+		logFile = filepath.Join(logsDir, filepath.Base(defaultFile))
+	}
+	size := settings.maxSize
+	if size == 0 {
+		size = defaultSize
 	}
-	fileFormatter := logging.NewBackendFormatter(apiFileLogger, fileLogFormat)
-	fileLoggerLeveled := logging.AddModuleLevel(fileFormatter)
+	fileLoggerLeveled := logging.AddModuleLevel(createFileLogger(logFile, size, settings))
 	fileLoggerLeveled.SetLevel(logging.DEBUG, "")
 
-	APILog.SetBackend(fileLoggerLeveled)
+	log.SetBackend(fileLoggerLeveled)
 }
 
-func createFileLogger(name string, size int) logging.Backend {
+func createFileLogger(name string, size int, settings *logSettings) logging.Backend {
 	if !filepath.IsAbs(name) {
 		name = getLogFile(name)
 	}
-	return logging.NewLogBackend(&lumberjack.Logger{
+	return newStructuredBackend(&lumberjack.Logger{
 		Filename:   name,
 		MaxSize:    size, // megabytes
-		MaxBackups: 3,
-		MaxAge:     28, //days
-	}, "", 0)
+		MaxBackups: settings.maxBackups,
+		MaxAge:     settings.maxAge, //days
+	}, settings.format)
 }
 
 func getLogFile(fileName string) string {
@@ -0,0 +1,294 @@
+// Copyright 2015 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+
+package logger
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/op/go-logging"
+)
+
+// Fields which identify where in an execution a message was logged.
+const (
+	StreamField    = "stream"
+	SpecField      = "spec"
+	ScenarioField  = "scenario"
+	StepField      = "step"
+	RunnerPIDField = "runner_pid"
+)
+
+var fieldOrder = []string{StreamField, SpecField, ScenarioField, StepField, RunnerPIDField}
+
+// Fields hold the context logged along with a message
+type Fields map[string]interface{}
+
+func (f Fields) merge(other Fields) Fields {
+	merged := make(Fields, len(f)+len(other))
+	for key, value := range f {
+		merged[key] = value
+	}
+	for key, value := range other {
+		merged[key] = value
+	}
+	return merged
+}
+
+// keys returns the known fields first, in the order they are nested in an execution, followed by the others sorted.
+func (f Fields) keys() []string {
+	var keys, others []string
+	for _, key := range fieldOrder {
+		if _, ok := f[key]; ok {
+			keys = append(keys, key)
+		}
+	}
+	for key := range f {
+		if !isKnownField(key) {
+			others = append(others, key)
+		}
+	}
+	sort.Strings(others)
+	return append(keys, others...)
+}
+
+func (f Fields) String() string {
+	var pairs []string
+	for _, key := range f.keys() {
+		value := fmt.Sprint(f[key])
+		if value == "" || strings.ContainsAny(value, " \t\n\"=") {
+			value = strconv.Quote(value)
+		}
+		pairs = append(pairs, key+"="+value)
+	}
+	return strings.Join(pairs, " ")
+}
+
+func isKnownField(key string) bool {
+	for _, known := range fieldOrder {
+		if key == known {
+			return true
+		}
+	}
+	return false
+}
+
+// fieldsMessage is handed to the file loggers in place of a plain message so that the fields reach the backend.
+type fieldsMessage struct {
+	fields Fields
+	text   string
+}
+
+func (m *fieldsMessage) String() string {
+	if len(m.fields) == 0 {
+		return m.text
+	}
+	return fmt.Sprintf("[%s] %s", m.fields, m.text)
+}
+
+// Entry logs messages along with the fields it was created with
+type Entry struct {
+	fields Fields
+}
+
+// WithFields returns an entry which logs messages with the given fields
+func WithFields(fields Fields) *Entry {
+	return &Entry{fields: Fields{}.merge(fields)}
+}
+
+// WithFields returns an entry which logs messages with the fields of this entry and the given ones
+func (e *Entry) WithFields(fields Fields) *Entry {
+	return &Entry{fields: e.fields.merge(fields)}
+}
+
+// Fields returns a copy of the fields of the entry
+func (e *Entry) Fields() Fields {
+	return Fields{}.merge(e.fields)
+}
+
+// Info logs INFO messages
+func (e *Entry) Info(msg string, args ...interface{}) {
+	e.log(logging.INFO, msg, args...)
+	fmt.Println(e.console(msg, args...))
+}
+
+// Errorf logs ERROR messages
+func (e *Entry) Errorf(msg string, args ...interface{}) {
+	e.log(logging.ERROR, msg, args...)
+	fmt.Println(e.console(msg, args...))
+}
+
+// Warning logs WARNING messages
+func (e *Entry) Warning(msg string, args ...interface{}) {
+	e.log(logging.WARNING, msg, args...)
+	fmt.Println(e.console(msg, args...))
+}
+
+// Debug logs DEBUG messages
+func (e *Entry) Debug(msg string, args ...interface{}) {
+	e.log(logging.DEBUG, msg, args...)
+	if level == logging.DEBUG {
+		fmt.Println(e.console(msg, args...))
+	}
+}
+
+// console prefixes messages of a parallel stream the way the parallel console reporter does
+func (e *Entry) console(msg string, args ...interface{}) string {
+	text := fmt.Sprintf(msg, args...)
+	if stream, ok := e.fields[StreamField]; ok {
+		return fmt.Sprintf("[runner: %v] %s", stream, text)
+	}
+	return text
+}
+
+// log writes the message only to the gauge log file
+func (e *Entry) log(l logging.Level, msg string, args ...interface{}) {
+	message := &fieldsMessage{fields: e.fields, text: fmt.Sprintf(msg, args...)}
+	switch l {
+	case logging.DEBUG:
+		GaugeLog.Debug("%s", message)
+	case logging.WARNING:
+		GaugeLog.Warning("%s", message)
+	case logging.ERROR:
+		GaugeLog.Error("%s", message)
+	default:
+		GaugeLog.Info("%s", message)
+	}
+}
+
+// Writer logs every line written to it to the gauge log file, with the fields of its entry and the context set last.
+// It is used to capture the output of runners into the same log as the messages of gauge.
+type Writer struct {
+	mutex   sync.Mutex
+	entry   *Entry
+	context Fields
+	level   logging.Level
+	buffer  bytes.Buffer
+}
+
+// InfoWriter returns a writer which logs the lines written to it as INFO messages
+func (e *Entry) InfoWriter() *Writer {
+	return &Writer{entry: e, level: logging.INFO}
+}
+
+// ErrorWriter returns a writer which logs the lines written to it as ERROR messages
+func (e *Entry) ErrorWriter() *Writer {
+	return &Writer{entry: e, level: logging.ERROR}
+}
+
+// AddFields adds fields to every line logged from now on
+func (w *Writer) AddFields(fields Fields) {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+	w.entry = w.entry.WithFields(fields)
+}
+
+// SetContext replaces the fields set by the previous call, e.g. the spec, scenario and step being executed.
+func (w *Writer) SetContext(context Fields) {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+	w.flush()
+	w.context = context
+}
+
+func (w *Writer) Write(b []byte) (int, error) {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+	w.buffer.Write(b)
+	for {
+		i := bytes.IndexByte(w.buffer.Bytes(), '\n')
+		if i < 0 {
+			break
+		}
+		w.logLine(string(w.buffer.Next(i + 1)))
+	}
+	return len(b), nil
+}
+
+// Flush logs what was written after the last new line
+func (w *Writer) Flush() {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+	w.flush()
+}
+
+func (w *Writer) flush() {
+	if w.buffer.Len() > 0 {
+		w.logLine(w.buffer.String())
+		w.buffer.Reset()
+	}
+}
+
+func (w *Writer) logLine(line string) {
+	line = strings.TrimRight(line, "\r\n")
+	if strings.TrimSpace(line) == "" {
+		return
+	}
+	w.entry.WithFields(w.context).log(w.level, "%s", line)
+}
+
+// structuredBackend writes records to the log files, as text or as one JSON object per line
+type structuredBackend struct {
+	mutex  sync.Mutex
+	writer io.Writer
+	json   bool
+}
+
+func newStructuredBackend(writer io.Writer, format string) *structuredBackend {
+	return &structuredBackend{writer: writer, json: format == jsonLogFormat}
+}
+
+func (b *structuredBackend) Log(l logging.Level, calldepth int, rec *logging.Record) error {
+	fields, text := recordFields(rec)
+	var line []byte
+	if b.json {
+		entry := make(map[string]interface{}, len(fields)+4)
+		for key, value := range fields {
+			entry[key] = value
+		}
+		entry["time"] = rec.Time.Format("2006-01-02T15:04:05.000Z07:00")
+		entry["level"] = l.String()
+		entry["module"] = rec.Module
+		entry["message"] = text
+		var err error
+		if line, err = json.Marshal(entry); err != nil {
+			return err
+		}
+	} else {
+		message := &fieldsMessage{fields: fields, text: text}
+		line = []byte(fmt.Sprintf("%s %s", rec.Time.Format("15:04:05.000"), message))
+	}
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	_, err := b.writer.Write(append(line, '\n'))
+	return err
+}
+
+func recordFields(rec *logging.Record) (Fields, string) {
+	if len(rec.Args) == 1 {
+		if message, ok := rec.Args[0].(*fieldsMessage); ok {
+			return message.fields, message.text
+		}
+	}
+	return nil, rec.Message()
+}
@@ -0,0 +1,110 @@
+// Copyright 2015 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+
+package logger
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"strings"
+
+	"github.com/op/go-logging"
+	. "gopkg.in/check.v1"
+)
+
+func captureGaugeLog(format string) *bytes.Buffer {
+	buffer := &bytes.Buffer{}
+	backend := logging.AddModuleLevel(newStructuredBackend(buffer, format))
+	backend.SetLevel(logging.DEBUG, "")
+	GaugeLog.SetBackend(backend)
+	return buffer
+}
+
+func (s *MySuite) TestFieldsAreLoggedInExecutionOrder(c *C) {
+	fields := Fields{RunnerPIDField: 42, "other": "x", StepField: "Say hello", StreamField: 2, SpecField: "specs/a.spec"}
+
+	c.Assert(fields.String(), Equals, `stream=2 spec=specs/a.spec step="Say hello" runner_pid=42 other=x`)
+}
+
+func (s *MySuite) TestEntryLogsTextWithFields(c *C) {
+	buffer := captureGaugeLog(textLogFormat)
+	defer Initialize("info")
+
+	WithFields(Fields{StreamField: 1}).WithFields(Fields{SpecField: "a.spec"}).log(logging.INFO, "started %s", "spec")
+
+	c.Assert(strings.HasSuffix(buffer.String(), " [stream=1 spec=a.spec] started spec\n"), Equals, true)
+}
+
+func (s *MySuite) TestEntryLogsJSON(c *C) {
+	buffer := captureGaugeLog(jsonLogFormat)
+	defer Initialize("info")
+
+	WithFields(Fields{StreamField: 3, ScenarioField: "Login"}).log(logging.ERROR, "failed")
+
+	var entry map[string]interface{}
+	c.Assert(json.Unmarshal(buffer.Bytes(), &entry), IsNil)
+	c.Assert(entry["message"], Equals, "failed")
+	c.Assert(entry["level"], Equals, "ERROR")
+	c.Assert(entry["stream"], Equals, float64(3))
+	c.Assert(entry["scenario"], Equals, "Login")
+}
+
+func (s *MySuite) TestWriterLogsLinesWithContext(c *C) {
+	buffer := captureGaugeLog(jsonLogFormat)
+	defer Initialize("info")
+	writer := WithFields(Fields{StreamField: 1}).InfoWriter()
+	writer.AddFields(Fields{RunnerPIDField: 100})
+
+	writer.Write([]byte("first line\nsecond "))
+	writer.SetContext(Fields{StepField: "Say hello"})
+	writer.Write([]byte("third\n\n"))
+	writer.Flush()
+
+	lines := strings.Split(strings.TrimSpace(buffer.String()), "\n")
+	c.Assert(len(lines), Equals, 3)
+	var entries []map[string]interface{}
+	for _, line := range lines {
+		var entry map[string]interface{}
+		c.Assert(json.Unmarshal([]byte(line), &entry), IsNil)
+		entries = append(entries, entry)
+	}
+	c.Assert(entries[0]["message"], Equals, "first line")
+	c.Assert(entries[0]["runner_pid"], Equals, float64(100))
+	c.Assert(entries[1]["message"], Equals, "second ")
+	c.Assert(entries[1]["step"], IsNil)
+	c.Assert(entries[2]["message"], Equals, "third")
+	c.Assert(entries[2]["step"], Equals, "Say hello")
+}
+
+func (s *MySuite) TestFileLogSettingsFromEnv(c *C) {
+	os.Setenv(logsFormat, "JSON")
+	os.Setenv(logsMaxSize, "5")
+	os.Setenv(logsMaxBackups, "-1")
+	defer func() {
+		os.Unsetenv(logsFormat)
+		os.Unsetenv(logsMaxSize)
+		os.Unsetenv(logsMaxBackups)
+	}()
+
+	settings, warnings := fileLogSettings()
+
+	c.Assert(settings.format, Equals, jsonLogFormat)
+	c.Assert(settings.maxSize, Equals, 5)
+	c.Assert(settings.maxBackups, Equals, 3)
+	c.Assert(warnings, DeepEquals, []string{"Invalid value for logs_max_backups: -1. Expected a non-negative number."})
+}
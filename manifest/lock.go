@@ -0,0 +1,105 @@
+// Copyright 2015 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+
+package manifest
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"sort"
+
+	"github.com/getgauge/common"
+	"github.com/getgauge/gauge/config"
+)
+
+// LockFile records the exact plugin versions used by a project
+const LockFile = "manifest.lock"
+
+// LockedPlugin is the version of a plugin a project is locked to, with the SHA-256 checksums of its downloads keyed
+// by platform, e.g. linux_x64.
+type LockedPlugin struct {
+	Name      string
+	Version   string
+	Checksums map[string]string `json:",omitempty"`
+}
+
+// Lock holds the plugin versions which were resolved from the version constraints of the manifest.
+type Lock struct {
+	Plugins []*LockedPlugin
+}
+
+// ProjectLock reads the lock file of the project. An empty lock is returned if the project does not have one.
+func ProjectLock() (*Lock, error) {
+	return ReadLock(config.ProjectRoot)
+}
+
+// ReadLock reads the lock file of the project at the given root. An empty lock is returned if the project does not
+// have one.
+func ReadLock(projectRoot string) (*Lock, error) {
+	lockFile := filepath.Join(projectRoot, LockFile)
+	if !common.FileExists(lockFile) {
+		return &Lock{}, nil
+	}
+	contents, err := common.ReadFileContents(lockFile)
+	if err != nil {
+		return nil, err
+	}
+	var l Lock
+	if err := json.Unmarshal([]byte(contents), &l); err != nil {
+		return nil, fmt.Errorf("Failed to read %s. %s", LockFile, err.Error())
+	}
+	return &l, nil
+}
+
+// Find returns the locked version of the plugin, nil if the plugin is not locked.
+func (l *Lock) Find(pluginName string) *LockedPlugin {
+	for _, p := range l.Plugins {
+		if p.Name == pluginName {
+			return p
+		}
+	}
+	return nil
+}
+
+// Set locks the plugin to the given version, replacing the version it was locked to.
+func (l *Lock) Set(lockedPlugin *LockedPlugin) {
+	for i, p := range l.Plugins {
+		if p.Name == lockedPlugin.Name {
+			l.Plugins[i] = lockedPlugin
+			return
+		}
+	}
+	l.Plugins = append(l.Plugins, lockedPlugin)
+}
+
+// Save writes the lock file to the project root, with the plugins sorted by name.
+func (l *Lock) Save() error {
+	sort.Sort(byName(l.Plugins))
+	b, err := json.MarshalIndent(l, "", "  ")
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(filepath.Join(config.ProjectRoot, LockFile), b, common.NewFilePermissions)
+}
+
+type byName []*LockedPlugin
+
+func (s byName) Len() int           { return len(s) }
+func (s byName) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
+func (s byName) Less(i, j int) bool { return s[i].Name < s[j].Name }
@@ -28,13 +28,40 @@ import (
 	"strings"
 )
 
+const pluginVersionSeparator = "@"
+
+// Manifest describes a Gauge project. Plugins can be given with a version constraint, e.g. html-report@^2.1.
 type Manifest struct {
 	Language string
 	Plugins  []string
 }
 
+// ParsePluginEntry splits a plugin of the manifest into the plugin name and its version constraint.
+func ParsePluginEntry(entry string) (string, string) {
+	parts := strings.SplitN(entry, pluginVersionSeparator, 2)
+	if len(parts) == 1 {
+		return strings.TrimSpace(parts[0]), ""
+	}
+	return strings.TrimSpace(parts[0]), strings.TrimSpace(parts[1])
+}
+
+// PluginNames returns the names of the plugins of the project, without their version constraints.
+func (m *Manifest) PluginNames() []string {
+	names := make([]string, 0, len(m.Plugins))
+	for _, entry := range m.Plugins {
+		name, _ := ParsePluginEntry(entry)
+		names = append(names, name)
+	}
+	return names
+}
+
 func ProjectManifest() (*Manifest, error) {
-	contents, err := common.ReadFileContents(path.Join(config.ProjectRoot, common.ManifestFile))
+	return ReadManifest(config.ProjectRoot)
+}
+
+// ReadManifest reads the manifest of the project at the given root.
+func ReadManifest(projectRoot string) (*Manifest, error) {
+	contents, err := common.ReadFileContents(path.Join(projectRoot, common.ManifestFile))
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,306 @@
+// Copyright 2015 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+
+package parser
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/gob"
+	"encoding/hex"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/getgauge/gauge/config"
+	"github.com/getgauge/gauge/gauge"
+	"github.com/getgauge/gauge/logger"
+	"github.com/getgauge/gauge/version"
+)
+
+const (
+	// parseCacheProperty disables the parse cache when set to false
+	parseCacheProperty = "parse_cache"
+	parseCacheDir      = ".gauge"
+	parseCacheFile     = "parse_cache"
+	parseCacheFormat   = 2
+	// Entries not used for parseCacheMaxAge are dropped, and the least recently used ones beyond parseCacheMaxEntries.
+	parseCacheMaxAge     = 30 * 24 * time.Hour
+	parseCacheMaxEntries = 50000
+	// The time an entry was last used is only updated, and the cache written for it, once in parseCacheTouchInterval.
+	parseCacheTouchInterval = 24 * time.Hour
+)
+
+// The parse cache keeps parsed specs and concepts in the .gauge directory of the project so that files which did not
+// change are not parsed again by the next invocation. Concepts are keyed by the hash of their file content, and specs
+// by the hash of their content and of the concept dictionary they were parsed with, so that a change to the concepts
+// parses the specs again. Specs reading other files, i.e. external data tables or special params, are not cached.
+type parseCache struct {
+	mutex   sync.Mutex
+	file    string
+	entries map[string]*parseCacheEntry
+	changed bool
+}
+
+// parseCacheEntry is a gob encoded cachedSpec or cachedConcepts, decoded only when it is used
+type parseCacheEntry struct {
+	Data     []byte
+	LastUsed time.Time
+}
+
+// parseCacheHeader identifies the gauge which wrote the cache, since parsing may change between versions
+type parseCacheHeader struct {
+	Format  int
+	Version string
+}
+
+var projectParseCache *parseCache
+var projectParseCacheMutex sync.Mutex
+
+// currentParseCache returns the cache of the current project, loading it on first use. It is nil if the parse cache
+// is disabled or there is no project.
+func currentParseCache() *parseCache {
+	if config.ProjectRoot == "" || strings.ToLower(strings.TrimSpace(os.Getenv(parseCacheProperty))) == "false" {
+		return nil
+	}
+	file := filepath.Join(config.ProjectRoot, parseCacheDir, parseCacheFile)
+	projectParseCacheMutex.Lock()
+	defer projectParseCacheMutex.Unlock()
+	if projectParseCache == nil || projectParseCache.file != file {
+		projectParseCache = loadParseCache(file)
+	}
+	return projectParseCache
+}
+
+func loadParseCache(file string) *parseCache {
+	c := &parseCache{file: file, entries: make(map[string]*parseCacheEntry)}
+	f, err := os.Open(file)
+	if err != nil {
+		return c
+	}
+	defer f.Close()
+	decoder := gob.NewDecoder(f)
+	var header parseCacheHeader
+	if err := decoder.Decode(&header); err != nil || header.Format != parseCacheFormat || header.Version != version.FullVersion() {
+		logger.Debug("Ignoring the parse cache %s written by another version of gauge.", file)
+		return c
+	}
+	if err := decoder.Decode(&c.entries); err != nil {
+		logger.Debug("Ignoring the corrupt parse cache %s. %s", file, err.Error())
+		c.entries = make(map[string]*parseCacheEntry)
+	}
+	return c
+}
+
+// parseSpec returns the spec parsed from the text with the concepts of the given hash from the cache, or from parse.
+// Parsed specs are cached unless they failed to parse or read other files. Specs are not cached without a hash of
+// the concepts.
+func (c *parseCache) parseSpec(text string, conceptsHash string, parse func() (*gauge.Specification, *ParseResult)) (*gauge.Specification, *ParseResult) {
+	if c == nil || conceptsHash == "" {
+		return parse()
+	}
+	key := "spec:" + contentHash(text) + ":" + conceptsHash
+	cached := &cachedSpec{}
+	if c.get(key, cached) {
+		return cached.specification(), &ParseResult{Ok: true, Warnings: cached.Warnings}
+	}
+	spec, parseResult := parse()
+	if !parseResult.Ok || readsOtherFiles(spec) {
+		return spec, parseResult
+	}
+	cached, err := newCachedSpec(spec, parseResult.Warnings)
+	if err != nil {
+		logger.Debug("Not caching the parsed spec. %s", err.Error())
+		return spec, parseResult
+	}
+	c.put(key, cached)
+	return spec, parseResult
+}
+
+// parseConcepts returns the concepts parsed from the text from the cache, or from parse which are then cached unless
+// they failed to parse or read other files.
+func (c *parseCache) parseConcepts(text string, parse func() ([]*gauge.Step, *ParseDetailResult)) ([]*gauge.Step, *ParseDetailResult) {
+	if c == nil {
+		return parse()
+	}
+	key := "concept:" + contentHash(text)
+	cached := &cachedConcepts{}
+	if c.get(key, cached) {
+		return cached.concepts(), cached.Details
+	}
+	concepts, parseDetails := parse()
+	if (parseDetails != nil && parseDetails.Error != nil) || stepsReadOtherFiles(concepts) {
+		return concepts, parseDetails
+	}
+	cached, err := newCachedConcepts(concepts, parseDetails)
+	if err != nil {
+		logger.Debug("Not caching the parsed concepts. %s", err.Error())
+		return concepts, parseDetails
+	}
+	c.put(key, cached)
+	return concepts, parseDetails
+}
+
+// conceptsHash returns the hash of the concepts in the dictionary, or an empty string if there is no cache or the
+// concepts can not be hashed.
+func (c *parseCache) conceptsHash(conceptDictionary *gauge.ConceptDictionary) string {
+	if c == nil {
+		return ""
+	}
+	hash := sha256.New()
+	if conceptDictionary != nil {
+		keys := make([]string, 0, len(conceptDictionary.ConceptsMap))
+		for key := range conceptDictionary.ConceptsMap {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		encoder := gob.NewEncoder(hash)
+		for _, key := range keys {
+			concept := conceptDictionary.ConceptsMap[key]
+			step, err := newCachedStep(concept.ConceptStep)
+			if err == nil {
+				err = encoder.Encode(concept.FileName)
+			}
+			if err == nil {
+				err = encoder.Encode(step)
+			}
+			if err != nil {
+				logger.Debug("Not caching specs, failed to hash the concept %s. %s", key, err.Error())
+				return ""
+			}
+		}
+	}
+	return hex.EncodeToString(hash.Sum(nil))
+}
+
+// get decodes the entry of the key into value, if the entry is in the cache
+func (c *parseCache) get(key string, value interface{}) bool {
+	c.mutex.Lock()
+	entry, ok := c.entries[key]
+	if ok && time.Since(entry.LastUsed) > parseCacheTouchInterval {
+		entry.LastUsed = time.Now()
+		c.changed = true
+	}
+	c.mutex.Unlock()
+	if !ok {
+		return false
+	}
+	if err := gob.NewDecoder(bytes.NewReader(entry.Data)).Decode(value); err != nil {
+		logger.Debug("Ignoring a corrupt entry of the parse cache %s. %s", c.file, err.Error())
+		return false
+	}
+	return true
+}
+
+func (c *parseCache) put(key string, value interface{}) {
+	var data bytes.Buffer
+	if err := gob.NewEncoder(&data).Encode(value); err != nil {
+		logger.Debug("Failed to add to the parse cache %s. %s", c.file, err.Error())
+		return
+	}
+	c.mutex.Lock()
+	c.entries[key] = &parseCacheEntry{Data: data.Bytes(), LastUsed: time.Now()}
+	c.changed = true
+	c.mutex.Unlock()
+}
+
+// save writes the cache if it changed since it was loaded or last saved, dropping the entries not used recently.
+func (c *parseCache) save() {
+	if c == nil {
+		return
+	}
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	if !c.changed {
+		return
+	}
+	c.prune()
+	if err := c.write(); err != nil {
+		logger.Debug("Failed to write the parse cache %s. %s", c.file, err.Error())
+		return
+	}
+	c.changed = false
+}
+
+func (c *parseCache) prune() {
+	keys := make([]string, 0, len(c.entries))
+	for key, entry := range c.entries {
+		if time.Since(entry.LastUsed) > parseCacheMaxAge {
+			delete(c.entries, key)
+			continue
+		}
+		keys = append(keys, key)
+	}
+	if len(keys) <= parseCacheMaxEntries {
+		return
+	}
+	sort.Sort(byLastUsed{keys: keys, entries: c.entries})
+	for _, key := range keys[parseCacheMaxEntries:] {
+		delete(c.entries, key)
+	}
+}
+
+// byLastUsed sorts the keys of the entries, the most recently used first
+type byLastUsed struct {
+	keys    []string
+	entries map[string]*parseCacheEntry
+}
+
+func (s byLastUsed) Len() int      { return len(s.keys) }
+func (s byLastUsed) Swap(i, j int) { s.keys[i], s.keys[j] = s.keys[j], s.keys[i] }
+func (s byLastUsed) Less(i, j int) bool {
+	return s.entries[s.keys[i]].LastUsed.After(s.entries[s.keys[j]].LastUsed)
+}
+
+// write replaces the cache file through a temporary file, so that concurrent invocations never read a partial cache.
+func (c *parseCache) write() error {
+	dir := filepath.Dir(c.file)
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return err
+	}
+	f, err := ioutil.TempFile(dir, parseCacheFile)
+	if err != nil {
+		return err
+	}
+	encoder := gob.NewEncoder(f)
+	err = encoder.Encode(parseCacheHeader{Format: parseCacheFormat, Version: version.FullVersion()})
+	if err == nil {
+		err = encoder.Encode(c.entries)
+	}
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		os.Remove(f.Name())
+		return err
+	}
+	return os.Rename(f.Name(), c.file)
+}
+
+// saveParseCache writes the cache of the current project, if any
+func saveParseCache() {
+	currentParseCache().save()
+}
+
+func contentHash(text string) string {
+	hash := sha256.Sum256([]byte(text))
+	return hex.EncodeToString(hash[:])
+}
@@ -0,0 +1,343 @@
+// Copyright 2015 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+
+package parser
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/getgauge/common"
+	"github.com/getgauge/gauge/config"
+	"github.com/getgauge/gauge/gauge"
+	. "gopkg.in/check.v1"
+)
+
+const cachedSpecText = `Spec heading
+============
+tags: first, second
+tags: third
+
+     |name |id|
+     |-----|--|
+     |gauge|1 |
+
+a comment
+* context step <name>
+
+Scenario heading
+----------------
+tags: scenario
+* step with "arg" and <name>
+     |header|
+     |------|
+     |value |
+scenario comment
+
+___
+* tear down step
+`
+
+func withProjectRoot(c *C, test func(dir string)) {
+	dir, err := ioutil.TempDir("", "gaugeParseCache")
+	c.Assert(err, IsNil)
+	oldRoot := config.ProjectRoot
+	config.ProjectRoot = dir
+	defer func() {
+		config.ProjectRoot = oldRoot
+		projectParseCache = nil
+		os.RemoveAll(dir)
+	}()
+	test(dir)
+}
+
+func newTestParseCache() *parseCache {
+	return &parseCache{entries: make(map[string]*parseCacheEntry)}
+}
+
+func countingParse(text string, calls *int) func() (*gauge.Specification, *ParseResult) {
+	return func() (*gauge.Specification, *ParseResult) {
+		*calls++
+		return new(SpecParser).Parse(text, gauge.NewConceptDictionary())
+	}
+}
+
+func (s *MySuite) TestParseCacheReturnsTheParsedSpec(c *C) {
+	cache := newTestParseCache()
+	calls := 0
+
+	spec, result := cache.parseSpec(cachedSpecText, "concepts", countingParse(cachedSpecText, &calls))
+	c.Assert(result.Ok, Equals, true)
+	cached, cachedResult := cache.parseSpec(cachedSpecText, "concepts", countingParse(cachedSpecText, &calls))
+
+	c.Assert(calls, Equals, 1)
+	c.Assert(cachedResult.Ok, Equals, true)
+	c.Assert(len(cachedResult.Warnings), Equals, len(result.Warnings))
+	c.Assert(cached.Heading, DeepEquals, spec.Heading)
+	c.Assert(cached.Tags.Values, DeepEquals, []string{"first", "second", "third"})
+	c.Assert(cached.DataTable.Table.Get("name")[0].Value, Equals, "gauge")
+	c.Assert(cached.Contexts[0].Args, DeepEquals, spec.Contexts[0].Args)
+	c.Assert(cached.Scenarios[0].Tags.Values, DeepEquals, []string{"scenario"})
+	c.Assert(cached.Scenarios[0].Steps[0].Fragments, DeepEquals, spec.Scenarios[0].Steps[0].Fragments)
+	c.Assert(cached.Scenarios[0].Steps[0].Args[2].Table.Get("header")[0].Value, Equals, "value")
+	c.Assert(cached.Scenarios[0].Comments, DeepEquals, spec.Scenarios[0].Comments)
+	c.Assert(cached.TearDownSteps[0].Value, Equals, "tear down step")
+	c.Assert(len(cached.Items), Equals, len(spec.Items))
+	for i, item := range cached.Items {
+		c.Assert(item.Kind(), Equals, spec.Items[i].Kind())
+	}
+}
+
+func (s *MySuite) TestParseCacheSharesItemsOfCachedSpecsAsParsed(c *C) {
+	cache := newTestParseCache()
+	calls := 0
+	cache.parseSpec(cachedSpecText, "concepts", countingParse(cachedSpecText, &calls))
+
+	spec, _ := cache.parseSpec(cachedSpecText, "concepts", countingParse(cachedSpecText, &calls))
+
+	c.Assert(calls, Equals, 1)
+	c.Assert(spec.Items[0], Equals, spec.Tags)
+	c.Assert(spec.Items[2], Equals, &spec.DataTable)
+	c.Assert(spec.Items[5], Equals, spec.Contexts[0])
+	c.Assert(spec.Items[7], Equals, spec.Scenarios[0])
+	c.Assert(spec.Items[len(spec.Items)-1], Equals, spec.TearDownSteps[0])
+	c.Assert(spec.Scenarios[0].Items[1], Equals, spec.Scenarios[0].Steps[0])
+}
+
+func (s *MySuite) TestParseCacheReturnsCopiesOfCachedSpecs(c *C) {
+	cache := newTestParseCache()
+	calls := 0
+	cache.parseSpec(cachedSpecText, "concepts", countingParse(cachedSpecText, &calls))
+
+	spec, _ := cache.parseSpec(cachedSpecText, "concepts", countingParse(cachedSpecText, &calls))
+	spec.Scenarios[0].Steps[0].Value = "changed"
+	cached, _ := cache.parseSpec(cachedSpecText, "concepts", countingParse(cachedSpecText, &calls))
+
+	c.Assert(cached.Scenarios[0].Steps[0].Value, Equals, "step with {} and {} {}")
+}
+
+func (s *MySuite) TestParseCacheDoesNotCacheParseErrors(c *C) {
+	cache := newTestParseCache()
+	text := "* step without spec heading"
+	calls := 0
+
+	_, result := cache.parseSpec(text, "concepts", countingParse(text, &calls))
+
+	c.Assert(result.Ok, Equals, false)
+	c.Assert(len(cache.entries), Equals, 0)
+}
+
+func (s *MySuite) TestParseCacheDoesNotCacheSpecsReadingOtherFiles(c *C) {
+	cache := newTestParseCache()
+	parse := func() (*gauge.Specification, *ParseResult) {
+		spec := &gauge.Specification{}
+		spec.AddHeading(&gauge.Heading{Value: "Spec heading", LineNo: 1})
+		spec.AddExternalDataTable(&gauge.DataTable{Value: "table: data.csv", IsExternal: true})
+		return spec, &ParseResult{Ok: true}
+	}
+
+	cache.parseSpec(cachedSpecText, "concepts", parse)
+
+	c.Assert(len(cache.entries), Equals, 0)
+}
+
+func (s *MySuite) TestParseCacheParsesSpecsAgainWithOtherConcepts(c *C) {
+	cache := newTestParseCache()
+	calls := 0
+
+	cache.parseSpec(cachedSpecText, "concepts", countingParse(cachedSpecText, &calls))
+	cache.parseSpec(cachedSpecText, "other concepts", countingParse(cachedSpecText, &calls))
+
+	c.Assert(calls, Equals, 2)
+}
+
+func (s *MySuite) TestParseCacheDoesNotCacheSpecsWithoutConceptsHash(c *C) {
+	cache := newTestParseCache()
+	calls := 0
+
+	cache.parseSpec(cachedSpecText, "", countingParse(cachedSpecText, &calls))
+	cache.parseSpec(cachedSpecText, "", countingParse(cachedSpecText, &calls))
+
+	c.Assert(calls, Equals, 2)
+	c.Assert(len(cache.entries), Equals, 0)
+}
+
+func (s *MySuite) TestConceptsHashChangesWithTheConcepts(c *C) {
+	cache := newTestParseCache()
+	dictionary := gauge.NewConceptDictionary()
+	emptyHash := cache.conceptsHash(dictionary)
+	c.Assert(AddConceptsFromText("# greet <name>\n* say hello to <name>\n", "greet.cpt", dictionary), IsNil)
+
+	hash := cache.conceptsHash(dictionary)
+
+	c.Assert(hash, Not(Equals), emptyHash)
+	c.Assert(cache.conceptsHash(dictionary), Equals, hash)
+	c.Assert((*parseCache)(nil).conceptsHash(dictionary), Equals, "")
+}
+
+func (s *MySuite) TestConceptStepsOfCachedSpecsAreLinkedToTheirConcept(c *C) {
+	withProjectRoot(c, func(dir string) {
+		specFile := filepath.Join(dir, "greet.spec")
+		c.Assert(ioutil.WriteFile(specFile, []byte("Spec\n====\nScenario\n--------\n* greet \"gauge\"\n"), 0644), IsNil)
+		specs, _ := ParseSpecFiles([]string{specFile}, gauge.NewConceptDictionary())
+		c.Assert(specs[0].Scenarios[0].Steps[0].IsConcept, Equals, false)
+
+		conceptsDir := filepath.Join(dir, common.SpecsDirectoryName)
+		c.Assert(os.MkdirAll(conceptsDir, 0755), IsNil)
+		c.Assert(ioutil.WriteFile(filepath.Join(conceptsDir, "greet.cpt"), []byte("# greet <name>\n* say hello to <name>\n"), 0644), IsNil)
+		dictionary, result := CreateConceptsDictionary(false)
+		c.Assert(result.Ok, Equals, true)
+		ParseSpecFiles([]string{specFile}, dictionary)
+		projectParseCache = nil
+		specs, _ = ParseSpecFiles([]string{specFile}, dictionary)
+
+		step := specs[0].Scenarios[0].Steps[0]
+		c.Assert(step.IsConcept, Equals, true)
+		c.Assert(step.Lookup.GetArg("name").Value, Equals, "gauge")
+		c.Assert(step.ConceptSteps[0].Value, Equals, "say hello to {}")
+		c.Assert(step.ConceptSteps[0].Parent, Equals, step)
+		c.Assert(specs[0].FileName, Equals, specFile)
+	})
+}
+
+func (s *MySuite) TestParseCacheReturnsTheParsedConcepts(c *C) {
+	cache := newTestParseCache()
+	text := "# greet <name>\n* say hello to <name>\n a comment\n* wave\n     |hand|\n     |----|\n     |left|\n# leave\n* say bye\n"
+	calls := 0
+	parse := func() ([]*gauge.Step, *ParseDetailResult) {
+		calls++
+		return new(ConceptParser).createConcepts(mustGenerateTokens(c, text))
+	}
+
+	concepts, details := cache.parseConcepts(text, parse)
+	cached, cachedDetails := cache.parseConcepts(text, parse)
+
+	c.Assert(calls, Equals, 1)
+	c.Assert(cachedDetails, DeepEquals, details)
+	c.Assert(len(cached), Equals, 2)
+	c.Assert(cached[0].Value, Equals, concepts[0].Value)
+	c.Assert(cached[0].Lookup.ContainsArg("name"), Equals, true)
+	c.Assert(cached[0].ConceptSteps[1].Args[0].Table.Get("hand")[0].Value, Equals, "left")
+	c.Assert(cached[0].ConceptSteps[0].Parent, IsNil)
+	c.Assert(len(cached[0].Items), Equals, 4)
+	c.Assert(cached[0].Items[0], Equals, cached[0])
+	c.Assert(cached[0].Items[1], Equals, cached[0].ConceptSteps[0])
+	c.Assert(cached[0].Items[2], DeepEquals, concepts[0].Items[2])
+	c.Assert(cached[0].Items[3], Equals, cached[0].ConceptSteps[1])
+	c.Assert(cached[1].ConceptSteps[0].Value, Equals, "say bye")
+}
+
+func mustGenerateTokens(c *C, text string) []*Token {
+	tokens, err := new(SpecParser).GenerateTokens(text)
+	c.Assert(err, IsNil)
+	return tokens
+}
+
+func (s *MySuite) TestParseCacheIsSavedAndLoaded(c *C) {
+	withProjectRoot(c, func(dir string) {
+		calls := 0
+		currentParseCache().parseSpec(cachedSpecText, "concepts", countingParse(cachedSpecText, &calls))
+		saveParseCache()
+
+		cache := loadParseCache(filepath.Join(dir, parseCacheDir, parseCacheFile))
+		spec, result := cache.parseSpec(cachedSpecText, "concepts", func() (*gauge.Specification, *ParseResult) {
+			c.Fatal("spec should be read from the cache")
+			return nil, nil
+		})
+
+		c.Assert(result.Ok, Equals, true)
+		c.Assert(spec.Scenarios[0].Steps[0].Value, Equals, "step with {} and {} {}")
+	})
+}
+
+func (s *MySuite) TestParseCacheWrittenByAnotherVersionIsIgnored(c *C) {
+	withProjectRoot(c, func(dir string) {
+		file := filepath.Join(dir, parseCacheDir, parseCacheFile)
+		c.Assert(os.MkdirAll(filepath.Dir(file), 0755), IsNil)
+		c.Assert(ioutil.WriteFile(file, []byte("not a parse cache"), 0644), IsNil)
+
+		c.Assert(len(loadParseCache(file).entries), Equals, 0)
+	})
+}
+
+func (s *MySuite) TestParseCacheDropsEntriesNotUsedRecently(c *C) {
+	cache := &parseCache{entries: map[string]*parseCacheEntry{
+		"old":    {LastUsed: time.Now().Add(-parseCacheMaxAge - time.Hour)},
+		"recent": {LastUsed: time.Now()},
+	}}
+
+	cache.prune()
+
+	c.Assert(len(cache.entries), Equals, 1)
+	c.Assert(cache.entries["recent"], NotNil)
+}
+
+func (s *MySuite) TestParseCacheIsDisabledWithoutProject(c *C) {
+	oldRoot := config.ProjectRoot
+	config.ProjectRoot = ""
+	defer func() { config.ProjectRoot = oldRoot }()
+
+	c.Assert(currentParseCache(), IsNil)
+}
+
+func (s *MySuite) TestParseCacheCanBeDisabled(c *C) {
+	withProjectRoot(c, func(dir string) {
+		os.Setenv(parseCacheProperty, "false")
+		defer os.Unsetenv(parseCacheProperty)
+
+		c.Assert(currentParseCache(), IsNil)
+	})
+}
+
+func benchmarkParseSpecFilesInNewInvocations(b *testing.B, parseCache bool) {
+	dir, err := ioutil.TempDir("", "gaugeParseCacheBenchmark")
+	if err != nil {
+		b.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	oldRoot := config.ProjectRoot
+	config.ProjectRoot = dir
+	if !parseCache {
+		os.Setenv(parseCacheProperty, "false")
+	}
+	defer func() {
+		config.ProjectRoot = oldRoot
+		projectParseCache = nil
+		os.Unsetenv(parseCacheProperty)
+	}()
+	specFiles := generateSpecFiles(b, dir, 500, 10, 10)
+	dictionary := gauge.NewConceptDictionary()
+	ParseSpecFiles(specFiles, dictionary)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		// Each invocation of gauge loads the parse cache again
+		projectParseCache = nil
+		ParseSpecFiles(specFiles, dictionary)
+	}
+}
+
+func BenchmarkParseSpecFilesWithParseCache(b *testing.B) {
+	benchmarkParseSpecFilesInNewInvocations(b, true)
+}
+
+func BenchmarkParseSpecFilesWithoutParseCache(b *testing.B) {
+	benchmarkParseSpecFilesInNewInvocations(b, false)
+}
@@ -0,0 +1,399 @@
+// Copyright 2015 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+
+package parser
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/getgauge/gauge/gauge"
+	"github.com/getgauge/gauge/gauge_messages"
+	"github.com/golang/protobuf/proto"
+)
+
+// The parse cache keeps specs and concepts as the types below. Parsed specs share their items between lists, link
+// concept steps to their parent and keep lookups and table headers in unexported fields, so they are rebuilt through
+// the same methods the parser uses.
+
+type cachedSpec struct {
+	Heading  *gauge.Heading
+	Items    []*cachedItem
+	Warnings []*Warning
+}
+
+// cachedItem is an item of a spec or a scenario, of the given kind
+type cachedItem struct {
+	Kind      gauge.TokenKind
+	Comment   *gauge.Comment
+	Scenario  *cachedScenario
+	Step      *cachedStep
+	Tags      *gauge.Tags
+	DataTable *cachedTable
+	TearDown  *gauge.TearDown
+}
+
+type cachedScenario struct {
+	Heading *gauge.Heading
+	Items   []*cachedItem
+}
+
+type cachedStep struct {
+	LineNo         int
+	Value          string
+	LineText       string
+	Args           []*cachedArg
+	IsConcept      bool
+	Lookup         []*cachedParam
+	ConceptSteps   []*cachedStep
+	Fragments      [][]byte
+	HasInlineTable bool
+	PreComments    []*gauge.Comment
+}
+
+type cachedArg struct {
+	Name    string
+	Value   string
+	ArgType gauge.ArgType
+	Table   cachedTable
+}
+
+// cachedParam is a param of a lookup. Params are kept sorted by name, since lookups are copied in map order.
+type cachedParam struct {
+	Name string
+	Arg  *cachedArg
+}
+
+type cachedTable struct {
+	Headers     []string
+	Columns     [][]gauge.TableCell
+	LineNo      int
+	Initialized bool
+}
+
+type cachedConcepts struct {
+	Concepts []*cachedConcept
+	Details  *ParseDetailResult
+}
+
+// cachedConcept is a concept with the items of its concept file, i.e. the concept heading, comments and its steps
+type cachedConcept struct {
+	Step  *cachedStep
+	Items []*cachedConceptItem
+}
+
+// cachedConceptItem is the concept heading, a comment or the step at the given index of the concept steps
+type cachedConceptItem struct {
+	Heading bool
+	Comment *gauge.Comment
+	Step    int
+}
+
+// readsOtherFiles tells if the spec uses an external data table or special params, which are read while parsing
+func readsOtherFiles(spec *gauge.Specification) bool {
+	if spec.DataTable.IsExternal {
+		return true
+	}
+	return stepsReadOtherFiles(spec.Contexts) || stepsReadOtherFiles(spec.TearDownSteps) ||
+		scenariosReadOtherFiles(spec.Scenarios)
+}
+
+func scenariosReadOtherFiles(scenarios []*gauge.Scenario) bool {
+	for _, scenario := range scenarios {
+		if stepsReadOtherFiles(scenario.Steps) {
+			return true
+		}
+	}
+	return false
+}
+
+func stepsReadOtherFiles(steps []*gauge.Step) bool {
+	for _, step := range steps {
+		for _, arg := range step.Args {
+			if arg.ArgType == gauge.SpecialString || arg.ArgType == gauge.SpecialTable {
+				return true
+			}
+		}
+		if stepsReadOtherFiles(step.ConceptSteps) {
+			return true
+		}
+	}
+	return false
+}
+
+func newCachedSpec(spec *gauge.Specification, warnings []*Warning) (*cachedSpec, error) {
+	items, err := newCachedItems(spec.Items)
+	if err != nil {
+		return nil, err
+	}
+	return &cachedSpec{Heading: spec.Heading, Items: items, Warnings: warnings}, nil
+}
+
+func (cached *cachedSpec) specification() *gauge.Specification {
+	spec := &gauge.Specification{}
+	spec.AddHeading(cached.Heading)
+	isTearDown := false
+	for _, item := range cached.Items {
+		switch item.Kind {
+		case gauge.CommentKind:
+			spec.AddComment(item.Comment)
+		case gauge.ScenarioKind:
+			spec.AddScenario(item.Scenario.scenario())
+		case gauge.StepKind:
+			if isTearDown {
+				step := item.Step.step(nil)
+				spec.TearDownSteps = append(spec.TearDownSteps, step)
+				spec.AddItem(step)
+			} else {
+				spec.AddContext(item.Step.step(nil))
+			}
+		case gauge.TagKind:
+			spec.AddTags(item.tags())
+		case gauge.DataTableKind:
+			table := item.DataTable.table()
+			spec.AddDataTable(&table)
+		case gauge.TearDownKind:
+			isTearDown = true
+			spec.AddItem(item.TearDown)
+		}
+	}
+	return spec
+}
+
+func newCachedItems(items []gauge.Item) ([]*cachedItem, error) {
+	cachedItems := make([]*cachedItem, len(items))
+	for i, item := range items {
+		cached := &cachedItem{Kind: item.Kind()}
+		switch item := item.(type) {
+		case *gauge.Comment:
+			cached.Comment = item
+		case *gauge.Scenario:
+			scenario, err := newCachedScenario(item)
+			if err != nil {
+				return nil, err
+			}
+			cached.Scenario = scenario
+		case *gauge.Step:
+			step, err := newCachedStep(item)
+			if err != nil {
+				return nil, err
+			}
+			cached.Step = step
+		case *gauge.Tags:
+			cached.Tags = item
+		case *gauge.DataTable:
+			if item.IsExternal {
+				return nil, fmt.Errorf("external data table %s can not be cached", item.Value)
+			}
+			cached.DataTable = newCachedTable(&item.Table)
+		case *gauge.TearDown:
+			cached.TearDown = item
+		default:
+			return nil, fmt.Errorf("item of kind %d can not be cached", item.Kind())
+		}
+		cachedItems[i] = cached
+	}
+	return cachedItems, nil
+}
+
+// tags returns the tags of the item, which are decoded as nil if there are no tag values
+func (item *cachedItem) tags() *gauge.Tags {
+	if item.Tags == nil {
+		return &gauge.Tags{}
+	}
+	return item.Tags
+}
+
+func newCachedScenario(scenario *gauge.Scenario) (*cachedScenario, error) {
+	items, err := newCachedItems(scenario.Items)
+	if err != nil {
+		return nil, err
+	}
+	return &cachedScenario{Heading: scenario.Heading, Items: items}, nil
+}
+
+func (cached *cachedScenario) scenario() *gauge.Scenario {
+	scenario := &gauge.Scenario{}
+	scenario.AddHeading(cached.Heading)
+	for _, item := range cached.Items {
+		switch item.Kind {
+		case gauge.CommentKind:
+			scenario.AddComment(item.Comment)
+		case gauge.StepKind:
+			scenario.AddStep(item.Step.step(nil))
+		case gauge.TagKind:
+			scenario.AddTags(item.tags())
+		}
+	}
+	return scenario
+}
+
+func newCachedStep(step *gauge.Step) (*cachedStep, error) {
+	cached := &cachedStep{
+		LineNo:         step.LineNo,
+		Value:          step.Value,
+		LineText:       step.LineText,
+		IsConcept:      step.IsConcept,
+		HasInlineTable: step.HasInlineTable,
+		PreComments:    step.PreComments,
+	}
+	for _, arg := range step.Args {
+		cached.Args = append(cached.Args, newCachedArg(arg))
+	}
+	names := make([]string, 0, len(step.Lookup.ParamIndexMap))
+	for name := range step.Lookup.ParamIndexMap {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		param := &cachedParam{Name: name}
+		if arg := step.Lookup.GetArg(name); arg != nil {
+			param.Arg = newCachedArg(arg)
+		}
+		cached.Lookup = append(cached.Lookup, param)
+	}
+	for _, conceptStep := range step.ConceptSteps {
+		cachedConceptStep, err := newCachedStep(conceptStep)
+		if err != nil {
+			return nil, err
+		}
+		cached.ConceptSteps = append(cached.ConceptSteps, cachedConceptStep)
+	}
+	// Fragments are kept as protobuf, which unlike gob keeps empty texts and values
+	for _, fragment := range step.Fragments {
+		data, err := proto.Marshal(fragment)
+		if err != nil {
+			return nil, err
+		}
+		cached.Fragments = append(cached.Fragments, data)
+	}
+	return cached, nil
+}
+
+// step returns the step with the given parent, the concept steps are given the step as their parent
+func (cached *cachedStep) step(parent *gauge.Step) *gauge.Step {
+	step := &gauge.Step{
+		LineNo:         cached.LineNo,
+		Value:          cached.Value,
+		LineText:       cached.LineText,
+		IsConcept:      cached.IsConcept,
+		Parent:         parent,
+		HasInlineTable: cached.HasInlineTable,
+		PreComments:    cached.PreComments,
+	}
+	for _, arg := range cached.Args {
+		step.Args = append(step.Args, arg.stepArg())
+	}
+	for _, param := range cached.Lookup {
+		step.Lookup.AddArgName(param.Name)
+		if param.Arg != nil {
+			step.Lookup.AddArgValue(param.Name, param.Arg.stepArg())
+		}
+	}
+	for _, conceptStep := range cached.ConceptSteps {
+		step.ConceptSteps = append(step.ConceptSteps, conceptStep.step(step))
+	}
+	for _, data := range cached.Fragments {
+		fragment := &gauge_messages.Fragment{}
+		// Fragments were marshalled by newCachedStep from valid fragments
+		proto.Unmarshal(data, fragment)
+		step.Fragments = append(step.Fragments, fragment)
+	}
+	return step
+}
+
+func newCachedArg(arg *gauge.StepArg) *cachedArg {
+	return &cachedArg{Name: arg.Name, Value: arg.Value, ArgType: arg.ArgType, Table: *newCachedTable(&arg.Table)}
+}
+
+func (cached *cachedArg) stepArg() *gauge.StepArg {
+	return &gauge.StepArg{Name: cached.Name, Value: cached.Value, ArgType: cached.ArgType, Table: cached.Table.table()}
+}
+
+func newCachedTable(table *gauge.Table) *cachedTable {
+	return &cachedTable{Headers: table.Headers, Columns: table.Columns, LineNo: table.LineNo, Initialized: table.IsInitialized()}
+}
+
+func (cached *cachedTable) table() gauge.Table {
+	table := gauge.Table{}
+	if cached.Initialized {
+		table.AddHeaders(cached.Headers)
+	}
+	table.Headers = cached.Headers
+	table.Columns = cached.Columns
+	table.LineNo = cached.LineNo
+	return table
+}
+
+func newCachedConcepts(concepts []*gauge.Step, details *ParseDetailResult) (*cachedConcepts, error) {
+	cached := &cachedConcepts{Details: details}
+	for _, concept := range concepts {
+		step, err := newCachedStep(concept)
+		if err != nil {
+			return nil, err
+		}
+		cachedConcept := &cachedConcept{Step: step}
+		for _, item := range concept.Items {
+			conceptItem, err := newCachedConceptItem(concept, item)
+			if err != nil {
+				return nil, err
+			}
+			cachedConcept.Items = append(cachedConcept.Items, conceptItem)
+		}
+		cached.Concepts = append(cached.Concepts, cachedConcept)
+	}
+	return cached, nil
+}
+
+func newCachedConceptItem(concept *gauge.Step, item gauge.Item) (*cachedConceptItem, error) {
+	switch item := item.(type) {
+	case *gauge.Comment:
+		return &cachedConceptItem{Comment: item}, nil
+	case *gauge.Step:
+		if item == concept {
+			return &cachedConceptItem{Heading: true}, nil
+		}
+		for i, conceptStep := range concept.ConceptSteps {
+			if item == conceptStep {
+				return &cachedConceptItem{Step: i}, nil
+			}
+		}
+	}
+	return nil, fmt.Errorf("item of kind %d of concept %s can not be cached", item.Kind(), concept.Value)
+}
+
+func (cached *cachedConcepts) concepts() []*gauge.Step {
+	concepts := make([]*gauge.Step, 0)
+	for _, cachedConcept := range cached.Concepts {
+		concept := cachedConcept.Step.step(nil)
+		// Steps of parsed concepts are only linked to their concept when the dictionary is created
+		for _, conceptStep := range concept.ConceptSteps {
+			conceptStep.Parent = nil
+		}
+		for _, item := range cachedConcept.Items {
+			if item.Heading {
+				concept.Items = append(concept.Items, concept)
+			} else if item.Comment != nil {
+				concept.Items = append(concept.Items, item.Comment)
+			} else {
+				concept.Items = append(concept.Items, concept.ConceptSteps[item.Step])
+			}
+		}
+		concepts = append(concepts, concept)
+	}
+	return concepts
+}
@@ -36,14 +36,16 @@ type ConceptParser struct {
 
 //concept file can have multiple concept headings
 func (parser *ConceptParser) Parse(text string) ([]*gauge.Step, *ParseDetailResult) {
-	defer parser.resetState()
+	return currentParseCache().parseConcepts(text, func() ([]*gauge.Step, *ParseDetailResult) {
+		defer parser.resetState()
 
-	specParser := new(SpecParser)
-	tokens, err := specParser.GenerateTokens(text)
-	if err != nil {
-		return nil, &ParseDetailResult{Error: err}
-	}
-	return parser.createConcepts(tokens)
+		specParser := new(SpecParser)
+		tokens, err := specParser.GenerateTokens(text)
+		if err != nil {
+			return nil, &ParseDetailResult{Error: err}
+		}
+		return parser.createConcepts(tokens)
+	})
 }
 
 func (parser *ConceptParser) ParseFile(file string) ([]*gauge.Step, *ParseDetailResult) {
@@ -94,6 +96,10 @@ func (parser *ConceptParser) createConcepts(tokens []*Token) ([]*gauge.Step, *Pa
 			parser.processTableHeader(token)
 			addStates(&parser.currentState, tableScope)
 		} else if parser.isTableDataRow(token) {
+			if areUnderlined(token.Args) {
+				// skip table separator
+				continue
+			}
 			parser.processTableDataRow(token, &parser.currentConcept.Lookup)
 		} else {
 			comment := &gauge.Comment{Value: token.Value, LineNo: token.LineNo}
@@ -199,8 +205,14 @@ func (parser *ConceptParser) createConceptLookup(concept *gauge.Step) {
 func CreateConceptsDictionary(shouldIgnoreErrors bool) (*gauge.ConceptDictionary, *ParseResult) {
 	conceptFiles := util.FindConceptFilesIn(filepath.Join(config.ProjectRoot, common.SpecsDirectoryName))
 	conceptsDictionary := gauge.NewConceptDictionary()
-	for _, conceptFile := range conceptFiles {
-		if err := AddConcepts(conceptFile, conceptsDictionary); err != nil {
+	concepts := make([][]*gauge.Step, len(conceptFiles))
+	parseResults := make([]*ParseDetailResult, len(conceptFiles))
+	forEachFile(len(conceptFiles), func(i int) {
+		concepts[i], parseResults[i] = new(ConceptParser).ParseFile(conceptFiles[i])
+	})
+	// Concepts are added in the order of the files, so that the same duplicate or circular concept is reported each time
+	for i, conceptFile := range conceptFiles {
+		if err := addConceptsToDictionary(concepts[i], parseResults[i], conceptFile, conceptsDictionary); err != nil {
 			if shouldIgnoreErrors {
 				logger.APILog.Error("Concept parse failure: %s %s", conceptFile, err)
 				continue
@@ -209,11 +221,23 @@ func CreateConceptsDictionary(shouldIgnoreErrors bool) (*gauge.ConceptDictionary
 			return nil, &ParseResult{ParseError: err, FileName: conceptFile}
 		}
 	}
+	saveParseCache()
 	return conceptsDictionary, &ParseResult{Ok: true}
 }
 
 func AddConcepts(conceptFile string, conceptDictionary *gauge.ConceptDictionary) *ParseError {
 	concepts, parseResults := new(ConceptParser).ParseFile(conceptFile)
+	return addConceptsToDictionary(concepts, parseResults, conceptFile, conceptDictionary)
+}
+
+// AddConceptsFromText parses the given concept file content and adds its concepts to the dictionary
+// under conceptFile, without reading the file from disk.
+func AddConceptsFromText(text string, conceptFile string, conceptDictionary *gauge.ConceptDictionary) *ParseError {
+	concepts, parseResults := new(ConceptParser).Parse(text)
+	return addConceptsToDictionary(concepts, parseResults, conceptFile, conceptDictionary)
+}
+
+func addConceptsToDictionary(concepts []*gauge.Step, parseResults *ParseDetailResult, conceptFile string, conceptDictionary *gauge.ConceptDictionary) *ParseError {
 	if parseResults != nil && parseResults.Warnings != nil {
 		for _, warning := range parseResults.Warnings {
 			logger.Warning(warning.String())
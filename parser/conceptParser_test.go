@@ -336,6 +336,18 @@ func (s *MySuite) TestParsingConceptStepWithInlineTable(c *C) {
 	c.Assert(inlineTable.Get("name")[1].CellType, Equals, gauge.Static)
 }
 
+func (s *MySuite) TestParsingConceptStepWithInlineTableSkipsSeparator(c *C) {
+	parser := new(ConceptParser)
+	concepts, parseRes := parser.Parse("# my concept <foo> \n * first step with <foo> and inline table\n |id|name|\n|--|----|\n|1|<foo>|\n")
+
+	c.Assert(parseRes.Error, IsNil)
+	inlineTable := concepts[0].ConceptSteps[0].Args[1].Table
+	c.Assert(inlineTable.GetRowCount(), Equals, 1)
+	c.Assert(inlineTable.Get("id")[0].Value, Equals, "1")
+	c.Assert(inlineTable.Get("name")[0].Value, Equals, "foo")
+	c.Assert(inlineTable.Get("name")[0].CellType, Equals, gauge.Dynamic)
+}
+
 func (s *MySuite) TestErrorParsingConceptWithInvalidInlineTable(c *C) {
 	parser := new(ConceptParser)
 	_, parseRes := parser.Parse("# my concept \n |id|name|\n|1|vishnu|\n|2|prateek|\n")
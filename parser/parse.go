@@ -27,42 +27,40 @@ import (
 	"github.com/getgauge/gauge/util"
 )
 
+// ParseSpecFiles parses the spec files on a bounded pool of workers. The specs and parse results are in the order of
+// the spec files, leaving out the specs which failed to parse.
 func ParseSpecFiles(specFiles []string, conceptDictionary *gauge.ConceptDictionary) ([]*gauge.Specification, []*ParseResult) {
-	parseResultsChan := make(chan *ParseResult, len(specFiles))
-	specsChan := make(chan *gauge.Specification, len(specFiles))
-	parseResults := make([]*ParseResult, 0)
+	parsedSpecs := make([]*gauge.Specification, len(specFiles))
+	parseResults := make([]*ParseResult, len(specFiles))
+	cache := currentParseCache()
+	conceptsHash := cache.conceptsHash(conceptDictionary)
+	forEachFile(len(specFiles), func(i int) {
+		parsedSpecs[i], parseResults[i] = parseSpec(specFiles[i], conceptDictionary, cache, conceptsHash)
+	})
 	specs := make([]*gauge.Specification, 0)
-
-	for _, specFile := range specFiles {
-		go parseSpec(specFile, conceptDictionary, specsChan, parseResultsChan)
-	}
-	for _, _ = range specFiles {
-		parseResults = append(parseResults, <-parseResultsChan)
-		spec := <-specsChan
+	for _, spec := range parsedSpecs {
 		if spec != nil {
 			specs = append(specs, spec)
 		}
 	}
+	cache.save()
 	return specs, parseResults
 }
 
-func parseSpec(specFile string, conceptDictionary *gauge.ConceptDictionary, specChannel chan *gauge.Specification, parseResultChan chan *ParseResult) {
+func parseSpec(specFile string, conceptDictionary *gauge.ConceptDictionary, cache *parseCache, conceptsHash string) (*gauge.Specification, *ParseResult) {
 	specFileContent, err := common.ReadFileContents(specFile)
 	if err != nil {
-		specChannel <- nil
-		parseResultChan <- &ParseResult{ParseError: &ParseError{Message: err.Error()}, Ok: false, FileName: specFile}
-		return
+		return nil, &ParseResult{ParseError: &ParseError{Message: err.Error()}, Ok: false, FileName: specFile}
 	}
-	spec, parseResult := new(SpecParser).Parse(specFileContent, conceptDictionary)
+	spec, parseResult := cache.parseSpec(specFileContent, conceptsHash, func() (*gauge.Specification, *ParseResult) {
+		return new(SpecParser).Parse(specFileContent, conceptDictionary)
+	})
 	parseResult.FileName = specFile
 	if !parseResult.Ok {
-		specChannel <- nil
-		parseResultChan <- parseResult
-		return
+		return nil, parseResult
 	}
 	spec.FileName = specFile
-	specChannel <- spec
-	parseResultChan <- parseResult
+	return spec, parseResult
 }
 
 func FindSpecs(specSource string, conceptDictionary *gauge.ConceptDictionary) ([]*gauge.Specification, []*ParseResult) {
@@ -1034,6 +1034,23 @@ func (s *MySuite) TestAddSpecTagsAndScenarioTags(c *C) {
 	c.Assert(tags.Values[1], Equals, "tag4")
 }
 
+func (s *MySuite) TestTagsOverSeveralTagLinesAreMerged(c *C) {
+	tokens := []*Token{
+		&Token{Kind: gauge.SpecKind, Value: "Spec Heading", LineNo: 1},
+		&Token{Kind: gauge.TagKind, Args: []string{"tag1", "tag2"}, LineNo: 2},
+		&Token{Kind: gauge.TagKind, Args: []string{"tag3"}, LineNo: 3},
+		&Token{Kind: gauge.ScenarioKind, Value: "Scenario Heading", LineNo: 4},
+		&Token{Kind: gauge.TagKind, Args: []string{"tag4"}, LineNo: 5},
+		&Token{Kind: gauge.TagKind, Args: []string{"tag5", "tag6"}, LineNo: 6},
+	}
+
+	spec, result := new(SpecParser).CreateSpecification(tokens, gauge.NewConceptDictionary())
+
+	c.Assert(result.Ok, Equals, true)
+	c.Assert(spec.Tags.Values, DeepEquals, []string{"tag1", "tag2", "tag3"})
+	c.Assert(spec.Scenarios[0].Tags.Values, DeepEquals, []string{"tag4", "tag5", "tag6"})
+}
+
 func (s *MySuite) TestErrorOnAddingDynamicParamterWithoutADataTable(c *C) {
 	tokens := []*Token{
 		&Token{Kind: gauge.SpecKind, Value: "Spec Heading", LineNo: 1},
@@ -0,0 +1,56 @@
+// Copyright 2015 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+
+package parser
+
+import (
+	"sync"
+
+	"github.com/getgauge/gauge/util"
+)
+
+// MaxParseWorkers is the number of spec or concept files parsed at the same time
+var MaxParseWorkers = util.NumberOfCores()
+
+// forEachFile calls parse with the index of each of the count files on at most MaxParseWorkers goroutines, and
+// returns once all of them are parsed. parse should store its result at the index, which keeps the results in the
+// order of the files whichever worker finished first.
+func forEachFile(count int, parse func(index int)) {
+	workers := MaxParseWorkers
+	if workers > count {
+		workers = count
+	}
+	if workers < 1 {
+		workers = 1
+	}
+	indices := make(chan int)
+	var wg sync.WaitGroup
+	wg.Add(workers)
+	for i := 0; i < workers; i++ {
+		go func() {
+			defer wg.Done()
+			for index := range indices {
+				parse(index)
+			}
+		}()
+	}
+	for index := 0; index < count; index++ {
+		indices <- index
+	}
+	close(indices)
+	wg.Wait()
+}
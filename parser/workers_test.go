@@ -0,0 +1,195 @@
+// Copyright 2015 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+
+package parser
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/getgauge/common"
+	"github.com/getgauge/gauge/config"
+	"github.com/getgauge/gauge/gauge"
+	"github.com/getgauge/gauge/util"
+	. "gopkg.in/check.v1"
+)
+
+func (s *MySuite) TestForEachFileParsesEachFileOnce(c *C) {
+	var mutex sync.Mutex
+	parsed := make(map[int]int)
+
+	forEachFile(100, func(i int) {
+		mutex.Lock()
+		defer mutex.Unlock()
+		parsed[i]++
+	})
+
+	c.Assert(len(parsed), Equals, 100)
+	for i := 0; i < 100; i++ {
+		c.Assert(parsed[i], Equals, 1)
+	}
+}
+
+func (s *MySuite) TestForEachFileWithoutFiles(c *C) {
+	forEachFile(0, func(i int) {
+		c.Fatal("no file should be parsed")
+	})
+}
+
+func (s *MySuite) TestParseSpecFilesKeepsTheOrderOfFiles(c *C) {
+	dir, err := ioutil.TempDir("", "gaugeParse")
+	c.Assert(err, IsNil)
+	defer os.RemoveAll(dir)
+	specFiles := generateSpecFiles(c, dir, 20, 2, 3)
+	invalidSpec := filepath.Join(dir, "invalid.spec")
+	c.Assert(ioutil.WriteFile(invalidSpec, []byte("* step before the spec heading"), 0644), IsNil)
+	specFiles = append(specFiles[:10], append([]string{invalidSpec}, specFiles[10:]...)...)
+
+	specs, parseResults := ParseSpecFiles(specFiles, gauge.NewConceptDictionary())
+
+	c.Assert(len(parseResults), Equals, 21)
+	for i, result := range parseResults {
+		c.Assert(result.FileName, Equals, specFiles[i])
+		c.Assert(result.Ok, Equals, specFiles[i] != invalidSpec)
+	}
+	c.Assert(len(specs), Equals, 20)
+	for i, spec := range specs {
+		expected := i
+		if i >= 10 {
+			expected = i + 1
+		}
+		c.Assert(spec.FileName, Equals, specFiles[expected])
+		c.Assert(len(spec.Scenarios), Equals, 2)
+	}
+}
+
+func (s *MySuite) TestCreateConceptsDictionaryFromManyFiles(c *C) {
+	withProjectRoot(c, func(dir string) {
+		os.Setenv(parseCacheProperty, "false")
+		defer os.Unsetenv(parseCacheProperty)
+		generateConceptFiles(c, filepath.Join(dir, common.SpecsDirectoryName), 20, 5)
+
+		dictionary, result := CreateConceptsDictionary(false)
+
+		c.Assert(result.Ok, Equals, true)
+		c.Assert(len(dictionary.ConceptsMap), Equals, 100)
+		concept := dictionary.Search("concept 4 of file 3 with {}")
+		c.Assert(concept, NotNil)
+		c.Assert(concept.FileName, Equals, filepath.Join(dir, common.SpecsDirectoryName, "concepts3.cpt"))
+	})
+}
+
+func generateSpecFiles(c fatalReporter, dir string, files, scenarios, steps int) []string {
+	specFiles := make([]string, 0, files)
+	for i := 0; i < files; i++ {
+		var spec bytes.Buffer
+		fmt.Fprintf(&spec, "Spec %d\n=======\n\ntags: generated, spec%d\n\n", i, i)
+		for j := 0; j < scenarios; j++ {
+			fmt.Fprintf(&spec, "Scenario %d of spec %d\n---------------------\n", j, i)
+			for k := 0; k < steps; k++ {
+				fmt.Fprintf(&spec, "* step %d with \"arg %d\"\n", k, j)
+			}
+			spec.WriteString("\n")
+		}
+		file := filepath.Join(dir, fmt.Sprintf("spec%d.spec", i))
+		if err := ioutil.WriteFile(file, spec.Bytes(), 0644); err != nil {
+			c.Fatal(err)
+		}
+		specFiles = append(specFiles, file)
+	}
+	return specFiles
+}
+
+func generateConceptFiles(c fatalReporter, dir string, files, concepts int) {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		c.Fatal(err)
+	}
+	for i := 0; i < files; i++ {
+		var conceptFile bytes.Buffer
+		for j := 0; j < concepts; j++ {
+			fmt.Fprintf(&conceptFile, "# concept %d of file %d with <param>\n* step %d with <param>\n* another step\n\n", j, i, j)
+		}
+		if err := ioutil.WriteFile(filepath.Join(dir, fmt.Sprintf("concepts%d.cpt", i)), conceptFile.Bytes(), 0644); err != nil {
+			c.Fatal(err)
+		}
+	}
+}
+
+// fatalReporter is the part of gocheck and testing failures needed to generate the corpus
+type fatalReporter interface {
+	Fatal(args ...interface{})
+}
+
+func benchmarkParseSpecFiles(b *testing.B, workers int) {
+	dir, err := ioutil.TempDir("", "gaugeParseBenchmark")
+	if err != nil {
+		b.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	oldRoot, oldWorkers := config.ProjectRoot, MaxParseWorkers
+	config.ProjectRoot, MaxParseWorkers = "", workers
+	defer func() { config.ProjectRoot, MaxParseWorkers = oldRoot, oldWorkers }()
+	specFiles := generateSpecFiles(b, dir, 500, 10, 10)
+	dictionary := gauge.NewConceptDictionary()
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		ParseSpecFiles(specFiles, dictionary)
+	}
+}
+
+func BenchmarkParseSpecFilesOneWorker(b *testing.B) {
+	benchmarkParseSpecFiles(b, 1)
+}
+
+func BenchmarkParseSpecFilesAllCores(b *testing.B) {
+	benchmarkParseSpecFiles(b, util.NumberOfCores())
+}
+
+func benchmarkCreateConceptsDictionary(b *testing.B, workers int) {
+	dir, err := ioutil.TempDir("", "gaugeConceptBenchmark")
+	if err != nil {
+		b.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	oldRoot, oldWorkers := config.ProjectRoot, MaxParseWorkers
+	config.ProjectRoot, MaxParseWorkers = dir, workers
+	os.Setenv(parseCacheProperty, "false")
+	defer func() {
+		config.ProjectRoot, MaxParseWorkers = oldRoot, oldWorkers
+		os.Unsetenv(parseCacheProperty)
+	}()
+	generateConceptFiles(b, filepath.Join(dir, common.SpecsDirectoryName), 200, 20)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		CreateConceptsDictionary(false)
+	}
+}
+
+func BenchmarkCreateConceptsDictionaryOneWorker(b *testing.B) {
+	benchmarkCreateConceptsDictionary(b, 1)
+}
+
+func BenchmarkCreateConceptsDictionaryAllCores(b *testing.B) {
+	benchmarkCreateConceptsDictionary(b, util.NumberOfCores())
+}
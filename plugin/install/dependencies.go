@@ -0,0 +1,267 @@
+// Copyright 2015 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+
+package install
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/getgauge/gauge/logger"
+	"github.com/getgauge/gauge/plugin"
+	"github.com/getgauge/gauge/version"
+)
+
+// plannedInstall is a plugin version which has to be present for the requested plugin to work.
+type plannedInstall struct {
+	name             string
+	version          string
+	requiredBy       string
+	constraint       *version.Constraint
+	alreadyInstalled bool
+	installDesc      *installDescription
+	versionDesc      *versionInstallDescription
+}
+
+func (p *plannedInstall) String() string {
+	text := fmt.Sprintf("%s %s", p.name, p.version)
+	if p.alreadyInstalled {
+		text += " (already installed)"
+	}
+	if p.requiredBy != "" {
+		text += fmt.Sprintf(", required by %s", p.requiredBy)
+	}
+	return text
+}
+
+// dependent is an installed plugin which depends on another plugin.
+type dependent struct {
+	name       string
+	version    string
+	constraint *version.Constraint
+}
+
+type dependencyResolver struct {
+	rootName              string
+	plan                  []*plannedInstall
+	installedDependents   map[string][]dependent
+	getInstallDescription func(pluginName string) (*installDescription, InstallResult)
+	installedVersion      func(pluginName string, constraint *version.Constraint) (string, error)
+}
+
+func newDependencyResolver() (*dependencyResolver, error) {
+	installedDependents, err := getInstalledDependents()
+	if err != nil {
+		return nil, err
+	}
+	return &dependencyResolver{installedDependents: installedDependents, getInstallDescription: getInstallDescription, installedVersion: plugin.GetLatestInstalledVersion}, nil
+}
+
+// getInstalledDependents returns the dependencies declared by the latest installed version of each plugin, keyed by
+// the plugin depended upon.
+func getInstalledDependents() (map[string][]dependent, error) {
+	installedDependents := make(map[string][]dependent)
+	installedPlugins, err := plugin.GetAllInstalledPluginsWithVersion()
+	if os.IsNotExist(err) {
+		return installedDependents, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("Failed to find installed plugins. %s", err.Error())
+	}
+	for _, installedPlugin := range installedPlugins {
+		gp, err := parsePluginJSON(installedPlugin.Path, installedPlugin.Name)
+		if err != nil {
+			return nil, fmt.Errorf("Failed to read the dependencies of installed plugin %s. %s", installedPlugin.Name, err.Error())
+		}
+		for name, constraintText := range gp.Dependencies {
+			constraint, err := version.ParseConstraint(constraintText)
+			if err != nil {
+				return nil, fmt.Errorf("Invalid dependency %s %s of installed plugin %s. %s", name, constraintText, installedPlugin.Name, err.Error())
+			}
+			installedDependents[name] = append(installedDependents[name], dependent{name: installedPlugin.Name, version: gp.Version, constraint: constraint})
+		}
+	}
+	return installedDependents, nil
+}
+
+// resolve returns the plugins to install, dependencies before the plugins depending on them and the requested plugin last.
+func (r *dependencyResolver) resolve(installDesc *installDescription, versionDesc *versionInstallDescription) ([]*plannedInstall, error) {
+	root := &plannedInstall{name: installDesc.Name, version: versionDesc.Version, installDesc: installDesc, versionDesc: versionDesc}
+	r.rootName = root.name
+	if err := r.checkInstalledDependents(root); err != nil {
+		return nil, err
+	}
+	// The root is reserved like any other dependency, so that plugins depending back on it are checked against it.
+	r.plan = append(r.plan, root)
+	if err := r.resolveDependenciesOf(root); err != nil {
+		return nil, err
+	}
+	r.plan = append(r.plan[1:], root)
+	return r.plan, nil
+}
+
+func (r *dependencyResolver) resolveDependenciesOf(p *plannedInstall) error {
+	var names []string
+	for name := range p.versionDesc.Dependencies {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		constraint, err := version.ParseConstraint(p.versionDesc.Dependencies[name])
+		if err != nil {
+			return fmt.Errorf("Invalid dependency %s %s of plugin %s. %s", name, p.versionDesc.Dependencies[name], p.name, err.Error())
+		}
+		if err := r.resolveDependency(name, constraint, p); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *dependencyResolver) resolveDependency(name string, constraint *version.Constraint, requiredBy *plannedInstall) error {
+	if name == requiredBy.name {
+		return fmt.Errorf("Plugin %s cannot depend on itself", name)
+	}
+	if planned := r.find(name); planned != nil {
+		v, err := version.ParseVersion(planned.version)
+		if err != nil || !constraint.IsSatisfiedBy(v) {
+			return fmt.Errorf("Conflicting dependencies: %s requires %s %s, but %s", requiredBy.name, name, constraint, planned.requirement())
+		}
+		return nil
+	}
+	if installedVersion, err := r.installedVersion(name, constraint); err == nil {
+		r.plan = append(r.plan, &plannedInstall{name: name, version: installedVersion, requiredBy: requiredBy.name, constraint: constraint, alreadyInstalled: true})
+		return nil
+	}
+	installDesc, result := r.getInstallDescription(name)
+	if !result.Success {
+		return fmt.Errorf("Could not resolve dependency %s of plugin %s. %s", name, requiredBy.name, result.getMessage())
+	}
+	versionDesc, err := installDesc.getLatestCompatibleVersionSatisfying(version.CurrentGaugeVersion, constraint)
+	if err != nil {
+		return fmt.Errorf("Could not resolve dependency %s of plugin %s. %s", name, requiredBy.name, err.Error())
+	}
+	p := &plannedInstall{name: name, version: versionDesc.Version, requiredBy: requiredBy.name, constraint: constraint, installDesc: installDesc, versionDesc: versionDesc}
+	if err := r.checkInstalledDependents(p); err != nil {
+		return err
+	}
+	// Reserve the plugin before resolving its own dependencies so that cycles end at the planned version.
+	r.plan = append(r.plan, p)
+	index := len(r.plan) - 1
+	if err := r.resolveDependenciesOf(p); err != nil {
+		return err
+	}
+	r.plan = append(append(r.plan[:index], r.plan[index+1:]...), p)
+	return nil
+}
+
+// checkInstalledDependents fails if an installed plugin depends on a version of the plugin which the planned one
+// would replace as the latest installed version. Plugins which are being installed themselves are not checked.
+func (r *dependencyResolver) checkInstalledDependents(p *plannedInstall) error {
+	v, err := version.ParseVersion(p.version)
+	if err != nil {
+		return nil
+	}
+	for _, d := range r.installedDependents[p.name] {
+		if r.isBeingInstalled(d.name) {
+			continue
+		}
+		if !d.constraint.IsSatisfiedBy(v) {
+			return fmt.Errorf("Installing %s %s conflicts with installed plugin %s %s, which requires %s %s", p.name, p.version, d.name, d.version, p.name, d.constraint)
+		}
+	}
+	return nil
+}
+
+func (r *dependencyResolver) isBeingInstalled(name string) bool {
+	if name == r.rootName {
+		return true
+	}
+	planned := r.find(name)
+	return planned != nil && !planned.alreadyInstalled
+}
+
+func (r *dependencyResolver) find(name string) *plannedInstall {
+	for _, p := range r.plan {
+		if p.name == name {
+			return p
+		}
+	}
+	return nil
+}
+
+func (p *plannedInstall) requirement() string {
+	if p.requiredBy == "" {
+		return fmt.Sprintf("%s %s is being installed", p.name, p.version)
+	}
+	return fmt.Sprintf("%s requires %s %s", p.requiredBy, p.name, p.constraint)
+}
+
+func printInstallPlan(plan []*plannedInstall) {
+	var lines []string
+	for _, p := range plan {
+		lines = append(lines, "  "+p.String())
+	}
+	logger.Info("The following plugins are required:\n%s", strings.Join(lines, "\n"))
+}
+
+// installWithDependencies installs the plugin version after the plugins it depends on.
+func installWithDependencies(installDesc *installDescription, versionDesc *versionInstallDescription) InstallResult {
+	if len(versionDesc.Dependencies) == 0 {
+		return installPluginVersion(installDesc, versionDesc)
+	}
+	resolver, err := newDependencyResolver()
+	if err != nil {
+		return installError(err)
+	}
+	plan, err := resolver.resolve(installDesc, versionDesc)
+	if err != nil {
+		return installError(err)
+	}
+	printInstallPlan(plan)
+	for _, p := range plan[:len(plan)-1] {
+		if p.alreadyInstalled {
+			continue
+		}
+		if result := installPluginVersion(p.installDesc, p.versionDesc); !result.Success && !result.Skipped {
+			return installError(fmt.Errorf("Failed to install dependency %s %s. %s", p.name, p.version, result.getMessage()))
+		}
+	}
+	return installPluginVersion(installDesc, versionDesc)
+}
+
+// checkDependenciesInstalled returns a warning naming the dependencies of the plugin which are not installed.
+func checkDependenciesInstalled(gp *GaugePlugin) string {
+	var missing []string
+	for name, constraintText := range gp.Dependencies {
+		constraint, err := version.ParseConstraint(constraintText)
+		if err != nil {
+			missing = append(missing, fmt.Sprintf("%s %s (invalid version)", name, constraintText))
+			continue
+		}
+		if _, err := plugin.GetLatestInstalledVersion(name, constraint); err != nil {
+			missing = append(missing, fmt.Sprintf("%s %s", name, constraintText))
+		}
+	}
+	if len(missing) == 0 {
+		return ""
+	}
+	sort.Strings(missing)
+	return fmt.Sprintf("Plugin %s requires %s, which is not installed.", gp.ID, strings.Join(missing, ", "))
+}
@@ -0,0 +1,148 @@
+// Copyright 2015 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+
+package install
+
+import (
+	"errors"
+
+	"github.com/getgauge/gauge/version"
+	. "gopkg.in/check.v1"
+)
+
+func newTestResolver(installed map[string]string, descriptions ...*installDescription) *dependencyResolver {
+	return &dependencyResolver{
+		installedDependents: make(map[string][]dependent),
+		getInstallDescription: func(pluginName string) (*installDescription, InstallResult) {
+			for _, d := range descriptions {
+				if d.Name == pluginName {
+					return d, installSuccess("")
+				}
+			}
+			return nil, installError(errors.New("Plugin not found"))
+		},
+		installedVersion: func(pluginName string, constraint *version.Constraint) (string, error) {
+			installedVersion, ok := installed[pluginName]
+			if !ok {
+				return "", errors.New("Plugin not installed")
+			}
+			v, _ := version.ParseVersion(installedVersion)
+			if !constraint.IsSatisfiedBy(v) {
+				return "", errors.New("No version satisfying constraint")
+			}
+			return installedVersion, nil
+		},
+	}
+}
+
+func describePlugin(name string, versions ...versionInstallDescription) *installDescription {
+	for i := range versions {
+		versions[i].GaugeVersionSupport = version.VersionSupport{Minimum: "0.0.1"}
+	}
+	return &installDescription{Name: name, Versions: versions}
+}
+
+func planOf(plan []*plannedInstall) []string {
+	var names []string
+	for _, p := range plan {
+		names = append(names, p.String())
+	}
+	return names
+}
+
+func (s *MySuite) TestResolvingTransitiveDependencies(c *C) {
+	html := describePlugin("html-report", versionInstallDescription{Version: "2.1.0", Dependencies: map[string]string{"screenshot": "^1.0", "java": ">=0.3.4"}})
+	screenshot := describePlugin("screenshot",
+		versionInstallDescription{Version: "1.2.0", Dependencies: map[string]string{"java": ">=0.3"}},
+		versionInstallDescription{Version: "2.0.0"})
+	resolver := newTestResolver(map[string]string{"java": "0.4.0"}, html, screenshot)
+
+	plan, err := resolver.resolve(html, &html.Versions[0])
+
+	c.Assert(err, Equals, nil)
+	c.Assert(planOf(plan), DeepEquals, []string{
+		"java 0.4.0 (already installed), required by html-report",
+		"screenshot 1.2.0, required by html-report",
+		"html-report 2.1.0",
+	})
+}
+
+func (s *MySuite) TestResolvingDependenciesInstallsDependenciesOfDependenciesFirst(c *C) {
+	html := describePlugin("html-report", versionInstallDescription{Version: "2.1.0", Dependencies: map[string]string{"a": "*"}})
+	a := describePlugin("a", versionInstallDescription{Version: "1.0.0", Dependencies: map[string]string{"b": "*"}})
+	b := describePlugin("b", versionInstallDescription{Version: "1.0.0"})
+	resolver := newTestResolver(map[string]string{}, html, a, b)
+
+	plan, err := resolver.resolve(html, &html.Versions[0])
+
+	c.Assert(err, Equals, nil)
+	c.Assert(planOf(plan), DeepEquals, []string{"b 1.0.0, required by a", "a 1.0.0, required by html-report", "html-report 2.1.0"})
+}
+
+func (s *MySuite) TestResolvingConflictingDependencies(c *C) {
+	html := describePlugin("html-report", versionInstallDescription{Version: "2.1.0", Dependencies: map[string]string{"a": "*", "java": "^0.4"}})
+	a := describePlugin("a", versionInstallDescription{Version: "1.0.0", Dependencies: map[string]string{"java": "^0.3"}})
+	java := describePlugin("java", versionInstallDescription{Version: "0.4.1"}, versionInstallDescription{Version: "0.3.9"})
+	resolver := newTestResolver(map[string]string{}, html, a, java)
+
+	_, err := resolver.resolve(html, &html.Versions[0])
+
+	c.Assert(err.Error(), Equals, "Conflicting dependencies: html-report requires java ^0.4, but a requires java ^0.3")
+}
+
+func (s *MySuite) TestResolvingDependencyConflictingWithInstalledPlugin(c *C) {
+	html := describePlugin("html-report", versionInstallDescription{Version: "2.1.0", Dependencies: map[string]string{"java": ">=0.5"}})
+	java := describePlugin("java", versionInstallDescription{Version: "0.5.0"})
+	resolver := newTestResolver(map[string]string{"java": "0.4.0"}, html, java)
+	lowerThanHalf, _ := version.ParseConstraint("<0.5")
+	resolver.installedDependents["java"] = []dependent{{name: "xml-report", version: "1.0.0", constraint: lowerThanHalf}}
+
+	_, err := resolver.resolve(html, &html.Versions[0])
+
+	c.Assert(err.Error(), Equals, "Installing java 0.5.0 conflicts with installed plugin xml-report 1.0.0, which requires java <0.5")
+}
+
+func (s *MySuite) TestResolvingUnavailableDependency(c *C) {
+	html := describePlugin("html-report", versionInstallDescription{Version: "2.1.0", Dependencies: map[string]string{"java": "^1.0"}})
+	java := describePlugin("java", versionInstallDescription{Version: "0.5.0"})
+	resolver := newTestResolver(map[string]string{}, html, java)
+
+	_, err := resolver.resolve(html, &html.Versions[0])
+
+	c.Assert(err, ErrorMatches, "Could not resolve dependency java of plugin html-report. Version satisfying \\^1.0 compatible to .* not found")
+}
+
+func (s *MySuite) TestResolvingDependencyWhichDependsBackOnThePlugin(c *C) {
+	html := describePlugin("html-report", versionInstallDescription{Version: "2.1.0", Dependencies: map[string]string{"screenshot": "*"}})
+	screenshot := describePlugin("screenshot", versionInstallDescription{Version: "1.0.0", Dependencies: map[string]string{"html-report": "^2.0"}})
+	resolver := newTestResolver(map[string]string{"html-report": "1.0.0"}, html, screenshot)
+
+	plan, err := resolver.resolve(html, &html.Versions[0])
+
+	c.Assert(err, Equals, nil)
+	c.Assert(planOf(plan), DeepEquals, []string{"screenshot 1.0.0, required by html-report", "html-report 2.1.0"})
+}
+
+func (s *MySuite) TestResolvingDependencyWhichRequiresAnotherVersionOfThePlugin(c *C) {
+	html := describePlugin("html-report", versionInstallDescription{Version: "2.1.0", Dependencies: map[string]string{"screenshot": "*"}})
+	screenshot := describePlugin("screenshot", versionInstallDescription{Version: "1.0.0", Dependencies: map[string]string{"html-report": "^1.0"}})
+	resolver := newTestResolver(map[string]string{"html-report": "1.0.0"}, html, screenshot)
+
+	_, err := resolver.resolve(html, &html.Versions[0])
+
+	c.Assert(err.Error(), Equals, "Conflicting dependencies: screenshot requires html-report ^1.0, but html-report 2.1.0 is being installed")
+}
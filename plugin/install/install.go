@@ -56,11 +56,21 @@ type versionInstallDescription struct {
 	GaugeVersionSupport version.VersionSupport
 	Install             platformSpecificCommand
 	DownloadUrls        downloadUrls
+	// Hex encoded SHA-256 checksums of the downloads, per platform
+	Checksums platformSpecificValues
+	// Base64 encoded detached signatures of the downloads, per platform
+	Signatures platformSpecificValues
+	// Version constraints of the plugins this version depends on, keyed by plugin name
+	Dependencies map[string]string
 }
 
-type downloadUrls struct {
-	X86 platformSpecificURL
-	X64 platformSpecificURL
+type downloadUrls platformSpecificValues
+
+// platformSpecificValues holds a value of a plugin version for each OS and architecture, like the download links or
+// the checksums of the downloads
+type platformSpecificValues struct {
+	X86 osSpecificValues
+	X64 osSpecificValues
 }
 
 type platformSpecificCommand struct {
@@ -69,7 +79,7 @@ type platformSpecificCommand struct {
 	Darwin  []string
 }
 
-type platformSpecificURL struct {
+type osSpecificValues struct {
 	Windows string
 	Linux   string
 	Darwin  string
@@ -125,6 +135,7 @@ type GaugePlugin struct {
 		Darwin  []string
 	}
 	GaugeVersionSupport version.VersionSupport
+	Dependencies        map[string]string
 }
 
 // InstallPluginFromZipFile installs plugin from given zip file
@@ -159,7 +170,7 @@ func InstallPluginFromZipFile(zipFile string, pluginName string) InstallResult {
 	if err = runPlatformCommands(gp.PostInstall, pluginInstallDir); err != nil {
 		return installError(err)
 	}
-	return installSuccess("")
+	return installSuccess(checkDependenciesInstalled(gp))
 }
 
 func getPluginInstallDir(pluginID, pluginDirName string) (string, error) {
@@ -220,7 +231,7 @@ func installPluginWithDescription(installDescription *installDescription, curren
 			return installError(fmt.Errorf("Could not find compatible version for plugin %s. : %s", installDescription.Name, err))
 		}
 	}
-	return installPluginVersion(installDescription, versionInstallDescription)
+	return installWithDependencies(installDescription, versionInstallDescription)
 }
 
 func installPluginVersion(installDesc *installDescription, versionInstallDescription *versionInstallDescription) InstallResult {
@@ -232,6 +243,7 @@ func installPluginVersion(installDesc *installDescription, versionInstallDescrip
 	if err != nil {
 		return installError(fmt.Errorf("Could not get download link: %s", err.Error()))
 	}
+	downloadLink = resolveDownloadLink(downloadLink)
 
 	tempDir := common.GetTempDir()
 	defer common.Remove(tempDir)
@@ -240,6 +252,9 @@ func installPluginVersion(installDesc *installDescription, versionInstallDescrip
 	if err != nil {
 		return installError(fmt.Errorf("Failed to download the plugin. %s", err.Error()))
 	}
+	if err := verifyDownload(pluginZip, versionInstallDescription); err != nil {
+		return installError(fmt.Errorf("Failed to verify the plugin %s %s. %s", installDesc.Name, versionInstallDescription.Version, err.Error()))
+	}
 	return InstallPluginFromZipFile(pluginZip, installDesc.Name)
 }
 
@@ -322,29 +337,56 @@ func uninstallVersionOfPlugin(pluginDir, pluginName, version string) error {
 }
 
 func getDownloadLink(downloadUrls downloadUrls) (string, error) {
-	var platformLinks *platformSpecificURL
-	if strings.Contains(runtime.GOARCH, "64") {
-		platformLinks = &downloadUrls.X64
-	} else {
-		platformLinks = &downloadUrls.X86
+	downloadLink := downloadUrls.forCurrentPlatform()
+	if downloadLink == "" {
+		return "", fmt.Errorf("Platform not supported for %s. Download URL not specified.", runtime.GOOS)
+	}
+	return downloadLink, nil
+}
+
+// resolveDownloadLink resolves download links relative to the repository, as written in a mirror, against the repository URL.
+func resolveDownloadLink(downloadLink string) string {
+	if strings.Contains(downloadLink, "://") {
+		return downloadLink
+	}
+	return strings.TrimSuffix(config.GaugeRepositoryUrl(), "/") + "/" + downloadLink
+}
+
+func (downloadUrls downloadUrls) forCurrentPlatform() string {
+	return platformSpecificValues(downloadUrls).forCurrentPlatform()
+}
+
+func (downloadUrls *downloadUrls) byPlatform() map[string]*string {
+	return (*platformSpecificValues)(downloadUrls).byPlatform()
+}
+
+func (values platformSpecificValues) forCurrentPlatform() string {
+	return *values.byPlatform()[currentPlatform()]
+}
+
+// byPlatform returns the values keyed by platform names of the form <os>_<arch>, e.g. linux_x64.
+func (values *platformSpecificValues) byPlatform() map[string]*string {
+	return map[string]*string{
+		"windows_x86": &values.X86.Windows,
+		"linux_x86":   &values.X86.Linux,
+		"darwin_x86":  &values.X86.Darwin,
+		"windows_x64": &values.X64.Windows,
+		"linux_x64":   &values.X64.Linux,
+		"darwin_x64":  &values.X64.Darwin,
 	}
+}
 
-	var downloadLink string
+func currentPlatform() string {
+	arch := "x86"
+	if strings.Contains(runtime.GOARCH, "64") {
+		arch = "x64"
+	}
 	switch runtime.GOOS {
-	case "windows":
-		downloadLink = platformLinks.Windows
-		break
-	case "darwin":
-		downloadLink = platformLinks.Darwin
-		break
+	case "windows", "darwin":
+		return runtime.GOOS + "_" + arch
 	default:
-		downloadLink = platformLinks.Linux
-		break
+		return "linux_" + arch
 	}
-	if downloadLink == "" {
-		return "", fmt.Errorf("Platform not supported for %s. Download URL not specified.", runtime.GOOS)
-	}
-	return downloadLink, nil
 }
 
 func getInstallDescription(plugin string) (*installDescription, InstallResult) {
@@ -396,12 +438,25 @@ func (installDesc *installDescription) getVersion(version string) (*versionInsta
 }
 
 func (installDesc *installDescription) getLatestCompatibleVersionTo(currentVersion *version.Version) (*versionInstallDescription, error) {
+	return installDesc.getLatestCompatibleVersionSatisfying(currentVersion, nil)
+}
+
+func (installDesc *installDescription) getLatestCompatibleVersionSatisfying(currentVersion *version.Version, constraint *version.Constraint) (*versionInstallDescription, error) {
 	installDesc.sortVersionInstallDescriptions()
 	for _, versionInstallDesc := range installDesc.Versions {
+		if constraint != nil {
+			v, err := version.ParseVersion(versionInstallDesc.Version)
+			if err != nil || !constraint.IsSatisfiedBy(v) {
+				continue
+			}
+		}
 		if err := version.CheckCompatibility(currentVersion, &versionInstallDesc.GaugeVersionSupport); err == nil {
 			return &versionInstallDesc, nil
 		}
 	}
+	if constraint != nil {
+		return nil, fmt.Errorf("Version satisfying %s compatible to %s not found", constraint, currentVersion)
+	}
 	return nil, fmt.Errorf("Compatible version to %s not found", currentVersion)
 }
 
@@ -434,11 +489,16 @@ func getRunnerJSONContents(file string) (*runner.Runner, error) {
 
 // InstallAllPlugins install the latest version of all plugins specified in Gauge project manifest file
 func InstallAllPlugins() {
+	lock, err := manifest.ProjectLock()
+	if err != nil {
+		logger.Fatalf(err.Error())
+	}
 	manifest, err := manifest.ProjectManifest()
 	if err != nil {
 		logger.Fatalf(err.Error())
 	}
-	installPluginsFromManifest(manifest)
+	defer util.RemoveTempDir()
+	installPluginsFromManifest(manifest, lock)
 }
 
 // UpdatePlugins updates all the currently installed plugins to its latest version
@@ -498,21 +558,126 @@ func HandleUpdateResult(result InstallResult, pluginName string, exitIfFailure b
 	return true
 }
 
-func installPluginsFromManifest(manifest *manifest.Manifest) {
-	pluginsMap := make(map[string]bool, 0)
-	pluginsMap[manifest.Language] = true
-	for _, plugin := range manifest.Plugins {
-		pluginsMap[plugin] = false
+// installPluginsFromManifest installs the versions of the plugins the project is locked to. Plugins which are not
+// locked, or whose locked version does not satisfy the manifest, are resolved again and locked.
+func installPluginsFromManifest(projectManifest *manifest.Manifest, lock *manifest.Lock) {
+	HandleInstallResult(installProjectPlugin(projectManifest.Language, "", true, lock), projectManifest.Language, true)
+	for _, entry := range projectManifest.Plugins {
+		pluginName, constraint := manifest.ParsePluginEntry(entry)
+		HandleInstallResult(installProjectPlugin(pluginName, constraint, false, lock), pluginName, true)
+	}
+	if err := lock.Save(); err != nil {
+		logger.Fatalf("Failed to save %s. %s", manifest.LockFile, err.Error())
+	}
+}
+
+func installProjectPlugin(pluginName, constraintText string, isRunner bool, lock *manifest.Lock) InstallResult {
+	constraint, err := version.ParseConstraint(constraintText)
+	if err != nil {
+		return installError(fmt.Errorf("Invalid version of plugin %s in %s. %s", pluginName, common.ManifestFile, err.Error()))
+	}
+	if locked := lock.Find(pluginName); locked != nil {
+		lockedVersion, err := version.ParseVersion(locked.Version)
+		if err == nil && constraint.IsSatisfiedBy(lockedVersion) {
+			if plugin.IsPluginInstalled(pluginName, locked.Version) {
+				logger.Info("Plugin %s %s is already installed.", pluginName, locked.Version)
+				return installSkipped("")
+			}
+			return installLockedVersion(pluginName, locked)
+		}
+		logger.Info("Plugin %s %s in %s does not satisfy %s, resolving it again.", pluginName, locked.Version, manifest.LockFile, constraintText)
+	}
+	if installedVersion, err := plugin.GetLatestInstalledVersion(pluginName, constraint); err == nil && isCompatibleVersionInstalled(pluginName, installedVersion, isRunner) {
+		logger.Info("Plugin %s %s is already installed.", pluginName, installedVersion)
+		lock.Set(lockInstalledVersion(pluginName, installedVersion))
+		return installSkipped("")
+	}
+	installDesc, result := getInstallDescription(pluginName)
+	if !result.Success {
+		return result
+	}
+	versionInstallDesc, err := installDesc.getLatestCompatibleVersionSatisfying(version.CurrentGaugeVersion, constraint)
+	if err != nil {
+		return installError(fmt.Errorf("Could not find compatible version for plugin %s. : %s", pluginName, err))
+	}
+	result = installWithDependencies(installDesc, versionInstallDesc)
+	if result.Success || result.Skipped {
+		lock.Set(lockedPluginOf(pluginName, versionInstallDesc))
+	}
+	return result
+}
+
+func installLockedVersion(pluginName string, locked *manifest.LockedPlugin) InstallResult {
+	installDesc, result := getInstallDescription(pluginName)
+	if !result.Success {
+		return result
 	}
+	versionInstallDesc, err := installDesc.getVersion(locked.Version)
+	if err != nil {
+		return installError(fmt.Errorf("Version %s of plugin %s in %s is not available. %s", locked.Version, pluginName, manifest.LockFile, err.Error()))
+	}
+	if err := applyLockedChecksums(versionInstallDesc, locked); err != nil {
+		return installError(err)
+	}
+	return installWithDependencies(installDesc, versionInstallDesc)
+}
 
-	for pluginName, isRunner := range pluginsMap {
-		if !IsCompatiblePluginInstalled(pluginName, isRunner) {
-			logger.Info("Compatible version of plugin %s not found. Installing plugin %s...", pluginName, pluginName)
-			HandleInstallResult(InstallPlugin(pluginName, ""), pluginName, true)
-		} else {
-			logger.Info("Plugin %s is already installed.", pluginName)
+// applyLockedChecksums makes the download of a locked version verified against the checksums recorded in the lock
+// file, failing if the repository now publishes different ones.
+func applyLockedChecksums(versionInstallDesc *versionInstallDescription, locked *manifest.LockedPlugin) error {
+	checksums := versionInstallDesc.Checksums.byPlatform()
+	for platform, lockedChecksum := range locked.Checksums {
+		checksum, ok := checksums[platform]
+		if !ok || lockedChecksum == "" {
+			continue
+		}
+		if *checksum != "" && !strings.EqualFold(*checksum, lockedChecksum) {
+			return fmt.Errorf("Checksum of plugin %s %s for %s in the repository does not match %s. Expected %s, got %s.", locked.Name, locked.Version, platform, manifest.LockFile, lockedChecksum, *checksum)
 		}
+		*checksum = lockedChecksum
 	}
+	return nil
+}
+
+// lockInstalledVersion locks an already installed version, recording its checksums if the repository is reachable.
+func lockInstalledVersion(pluginName, installedVersion string) *manifest.LockedPlugin {
+	installDesc, result := getInstallDescription(pluginName)
+	if result.Success {
+		if versionInstallDesc, err := installDesc.getVersion(installedVersion); err == nil {
+			return lockedPluginOf(pluginName, versionInstallDesc)
+		}
+	}
+	logger.Warning("Could not find checksums of plugin %s %s. It is locked without checksums.", pluginName, installedVersion)
+	return &manifest.LockedPlugin{Name: pluginName, Version: installedVersion}
+}
+
+func lockedPluginOf(pluginName string, versionInstallDesc *versionInstallDescription) *manifest.LockedPlugin {
+	checksums := make(map[string]string)
+	for platform, checksum := range versionInstallDesc.Checksums.byPlatform() {
+		if *checksum != "" {
+			checksums[platform] = *checksum
+		}
+	}
+	return &manifest.LockedPlugin{Name: pluginName, Version: versionInstallDesc.Version, Checksums: checksums}
+}
+
+func isCompatibleVersionInstalled(pluginName, pluginVersion string, isRunner bool) bool {
+	if isRunner {
+		installDir, err := plugin.GetInstallDir(pluginName, pluginVersion)
+		if err != nil {
+			return false
+		}
+		r, err := getRunnerJSONContents(filepath.Join(installDir, pluginName+".json"))
+		if err != nil {
+			return false
+		}
+		return version.CheckCompatibility(version.CurrentGaugeVersion, &r.GaugeVersionSupport) == nil
+	}
+	pd, err := plugin.GetPluginDescriptor(pluginName, pluginVersion)
+	if err != nil {
+		return false
+	}
+	return version.CheckCompatibility(version.CurrentGaugeVersion, &pd.GaugeVersionSupport) == nil
 }
 
 // IsCompatiblePluginInstalled checks if a plugin compatible to gauge is installed
@@ -23,6 +23,7 @@ import (
 
 	"fmt"
 
+	"github.com/getgauge/gauge/manifest"
 	"github.com/getgauge/gauge/util"
 	"github.com/getgauge/gauge/version"
 	. "gopkg.in/check.v1"
@@ -80,6 +81,63 @@ func (s *MySuite) TestFindingLatestCompatibleVersionFailing(c *C) {
 	c.Assert(err, NotNil)
 }
 
+func (s *MySuite) TestFindingLatestCompatibleVersionSatisfyingConstraint(c *C) {
+	installDescription := createInstallDescriptionWithVersions("2.1.0", "2.3.1", "3.0.0", "2.2.0")
+	addVersionSupportToInstallDescription(installDescription,
+		&version.VersionSupport{Minimum: "0.1.0"},
+		&version.VersionSupport{Minimum: "0.9.0"},
+		&version.VersionSupport{Minimum: "0.1.0"},
+		&version.VersionSupport{Minimum: "0.1.0"})
+	constraint, _ := version.ParseConstraint("^2.1")
+	versionInstallDesc, err := installDescription.getLatestCompatibleVersionSatisfying(&version.Version{Major: 0, Minor: 5, Patch: 0}, constraint)
+	c.Assert(err, Equals, nil)
+	c.Assert(versionInstallDesc.Version, Equals, "2.2.0")
+}
+
+func (s *MySuite) TestFindingLatestCompatibleVersionSatisfyingConstraintFailing(c *C) {
+	installDescription := createInstallDescriptionWithVersions("2.1.0", "3.0.0")
+	addVersionSupportToInstallDescription(installDescription,
+		&version.VersionSupport{Minimum: "0.1.0"},
+		&version.VersionSupport{Minimum: "0.1.0"})
+	constraint, _ := version.ParseConstraint("~2.2")
+	_, err := installDescription.getLatestCompatibleVersionSatisfying(&version.Version{Major: 0, Minor: 5, Patch: 0}, constraint)
+	c.Assert(err.Error(), Equals, "Version satisfying ~2.2 compatible to 0.5.0 not found")
+}
+
+func (s *MySuite) TestApplyingLockedChecksums(c *C) {
+	versionInstallDesc := &versionInstallDescription{Version: "2.1.0"}
+	versionInstallDesc.Checksums.X64.Linux = "abc"
+	locked := &manifest.LockedPlugin{Name: "html-report", Version: "2.1.0", Checksums: map[string]string{"linux_x64": "ABC", "darwin_x64": "def"}}
+
+	err := applyLockedChecksums(versionInstallDesc, locked)
+
+	c.Assert(err, Equals, nil)
+	c.Assert(versionInstallDesc.Checksums.X64.Linux, Equals, "ABC")
+	c.Assert(versionInstallDesc.Checksums.X64.Darwin, Equals, "def")
+}
+
+func (s *MySuite) TestApplyingLockedChecksumsFailsOnMismatch(c *C) {
+	versionInstallDesc := &versionInstallDescription{Version: "2.1.0"}
+	versionInstallDesc.Checksums.X64.Linux = "abc"
+	locked := &manifest.LockedPlugin{Name: "html-report", Version: "2.1.0", Checksums: map[string]string{"linux_x64": "def"}}
+
+	err := applyLockedChecksums(versionInstallDesc, locked)
+
+	c.Assert(err.Error(), Equals, "Checksum of plugin html-report 2.1.0 for linux_x64 in the repository does not match manifest.lock. Expected def, got abc.")
+}
+
+func (s *MySuite) TestLockingVersionRecordsChecksums(c *C) {
+	versionInstallDesc := &versionInstallDescription{Version: "2.1.0"}
+	versionInstallDesc.Checksums.X86.Windows = "abc"
+	versionInstallDesc.Checksums.X64.Darwin = "def"
+
+	locked := lockedPluginOf("html-report", versionInstallDesc)
+
+	c.Assert(locked.Name, Equals, "html-report")
+	c.Assert(locked.Version, Equals, "2.1.0")
+	c.Assert(locked.Checksums, DeepEquals, map[string]string{"windows_x86": "abc", "darwin_x64": "def"})
+}
+
 func createInstallDescriptionWithVersions(versionNumbers ...string) *installDescription {
 	var versionInstallDescriptions []versionInstallDescription
 	for _, version := range versionNumbers {
@@ -0,0 +1,156 @@
+// Copyright 2015 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+
+package install
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+
+	"github.com/getgauge/common"
+	"github.com/getgauge/gauge/logger"
+	"github.com/getgauge/gauge/plugin"
+	"github.com/getgauge/gauge/util"
+)
+
+// MirrorPlugins copies the install descriptions and downloads of the installed plugin versions, for all platforms,
+// from the plugin repository to mirrorDir. The mirror can be used as a repository through a file:// URL.
+func MirrorPlugins(mirrorDir string) error {
+	plugins, err := plugin.GetAllInstalledPluginsWithVersion()
+	if err != nil {
+		return fmt.Errorf("Failed to find installed plugins. %s", err.Error())
+	}
+	if err := os.MkdirAll(mirrorDir, common.NewDirectoryPermissions); err != nil {
+		return err
+	}
+	defer util.RemoveTempDir()
+	failed := make([]string, 0)
+	for _, pluginInfo := range plugins {
+		if err := mirrorPlugin(pluginInfo.Name, pluginInfo.Version.String(), mirrorDir); err != nil {
+			logger.Errorf("Failed to mirror plugin %s %s. %s", pluginInfo.Name, pluginInfo.Version.String(), err.Error())
+			failed = append(failed, pluginInfo.Name)
+			continue
+		}
+		logger.Info("Mirrored plugin %s %s", pluginInfo.Name, pluginInfo.Version.String())
+	}
+	if len(failed) > 0 {
+		return fmt.Errorf("Failed to mirror %d plugins.", len(failed))
+	}
+	return nil
+}
+
+func mirrorPlugin(pluginName, pluginVersion, mirrorDir string) error {
+	installDescription, result := getInstallDescription(pluginName)
+	if !result.Success {
+		return result.Error
+	}
+	versionInstallDescription, err := installDescription.getVersion(pluginVersion)
+	if err != nil {
+		return err
+	}
+	mirrored := *versionInstallDescription
+	checksums := mirrored.Checksums.byPlatform()
+	downloadLinks := mirrored.DownloadUrls.byPlatform()
+	for platform, downloadLink := range downloadLinks {
+		if *downloadLink == "" {
+			continue
+		}
+		dir := path.Join(pluginName, pluginVersion)
+		if hasOtherDownloadNamed(downloadLinks, *downloadLink) {
+			dir = path.Join(dir, platform)
+		}
+		link, err := mirrorDownload(resolveDownloadLink(*downloadLink), *checksums[platform], mirrorDir, dir)
+		if err != nil {
+			return err
+		}
+		*downloadLink = link
+	}
+	return addToMirroredInstallDescription(installDescription, &mirrored, mirrorDir)
+}
+
+// hasOtherDownloadNamed tells if another platform downloads a different file with the same name, in which case the
+// downloads of each platform are kept apart in the mirror.
+func hasOtherDownloadNamed(downloadLinks map[string]*string, downloadLink string) bool {
+	for _, other := range downloadLinks {
+		if *other != downloadLink && path.Base(*other) == path.Base(downloadLink) {
+			return true
+		}
+	}
+	return false
+}
+
+// mirrorDownload copies the download to the directory dir of the mirror, verifying its checksum, and returns the
+// link relative to the mirror. Downloads already in the mirror are not copied again.
+func mirrorDownload(downloadLink, checksum, mirrorDir, dir string) (string, error) {
+	link := path.Join(dir, path.Base(downloadLink))
+	targetDir := filepath.Join(mirrorDir, filepath.FromSlash(dir))
+	mirroredFile := filepath.Join(mirrorDir, filepath.FromSlash(link))
+	if common.FileExists(mirroredFile) && verifyMirroredFile(mirroredFile, checksum) == nil {
+		return link, nil
+	}
+	if err := os.MkdirAll(targetDir, common.NewDirectoryPermissions); err != nil {
+		return "", err
+	}
+	logger.Info("Downloading %s", link)
+	if _, err := util.Download(downloadLink, targetDir); err != nil {
+		return "", fmt.Errorf("Failed to download %s. %s", downloadLink, err.Error())
+	}
+	if err := verifyMirroredFile(mirroredFile, checksum); err != nil {
+		os.Remove(mirroredFile)
+		return "", fmt.Errorf("Failed to verify %s. %s", link, err.Error())
+	}
+	return link, nil
+}
+
+func verifyMirroredFile(fileName, checksum string) error {
+	if checksum == "" {
+		return nil
+	}
+	digest, err := sha256Of(fileName)
+	if err != nil {
+		return err
+	}
+	return verifyChecksum(digest, checksum)
+}
+
+// addToMirroredInstallDescription writes the install description of the mirrored version, keeping the other versions
+// already present in the mirror.
+func addToMirroredInstallDescription(installDesc *installDescription, mirrored *versionInstallDescription, mirrorDir string) error {
+	installJSON := filepath.Join(mirrorDir, installDesc.Name+"-install.json")
+	mirroredDescription := &installDescription{Name: installDesc.Name, Description: installDesc.Description}
+	if common.FileExists(installJSON) {
+		existing, result := getInstallDescriptionFromJSON(installJSON)
+		if !result.Success {
+			return fmt.Errorf("Failed to read %s. %s", installJSON, result.Error.Error())
+		}
+		for _, versionInstallDescription := range existing.Versions {
+			if versionInstallDescription.Version != mirrored.Version {
+				mirroredDescription.Versions = append(mirroredDescription.Versions, versionInstallDescription)
+			}
+		}
+	}
+	mirroredDescription.Versions = append(mirroredDescription.Versions, *mirrored)
+	mirroredDescription.sortVersionInstallDescriptions()
+	contents, err := json.MarshalIndent(mirroredDescription, "", "    ")
+	if err != nil {
+		return err
+	}
+	return common.SaveFile(installJSON, string(contents), false)
+}
@@ -0,0 +1,77 @@
+// Copyright 2015 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+
+package install
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/getgauge/common"
+	"github.com/getgauge/gauge/util"
+	. "gopkg.in/check.v1"
+)
+
+func (s *MySuite) TestMirrorDownloadVerifiesChecksum(c *C) {
+	zipFile, digest := createPluginZip(c, "plugin contents")
+	defer os.RemoveAll(filepath.Dir(zipFile))
+	mirrorDir, err := ioutil.TempDir("", "gaugeMirror")
+	c.Assert(err, IsNil)
+	defer os.RemoveAll(mirrorDir)
+
+	link, err := mirrorDownload(util.FileURLOf(zipFile), hex.EncodeToString(digest), mirrorDir, "plugin/1.0.0")
+	c.Assert(err, IsNil)
+	c.Assert(link, Equals, "plugin/1.0.0/plugin-1.0.0.zip")
+	c.Assert(common.FileExists(filepath.Join(mirrorDir, "plugin", "1.0.0", "plugin-1.0.0.zip")), Equals, true)
+
+	otherDigest := sha256.Sum256([]byte("other contents"))
+	os.Remove(filepath.Join(mirrorDir, filepath.FromSlash(link)))
+	_, err = mirrorDownload(util.FileURLOf(zipFile), hex.EncodeToString(otherDigest[:]), mirrorDir, "plugin/1.0.0")
+	c.Assert(err, ErrorMatches, "Failed to verify plugin/1.0.0/plugin-1.0.0.zip. Checksum mismatch.*")
+	_, err = os.Stat(filepath.Join(mirrorDir, filepath.FromSlash(link)))
+	c.Assert(os.IsNotExist(err), Equals, true)
+}
+
+func (s *MySuite) TestDownloadsWithTheSameNameAreKeptApart(c *C) {
+	linux, darwin := "https://example.com/linux/plugin.zip", "https://example.com/darwin/plugin.zip"
+	downloadLinks := map[string]*string{"linux_x64": &linux, "darwin_x64": &darwin}
+
+	c.Assert(hasOtherDownloadNamed(downloadLinks, linux), Equals, true)
+
+	darwin = linux
+	c.Assert(hasOtherDownloadNamed(downloadLinks, linux), Equals, false)
+}
+
+func (s *MySuite) TestAddToMirroredInstallDescriptionKeepsOtherVersions(c *C) {
+	mirrorDir, err := ioutil.TempDir("", "gaugeMirror")
+	c.Assert(err, IsNil)
+	defer os.RemoveAll(mirrorDir)
+	installDesc := &installDescription{Name: "java"}
+
+	c.Assert(addToMirroredInstallDescription(installDesc, &versionInstallDescription{Version: "1.0.0", DownloadUrls: downloadUrls(forAllPlatforms("java-1.0.0.zip"))}, mirrorDir), IsNil)
+	c.Assert(addToMirroredInstallDescription(installDesc, &versionInstallDescription{Version: "1.1.0", DownloadUrls: downloadUrls(forAllPlatforms("java-1.1.0.zip"))}, mirrorDir), IsNil)
+	c.Assert(addToMirroredInstallDescription(installDesc, &versionInstallDescription{Version: "1.0.0", DownloadUrls: downloadUrls(forAllPlatforms("java-1.0.0-new.zip"))}, mirrorDir), IsNil)
+
+	mirrored, result := getInstallDescriptionFromJSON(filepath.Join(mirrorDir, "java-install.json"))
+	c.Assert(result.Success, Equals, true)
+	c.Assert(len(mirrored.Versions), Equals, 2)
+	c.Assert(mirrored.Versions[0].Version, Equals, "1.1.0")
+	c.Assert(mirrored.Versions[1].DownloadUrls.forCurrentPlatform(), Equals, "java-1.0.0-new.zip")
+}
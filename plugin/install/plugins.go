@@ -0,0 +1,210 @@
+// Copyright 2015 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+
+package install
+
+import (
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/getgauge/common"
+	"github.com/getgauge/gauge/logger"
+	"github.com/getgauge/gauge/plugin"
+	"github.com/getgauge/gauge/version"
+)
+
+const languageScope = "Language"
+
+type installedPluginVersion struct {
+	Name                string            `json:"name"`
+	Version             string            `json:"version"`
+	Path                string            `json:"path"`
+	Description         string            `json:"description,omitempty"`
+	Scope               []string          `json:"scope"`
+	Command             []string          `json:"command,omitempty"`
+	GaugeVersionSupport string            `json:"gaugeVersionSupport"`
+	Compatible          bool              `json:"compatible"`
+	Dependencies        map[string]string `json:"dependencies,omitempty"`
+}
+
+func (p *installedPluginVersion) compatibility() string {
+	if p.Compatible {
+		return "compatible"
+	}
+	return fmt.Sprintf("not compatible with gauge %s (supports %s)", version.CurrentGaugeVersion, p.GaugeVersionSupport)
+}
+
+// describeInstalledVersion reads the plugin json of an installed plugin version. Language runners, which are
+// described by <plugin name>.json instead of plugin.json, get the Language scope.
+func describeInstalledVersion(pluginInfo plugin.PluginInfo) (*installedPluginVersion, error) {
+	gp, err := parsePluginJSON(pluginInfo.Path, pluginInfo.Name)
+	if err != nil {
+		return nil, err
+	}
+	p := &installedPluginVersion{
+		Name:                pluginInfo.Name,
+		Version:             filepath.Base(pluginInfo.Path),
+		Path:                pluginInfo.Path,
+		Description:         gp.Description,
+		GaugeVersionSupport: versionSupportText(gp.GaugeVersionSupport),
+		Compatible:          version.CheckCompatibility(version.CurrentGaugeVersion, &gp.GaugeVersionSupport) == nil,
+		Dependencies:        gp.Dependencies,
+	}
+	if common.FileExists(filepath.Join(pluginInfo.Path, pluginInfo.Name+jsonExt)) {
+		p.Scope = []string{languageScope}
+		return p, nil
+	}
+	pd, err := plugin.GetPluginDescriptorFromJSON(filepath.Join(pluginInfo.Path, pluginJSON))
+	if err != nil {
+		return nil, err
+	}
+	p.Scope = pd.Scope
+	p.Command = commandForCurrentPlatform(pd.Command.Windows, pd.Command.Linux, pd.Command.Darwin)
+	return p, nil
+}
+
+func versionSupportText(support version.VersionSupport) string {
+	if support.Maximum == "" {
+		return fmt.Sprintf(">= %s", support.Minimum)
+	}
+	return fmt.Sprintf("%s - %s", support.Minimum, support.Maximum)
+}
+
+func commandForCurrentPlatform(windows, linux, darwin []string) []string {
+	switch {
+	case strings.HasPrefix(currentPlatform(), "windows"):
+		return windows
+	case strings.HasPrefix(currentPlatform(), "darwin"):
+		return darwin
+	default:
+		return linux
+	}
+}
+
+func getInstalledPluginVersions() ([]*installedPluginVersion, error) {
+	allVersions, err := plugin.GetAllInstalledVersions()
+	if err != nil {
+		return nil, err
+	}
+	var installed []*installedPluginVersion
+	for _, pluginInfo := range allVersions {
+		p, err := describeInstalledVersion(pluginInfo)
+		if err != nil {
+			logger.Warning("Skipping plugin %s %s. %s", pluginInfo.Name, filepath.Base(pluginInfo.Path), err.Error())
+			continue
+		}
+		installed = append(installed, p)
+	}
+	return installed, nil
+}
+
+// ListPlugins prints every installed version of each plugin with its scope and compatibility with this version of gauge.
+func ListPlugins(machineReadable bool) {
+	installed, err := getInstalledPluginVersions()
+	if err != nil {
+		logger.Fatalf("Failed to list plugins. %s", err.Error())
+	}
+	if machineReadable {
+		if installed == nil {
+			installed = make([]*installedPluginVersion, 0)
+		}
+		b, err := json.MarshalIndent(installed, "", "    ")
+		if err != nil {
+			logger.Fatalf("Failed to list plugins. %s", err.Error())
+		}
+		fmt.Println(string(b))
+		return
+	}
+	if len(installed) == 0 {
+		logger.Info("No plugins found")
+		logger.Info("Plugins can be installed with `gauge --install {plugin-name}`")
+		return
+	}
+	logger.Info(pluginListText(installed))
+}
+
+func pluginListText(installed []*installedPluginVersion) string {
+	var lines []string
+	for i, p := range installed {
+		if i == 0 || installed[i-1].Name != p.Name {
+			lines = append(lines, p.Name)
+		}
+		lines = append(lines, fmt.Sprintf("  %s  [%s]  %s", p.Version, strings.Join(p.Scope, ", "), p.compatibility()))
+	}
+	return strings.Join(lines, "\n")
+}
+
+// ShowPluginInfo prints the details of an installed version of the plugin, the latest one if no version is given.
+func ShowPluginInfo(pluginName, pluginVersion string) {
+	installed, err := getInstalledPluginVersions()
+	if err != nil {
+		logger.Fatalf("Failed to read plugin %s. %s", pluginName, err.Error())
+	}
+	var versions []*installedPluginVersion
+	for _, p := range installed {
+		if p.Name == pluginName {
+			versions = append(versions, p)
+		}
+	}
+	if len(versions) == 0 {
+		logger.Fatalf("Plugin %s is not installed.", pluginName)
+	}
+	selected := versions[0]
+	if pluginVersion != "" {
+		selected = nil
+		for _, p := range versions {
+			if p.Version == pluginVersion {
+				selected = p
+			}
+		}
+		if selected == nil {
+			logger.Fatalf("Plugin %s %s is not installed.", pluginName, pluginVersion)
+		}
+	}
+	logger.Info(pluginInfoText(selected, versions))
+}
+
+func pluginInfoText(p *installedPluginVersion, versions []*installedPluginVersion) string {
+	lines := []string{
+		fmt.Sprintf("Name:             %s", p.Name),
+		fmt.Sprintf("Version:          %s", p.Version),
+		fmt.Sprintf("Description:      %s", p.Description),
+		fmt.Sprintf("Scope:            %s", strings.Join(p.Scope, ", ")),
+		fmt.Sprintf("Gauge versions:   %s (%s)", p.GaugeVersionSupport, p.compatibility()),
+		fmt.Sprintf("Install location: %s", p.Path),
+	}
+	if len(p.Command) > 0 {
+		lines = append(lines, fmt.Sprintf("Command:          %s", strings.Join(p.Command, " ")))
+	}
+	if len(p.Dependencies) > 0 {
+		var dependencies []string
+		for name, constraint := range p.Dependencies {
+			dependencies = append(dependencies, fmt.Sprintf("%s %s", name, constraint))
+		}
+		sort.Strings(dependencies)
+		lines = append(lines, fmt.Sprintf("Dependencies:     %s", strings.Join(dependencies, ", ")))
+	}
+	var installedVersions []string
+	for _, v := range versions {
+		installedVersions = append(installedVersions, v.Version)
+	}
+	lines = append(lines, fmt.Sprintf("Installed:        %s", strings.Join(installedVersions, ", ")))
+	return strings.Join(lines, "\n")
+}
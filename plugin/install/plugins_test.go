@@ -0,0 +1,44 @@
+// Copyright 2015 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+
+package install
+
+import (
+	"github.com/getgauge/gauge/version"
+	. "gopkg.in/check.v1"
+)
+
+func (s *MySuite) TestPluginListGroupsVersionsByPlugin(c *C) {
+	installed := []*installedPluginVersion{
+		{Name: "html-report", Version: "2.1.0", Scope: []string{"Execution"}, Compatible: true},
+		{Name: "html-report", Version: "1.0.0", Scope: []string{"Execution"}, GaugeVersionSupport: "0.1.0 - 0.2.0"},
+		{Name: "java", Version: "0.4.0", Scope: []string{languageScope}, Compatible: true},
+	}
+
+	text := pluginListText(installed)
+
+	c.Assert(text, Equals, "html-report\n"+
+		"  2.1.0  [Execution]  compatible\n"+
+		"  1.0.0  [Execution]  not compatible with gauge "+version.CurrentGaugeVersion.String()+" (supports 0.1.0 - 0.2.0)\n"+
+		"java\n"+
+		"  0.4.0  [Language]  compatible")
+}
+
+func (s *MySuite) TestVersionSupportText(c *C) {
+	c.Assert(versionSupportText(version.VersionSupport{Minimum: "0.3.0"}), Equals, ">= 0.3.0")
+	c.Assert(versionSupportText(version.VersionSupport{Minimum: "0.3.0", Maximum: "0.5.0"}), Equals, "0.3.0 - 0.5.0")
+}
@@ -0,0 +1,172 @@
+// Copyright 2015 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+
+package install
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/getgauge/common"
+	"github.com/getgauge/gauge/logger"
+	"github.com/getgauge/gauge/manifest"
+	"github.com/getgauge/gauge/plugin"
+	"github.com/getgauge/gauge/version"
+)
+
+// PrunePlugins uninstalls the versions of plugins which none of the given projects use. The latest version of each
+// plugin is always kept, as it is used by projects which do not lock or constrain the plugin version, and so are the
+// dependencies of kept versions. The versions to uninstall are listed first, and only listed when dryRun is set.
+func PrunePlugins(projectRoots []string, dryRun bool) error {
+	installed, err := plugin.GetAllInstalledVersions()
+	if err != nil {
+		return err
+	}
+	referenced := make(map[string]map[string]bool)
+	for _, projectRoot := range projectRoots {
+		if err := addReferencedVersions(projectRoot, installed, referenced); err != nil {
+			return fmt.Errorf("Failed to read plugins of project %s. %s", projectRoot, err.Error())
+		}
+	}
+	if err := addDependenciesOfKeptVersions(installed, referenced, dependenciesOf); err != nil {
+		return err
+	}
+	pluginsHome, err := common.GetPrimaryPluginsInstallDir()
+	if err != nil {
+		return err
+	}
+	var toPrune []plugin.PluginInfo
+	for _, p := range versionsToPrune(installed, referenced) {
+		if strings.HasPrefix(p.Path, pluginsHome) {
+			toPrune = append(toPrune, p)
+		}
+	}
+	if len(toPrune) == 0 {
+		logger.Info("No plugin versions to prune.")
+		return nil
+	}
+	logger.Info("The following plugin versions are not used:")
+	for _, p := range toPrune {
+		logger.Info("  %s %s", p.Name, filepath.Base(p.Path))
+	}
+	if dryRun {
+		return nil
+	}
+	for _, p := range toPrune {
+		if err := uninstallVersionOfPlugin(p.Path, p.Name, filepath.Base(p.Path)); err != nil {
+			return fmt.Errorf("Failed to uninstall plugin %s %s. %s", p.Name, filepath.Base(p.Path), err.Error())
+		}
+	}
+	return nil
+}
+
+func dependenciesOf(p plugin.PluginInfo) (map[string]string, error) {
+	gp, err := parsePluginJSON(p.Path, p.Name)
+	if err != nil {
+		return nil, err
+	}
+	return gp.Dependencies, nil
+}
+
+// addDependenciesOfKeptVersions marks the dependencies of the versions which are kept, that is the referenced and the
+// latest versions, and in turn their dependencies. A dependency is resolved to the latest installed version
+// satisfying its constraint, like when the plugin is used.
+func addDependenciesOfKeptVersions(installed []plugin.PluginInfo, referenced map[string]map[string]bool, dependenciesOf func(plugin.PluginInfo) (map[string]string, error)) error {
+	var kept []plugin.PluginInfo
+	for i, p := range installed {
+		if i == 0 || installed[i-1].Name != p.Name || referenced[p.Name][filepath.Base(p.Path)] {
+			kept = append(kept, p)
+		}
+	}
+	for len(kept) > 0 {
+		p := kept[0]
+		kept = kept[1:]
+		dependencies, err := dependenciesOf(p)
+		if err != nil {
+			return fmt.Errorf("Failed to read the dependencies of plugin %s %s. %s", p.Name, filepath.Base(p.Path), err.Error())
+		}
+		for name, constraintText := range dependencies {
+			constraint, err := version.ParseConstraint(constraintText)
+			if err != nil {
+				return fmt.Errorf("Invalid dependency %s %s of plugin %s %s. %s", name, constraintText, p.Name, filepath.Base(p.Path), err.Error())
+			}
+			for _, dependency := range installed {
+				if dependency.Name == name && constraint.IsSatisfiedBy(dependency.Version) {
+					if !referenced[name][filepath.Base(dependency.Path)] {
+						markReferenced(referenced, name, filepath.Base(dependency.Path))
+						kept = append(kept, dependency)
+					}
+					break
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// addReferencedVersions marks the plugin versions the project uses: the locked versions and, for plugins which are not
+// locked, the latest installed version satisfying the version constraint of the manifest.
+func addReferencedVersions(projectRoot string, installed []plugin.PluginInfo, referenced map[string]map[string]bool) error {
+	m, err := manifest.ReadManifest(projectRoot)
+	if err != nil {
+		return err
+	}
+	lock, err := manifest.ReadLock(projectRoot)
+	if err != nil {
+		return err
+	}
+	entries := append([]string{m.Language}, m.Plugins...)
+	for _, entry := range entries {
+		name, constraintText := manifest.ParsePluginEntry(entry)
+		if locked := lock.Find(name); locked != nil {
+			markReferenced(referenced, name, locked.Version)
+			continue
+		}
+		constraint, err := version.ParseConstraint(constraintText)
+		if err != nil {
+			return err
+		}
+		for _, p := range installed {
+			if p.Name == name && constraint.IsSatisfiedBy(p.Version) {
+				markReferenced(referenced, name, filepath.Base(p.Path))
+				break
+			}
+		}
+	}
+	return nil
+}
+
+func markReferenced(referenced map[string]map[string]bool, name, pluginVersion string) {
+	if referenced[name] == nil {
+		referenced[name] = make(map[string]bool)
+	}
+	referenced[name][pluginVersion] = true
+}
+
+// versionsToPrune returns the installed versions which are neither referenced nor the latest version of their plugin.
+// The installed versions are expected latest first for each plugin.
+func versionsToPrune(installed []plugin.PluginInfo, referenced map[string]map[string]bool) []plugin.PluginInfo {
+	var toPrune []plugin.PluginInfo
+	for i, p := range installed {
+		isLatest := i == 0 || installed[i-1].Name != p.Name
+		if !isLatest && !referenced[p.Name][filepath.Base(p.Path)] {
+			toPrune = append(toPrune, p)
+		}
+	}
+	return toPrune
+}
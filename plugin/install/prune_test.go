@@ -0,0 +1,104 @@
+// Copyright 2015 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+
+package install
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/getgauge/gauge/plugin"
+	"github.com/getgauge/gauge/version"
+	. "gopkg.in/check.v1"
+)
+
+func installedVersion(name, pluginVersion string) plugin.PluginInfo {
+	v, _ := version.ParseVersion(pluginVersion)
+	return plugin.PluginInfo{Name: name, Version: v, Path: filepath.Join("plugins", name, pluginVersion)}
+}
+
+func (s *MySuite) TestVersionsToPruneKeepsLatestAndReferencedVersions(c *C) {
+	installed := []plugin.PluginInfo{
+		installedVersion("html-report", "2.1.0"),
+		installedVersion("html-report", "2.0.0"),
+		installedVersion("html-report", "1.9.0"),
+		installedVersion("java", "0.4.0"),
+		installedVersion("java", "0.3.0"),
+	}
+	referenced := map[string]map[string]bool{"html-report": {"2.0.0": true}}
+
+	toPrune := versionsToPrune(installed, referenced)
+
+	c.Assert(toPrune, DeepEquals, []plugin.PluginInfo{installed[2], installed[4]})
+}
+
+func (s *MySuite) TestReferencedVersionsOfProject(c *C) {
+	projectRoot, err := ioutil.TempDir("", "gaugeProject")
+	c.Assert(err, IsNil)
+	defer os.RemoveAll(projectRoot)
+	c.Assert(ioutil.WriteFile(filepath.Join(projectRoot, "manifest.json"), []byte(`{"Language": "java", "Plugins": ["html-report@~2.0", "xml-report"]}`), 0644), IsNil)
+	c.Assert(ioutil.WriteFile(filepath.Join(projectRoot, "manifest.lock"), []byte(`{"Plugins": [{"Name": "java", "Version": "0.3.0"}]}`), 0644), IsNil)
+	installed := []plugin.PluginInfo{
+		installedVersion("html-report", "2.1.0"),
+		installedVersion("html-report", "2.0.1"),
+		installedVersion("html-report", "2.0.0"),
+		installedVersion("java", "0.4.0"),
+		installedVersion("java", "0.3.0"),
+		installedVersion("xml-report", "1.1.0"),
+		installedVersion("xml-report", "1.0.0"),
+	}
+	referenced := make(map[string]map[string]bool)
+
+	err = addReferencedVersions(projectRoot, installed, referenced)
+
+	c.Assert(err, IsNil)
+	c.Assert(referenced, DeepEquals, map[string]map[string]bool{
+		"java":        {"0.3.0": true},
+		"html-report": {"2.0.1": true},
+		"xml-report":  {"1.1.0": true},
+	})
+}
+
+func (s *MySuite) TestDependenciesOfKeptVersionsAreKept(c *C) {
+	installed := []plugin.PluginInfo{
+		installedVersion("html-report", "2.1.0"),
+		installedVersion("html-report", "2.0.0"),
+		installedVersion("java", "0.4.0"),
+		installedVersion("java", "0.3.0"),
+		installedVersion("screenshot", "2.0.0"),
+		installedVersion("screenshot", "1.1.0"),
+		installedVersion("screenshot", "1.0.0"),
+	}
+	dependencies := map[string]map[string]string{
+		"html-report 2.0.0": {"screenshot": "^1.0"},
+		"screenshot 1.1.0":  {"java": "^0.3"},
+	}
+	dependenciesOf := func(p plugin.PluginInfo) (map[string]string, error) {
+		return dependencies[p.Name+" "+filepath.Base(p.Path)], nil
+	}
+	referenced := map[string]map[string]bool{"html-report": {"2.0.0": true}}
+
+	c.Assert(addDependenciesOfKeptVersions(installed, referenced, dependenciesOf), IsNil)
+
+	c.Assert(referenced, DeepEquals, map[string]map[string]bool{
+		"html-report": {"2.0.0": true},
+		"screenshot":  {"1.1.0": true},
+		"java":        {"0.3.0": true},
+	})
+	c.Assert(versionsToPrune(installed, referenced), DeepEquals, []plugin.PluginInfo{installed[6]})
+}
@@ -0,0 +1,135 @@
+// Copyright 2015 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+
+package install
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/subtle"
+	"crypto/x509"
+	"encoding/asn1"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"math/big"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/getgauge/gauge/config"
+	"github.com/getgauge/gauge/logger"
+)
+
+// verifyDownload checks the downloaded plugin zip against the checksum and signature given in its install description.
+// Signatures are verified only when a public key is configured, in which case they are mandatory.
+func verifyDownload(pluginZip string, versionInstallDescription *versionInstallDescription) error {
+	digest, err := sha256Of(pluginZip)
+	if err != nil {
+		return err
+	}
+	checksum := versionInstallDescription.Checksums.forCurrentPlatform()
+	if checksum == "" {
+		if config.RequirePluginChecksum() {
+			return errors.New("Checksum not specified in the install description.")
+		}
+		logger.Warning("Checksum not specified for %s, skipping checksum verification.", filepath.Base(pluginZip))
+	} else if err := verifyChecksum(digest, checksum); err != nil {
+		return err
+	}
+	publicKeyFile := config.PluginPublicKeyFile()
+	if publicKeyFile == "" {
+		return nil
+	}
+	signature := versionInstallDescription.Signatures.forCurrentPlatform()
+	if signature == "" {
+		return errors.New("Signature not specified in the install description.")
+	}
+	publicKey, err := readPublicKey(publicKeyFile)
+	if err != nil {
+		return err
+	}
+	return verifySignature(digest, signature, publicKey)
+}
+
+func sha256Of(fileName string) ([]byte, error) {
+	file, err := os.Open(fileName)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+	hash := sha256.New()
+	if _, err := io.Copy(hash, file); err != nil {
+		return nil, err
+	}
+	return hash.Sum(nil), nil
+}
+
+func verifyChecksum(digest []byte, checksum string) error {
+	expected, err := hex.DecodeString(strings.TrimSpace(checksum))
+	if err != nil || len(expected) != sha256.Size {
+		return fmt.Errorf("Invalid SHA-256 checksum %s in the install description.", checksum)
+	}
+	if subtle.ConstantTimeCompare(digest, expected) != 1 {
+		return fmt.Errorf("Checksum mismatch. Expected %s, got %s.", strings.ToLower(strings.TrimSpace(checksum)), hex.EncodeToString(digest))
+	}
+	return nil
+}
+
+func readPublicKey(publicKeyFile string) (crypto.PublicKey, error) {
+	contents, err := ioutil.ReadFile(publicKeyFile)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to read public key %s. %s", publicKeyFile, err.Error())
+	}
+	block, _ := pem.Decode(contents)
+	if block == nil {
+		return nil, fmt.Errorf("Public key %s is not PEM encoded.", publicKeyFile)
+	}
+	publicKey, err := x509.ParsePKIXPublicKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to parse public key %s. %s", publicKeyFile, err.Error())
+	}
+	return publicKey, nil
+}
+
+// verifySignature verifies an RSA PKCS #1 v1.5 or ASN.1 encoded ECDSA signature of the SHA-256 digest.
+func verifySignature(digest []byte, signature string, publicKey crypto.PublicKey) error {
+	signatureBytes, err := base64.StdEncoding.DecodeString(strings.TrimSpace(signature))
+	if err != nil {
+		return fmt.Errorf("Invalid signature in the install description. %s", err.Error())
+	}
+	switch key := publicKey.(type) {
+	case *rsa.PublicKey:
+		if err := rsa.VerifyPKCS1v15(key, crypto.SHA256, digest, signatureBytes); err != nil {
+			return errors.New("Signature verification failed.")
+		}
+	case *ecdsa.PublicKey:
+		var ecdsaSignature struct{ R, S *big.Int }
+		if _, err := asn1.Unmarshal(signatureBytes, &ecdsaSignature); err != nil || !ecdsa.Verify(key, digest, ecdsaSignature.R, ecdsaSignature.S) {
+			return errors.New("Signature verification failed.")
+		}
+	default:
+		return fmt.Errorf("Unsupported public key type %T, only RSA and ECDSA keys are supported.", publicKey)
+	}
+	return nil
+}
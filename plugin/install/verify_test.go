@@ -0,0 +1,138 @@
+// Copyright 2015 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+
+package install
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/asn1"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/pem"
+	"io/ioutil"
+	"math/big"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+
+	. "gopkg.in/check.v1"
+)
+
+func createPluginZip(c *C, contents string) (string, []byte) {
+	dir, err := ioutil.TempDir("", "gaugePlugin")
+	c.Assert(err, IsNil)
+	zipFile := filepath.Join(dir, "plugin-1.0.0.zip")
+	c.Assert(ioutil.WriteFile(zipFile, []byte(contents), 0644), IsNil)
+	digest := sha256.Sum256([]byte(contents))
+	return zipFile, digest[:]
+}
+
+func writePublicKey(c *C, dir string, publicKey crypto.PublicKey) string {
+	der, err := x509.MarshalPKIXPublicKey(publicKey)
+	c.Assert(err, IsNil)
+	keyFile := filepath.Join(dir, "public.pem")
+	c.Assert(ioutil.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}), 0644), IsNil)
+	return keyFile
+}
+
+func forAllPlatforms(value string) platformSpecificValues {
+	values := osSpecificValues{Windows: value, Linux: value, Darwin: value}
+	return platformSpecificValues{X86: values, X64: values}
+}
+
+func (s *MySuite) TestVerifyChecksum(c *C) {
+	zipFile, digest := createPluginZip(c, "plugin contents")
+	defer os.RemoveAll(filepath.Dir(zipFile))
+
+	c.Assert(verifyChecksum(digest, strings.ToUpper(hex.EncodeToString(digest))), IsNil)
+	c.Assert(verifyChecksum(digest, hex.EncodeToString(make([]byte, sha256.Size))), ErrorMatches, "Checksum mismatch. Expected 0+, got "+hex.EncodeToString(digest)+".")
+	c.Assert(verifyChecksum(digest, "not a checksum"), ErrorMatches, "Invalid SHA-256 checksum .*")
+}
+
+func (s *MySuite) TestVerifyDownloadFailsOnChecksumMismatch(c *C) {
+	zipFile, _ := createPluginZip(c, "plugin contents")
+	defer os.RemoveAll(filepath.Dir(zipFile))
+	otherDigest := sha256.Sum256([]byte("tampered contents"))
+
+	err := verifyDownload(zipFile, &versionInstallDescription{Checksums: forAllPlatforms(hex.EncodeToString(otherDigest[:]))})
+
+	c.Assert(err, ErrorMatches, "Checksum mismatch.*")
+}
+
+func (s *MySuite) TestVerifyDownloadWithoutChecksumIsAllowedByDefault(c *C) {
+	zipFile, _ := createPluginZip(c, "plugin contents")
+	defer os.RemoveAll(filepath.Dir(zipFile))
+
+	c.Assert(verifyDownload(zipFile, &versionInstallDescription{}), IsNil)
+}
+
+func (s *MySuite) TestChecksumForCurrentPlatform(c *C) {
+	checksums := platformSpecificValues{X86: forAllPlatforms("x86").X86, X64: forAllPlatforms("x64").X64}
+
+	if strings.Contains(runtime.GOARCH, "64") {
+		c.Assert(checksums.forCurrentPlatform(), Equals, "x64")
+	} else {
+		c.Assert(checksums.forCurrentPlatform(), Equals, "x86")
+	}
+}
+
+func (s *MySuite) TestVerifyRSASignature(c *C) {
+	zipFile, digest := createPluginZip(c, "plugin contents")
+	defer os.RemoveAll(filepath.Dir(zipFile))
+	privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	c.Assert(err, IsNil)
+	signature, err := rsa.SignPKCS1v15(rand.Reader, privateKey, crypto.SHA256, digest)
+	c.Assert(err, IsNil)
+	publicKey, err := readPublicKey(writePublicKey(c, filepath.Dir(zipFile), &privateKey.PublicKey))
+	c.Assert(err, IsNil)
+
+	c.Assert(verifySignature(digest, base64.StdEncoding.EncodeToString(signature), publicKey), IsNil)
+	tampered := sha256.Sum256([]byte("tampered contents"))
+	c.Assert(verifySignature(tampered[:], base64.StdEncoding.EncodeToString(signature), publicKey), ErrorMatches, "Signature verification failed.")
+}
+
+func (s *MySuite) TestVerifyECDSASignature(c *C) {
+	zipFile, digest := createPluginZip(c, "plugin contents")
+	defer os.RemoveAll(filepath.Dir(zipFile))
+	privateKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	c.Assert(err, IsNil)
+	r, sig, err := ecdsa.Sign(rand.Reader, privateKey, digest)
+	c.Assert(err, IsNil)
+	signature, err := asn1.Marshal(struct{ R, S *big.Int }{r, sig})
+	c.Assert(err, IsNil)
+	publicKey, err := readPublicKey(writePublicKey(c, filepath.Dir(zipFile), &privateKey.PublicKey))
+	c.Assert(err, IsNil)
+
+	c.Assert(verifySignature(digest, base64.StdEncoding.EncodeToString(signature), publicKey), IsNil)
+	c.Assert(verifySignature(digest, base64.StdEncoding.EncodeToString([]byte("garbage")), publicKey), ErrorMatches, "Signature verification failed.")
+}
+
+func (s *MySuite) TestReadPublicKeyFailsForNonPEMFile(c *C) {
+	zipFile, _ := createPluginZip(c, "plugin contents")
+	defer os.RemoveAll(filepath.Dir(zipFile))
+
+	_, err := readPublicKey(zipFile)
+
+	c.Assert(err, ErrorMatches, "Public key .* is not PEM encoded.")
+}
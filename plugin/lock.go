@@ -0,0 +1,74 @@
+// Copyright 2015 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+
+package plugin
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"github.com/getgauge/common"
+	"github.com/getgauge/gauge/manifest"
+	"github.com/getgauge/gauge/version"
+)
+
+func getProjectLock() (*manifest.Lock, error) {
+	return manifest.ProjectLock()
+}
+
+func getPluginEntry(entry string) (string, string) {
+	return manifest.ParsePluginEntry(entry)
+}
+
+// getVersionToUse returns the version of the plugin the project is locked to. Plugins which are not locked use the
+// latest installed version satisfying their constraint, an empty version meaning the latest installed one.
+func getVersionToUse(pluginID, constraintText string, lock *manifest.Lock) (string, error) {
+	if lock != nil {
+		if locked := lock.Find(pluginID); locked != nil {
+			if !IsPluginInstalled(pluginID, locked.Version) {
+				return "", fmt.Errorf("Version %s in %s is not installed. To install, run `gauge --install-all`.", locked.Version, manifest.LockFile)
+			}
+			return locked.Version, nil
+		}
+	}
+	if constraintText == "" {
+		return "", nil
+	}
+	constraint, err := version.ParseConstraint(constraintText)
+	if err != nil {
+		return "", err
+	}
+	installedVersion, err := GetLatestInstalledVersion(pluginID, constraint)
+	if err != nil {
+		return "", fmt.Errorf("%s. To install, run `gauge --install-all`.", err.Error())
+	}
+	return installedVersion, nil
+}
+
+// GetLatestInstalledVersion returns the latest installed version of the plugin satisfying the constraint. A nil
+// constraint is satisfied by any version.
+func GetLatestInstalledVersion(pluginID string, constraint *version.Constraint) (string, error) {
+	pluginsInstallDir, err := common.GetPluginsInstallDir(pluginID)
+	if err != nil {
+		return "", err
+	}
+	pluginInfo, err := getLatestInstalledPluginSatisfying(filepath.Join(pluginsInstallDir, pluginID), constraint)
+	if err != nil {
+		return "", err
+	}
+	return filepath.Base(pluginInfo.Path), nil
+}
@@ -45,8 +45,9 @@ import (
 )
 
 const (
-	executionScope          = "execution"
-	pluginConnectionPortEnv = "plugin_connection_port"
+	executionScope            = "execution"
+	pluginConnectionPortEnv   = "plugin_connection_port"
+	pluginConnectionSocketEnv = "plugin_connection_socket"
 )
 
 type pluginDescriptor struct {
@@ -61,6 +62,8 @@ type pluginDescriptor struct {
 	}
 	Scope               []string
 	GaugeVersionSupport version.VersionSupport
+	Framing             []string
+	Transports          []string
 	pluginPath          string
 }
 
@@ -142,7 +145,7 @@ func getPluginJSONPath(pluginName, pluginVersion string) (string, error) {
 		return "", fmt.Errorf("Plugin %s %s is not installed", pluginName, pluginVersion)
 	}
 
-	pluginInstallDir, err := GetInstallDir(pluginName, "")
+	pluginInstallDir, err := GetInstallDir(pluginName, pluginVersion)
 	if err != nil {
 		return "", err
 	}
@@ -223,7 +226,7 @@ func setEnvironmentProperties(properties map[string]string) error {
 }
 
 func IsPluginAdded(manifest *manifest.Manifest, descriptor *pluginDescriptor) bool {
-	for _, pluginID := range manifest.Plugins {
+	for _, pluginID := range manifest.PluginNames() {
 		if pluginID == descriptor.ID {
 			return true
 		}
@@ -236,8 +239,18 @@ func startPluginsForExecution(manifest *manifest.Manifest) (*Handler, []string)
 	handler := &Handler{}
 	envProperties := make(map[string]string)
 
-	for _, pluginID := range manifest.Plugins {
-		pd, err := GetPluginDescriptor(pluginID, "")
+	lock, err := getProjectLock()
+	if err != nil {
+		warnings = append(warnings, err.Error())
+	}
+	for _, entry := range manifest.Plugins {
+		pluginID, constraint := getPluginEntry(entry)
+		pluginVersion, err := getVersionToUse(pluginID, constraint, lock)
+		if err != nil {
+			warnings = append(warnings, fmt.Sprintf("Error starting plugin %s. %s", pluginID, err.Error()))
+			continue
+		}
+		pd, err := GetPluginDescriptor(pluginID, pluginVersion)
 		if err != nil {
 			warnings = append(warnings, fmt.Sprintf("Error starting plugin %s. Failed to get plugin.json. %s. To install, run `gauge --install %s`.", pluginID, err.Error(), pluginID))
 			continue
@@ -248,24 +261,36 @@ func startPluginsForExecution(manifest *manifest.Manifest) (*Handler, []string)
 			continue
 		}
 		if isExecutionScopePlugin(pd) {
-			gaugeConnectionHandler, err := conn.NewGaugeConnectionHandler(0, nil)
+			gaugeConnectionHandler, err := newConnectionHandler(pd)
 			if err != nil {
 				warnings = append(warnings, err.Error())
 				continue
 			}
-			envProperties[pluginConnectionPortEnv] = strconv.Itoa(gaugeConnectionHandler.ConnectionPortNumber())
+			envProperties[pluginConnectionPortEnv] = ""
+			envProperties[pluginConnectionSocketEnv] = gaugeConnectionHandler.SocketPath()
+			if envProperties[pluginConnectionSocketEnv] == "" {
+				envProperties[pluginConnectionPortEnv] = strconv.Itoa(gaugeConnectionHandler.ConnectionPortNumber())
+			}
+			framing := conn.Negotiate(conn.OfferedFraming(), pd.Framing)
+			for name, value := range framing.Env() {
+				envProperties[name] = value
+			}
+			gaugeConnectionHandler.UseFraming(framing)
 			err = SetEnvForPlugin(executionScope, pd, manifest, envProperties)
 			if err != nil {
 				warnings = append(warnings, fmt.Sprintf("Error setting environment for plugin %s %s. %s", pd.Name, pd.Version, err.Error()))
+				gaugeConnectionHandler.Close()
 				continue
 			}
 
 			plugin, err := StartPlugin(pd, executionScope)
 			if err != nil {
 				warnings = append(warnings, fmt.Sprintf("Error starting plugin %s %s. %s", pd.Name, pd.Version, err.Error()))
+				gaugeConnectionHandler.Close()
 				continue
 			}
 			pluginConnection, err := gaugeConnectionHandler.AcceptConnection(config.PluginConnectionTimeout(), make(chan error))
+			gaugeConnectionHandler.Close()
 			if err != nil {
 				warnings = append(warnings, fmt.Sprintf("Error starting plugin %s %s. Failed to connect to plugin. %s", pd.Name, pd.Version, err.Error()))
 				plugin.pluginCmd.Process.Kill()
@@ -279,6 +304,13 @@ func startPluginsForExecution(manifest *manifest.Manifest) (*Handler, []string)
 	return handler, warnings
 }
 
+func newConnectionHandler(pd *pluginDescriptor) (*conn.GaugeConnectionHandler, error) {
+	if conn.UseUnixSocket(pd.Transports) {
+		return conn.NewGaugeSocketConnectionHandler("", nil)
+	}
+	return conn.NewGaugeConnectionHandler(0, nil)
+}
+
 func isExecutionScopePlugin(pd *pluginDescriptor) bool {
 	for _, scope := range pd.Scope {
 		if strings.ToLower(scope) == executionScope {
@@ -349,29 +381,29 @@ func StartPlugins(manifest *manifest.Manifest) *Handler {
 }
 
 func getLatestInstalledPlugin(pluginDir string) (*PluginInfo, error) {
-	files, err := ioutil.ReadDir(pluginDir)
+	return getLatestInstalledPluginSatisfying(pluginDir, nil)
+}
+
+// getLatestInstalledPluginSatisfying returns the latest installed version of the plugin which satisfies the
+// constraint. A nil constraint is satisfied by any version.
+func getLatestInstalledPluginSatisfying(pluginDir string, constraint *version.Constraint) (*PluginInfo, error) {
+	installedVersions, err := getInstalledVersions(pluginDir)
 	if err != nil {
-		return nil, fmt.Errorf("Error listing files in plugin directory %s: %s", pluginDir, err.Error())
+		return nil, err
 	}
 	versionToPlugins := make(map[*version.Version][]PluginInfo, 0)
 	pluginName := filepath.Base(pluginDir)
 
-	for _, file := range files {
-		if file.IsDir() {
-			var v *version.Version
-			var err error
-			if strings.Contains(file.Name(), "nightly") {
-				v, err = version.ParseVersion(file.Name()[:strings.LastIndex(file.Name(), ".")])
-			} else {
-				v, err = version.ParseVersion(file.Name())
-			}
-			if err == nil {
-				versionToPlugins[v] = append(versionToPlugins[v], PluginInfo{pluginName, v, filepath.Join(pluginDir, file.Name())})
-			}
+	for _, p := range installedVersions {
+		if constraint.IsSatisfiedBy(p.Version) {
+			versionToPlugins[p.Version] = append(versionToPlugins[p.Version], p)
 		}
 	}
 
 	if len(versionToPlugins) < 1 {
+		if constraint != nil {
+			return nil, fmt.Errorf("No versions of plugin %s satisfying %s found in %s", pluginName, constraint, pluginDir)
+		}
 		return nil, fmt.Errorf("No valid versions of plugin %s found in %s", pluginName, pluginDir)
 	}
 	var availableVersions []*version.Version
@@ -383,6 +415,31 @@ func getLatestInstalledPlugin(pluginDir string) (*PluginInfo, error) {
 	return &latestBuild, nil
 }
 
+// getInstalledVersions returns the versions of the plugin installed in the plugin directory.
+func getInstalledVersions(pluginDir string) ([]PluginInfo, error) {
+	files, err := ioutil.ReadDir(pluginDir)
+	if err != nil {
+		return nil, fmt.Errorf("Error listing files in plugin directory %s: %s", pluginDir, err.Error())
+	}
+	var installedVersions []PluginInfo
+	pluginName := filepath.Base(pluginDir)
+	for _, file := range files {
+		if file.IsDir() {
+			var v *version.Version
+			var err error
+			if strings.Contains(file.Name(), "nightly") {
+				v, err = version.ParseVersion(file.Name()[:strings.LastIndex(file.Name(), ".")])
+			} else {
+				v, err = version.ParseVersion(file.Name())
+			}
+			if err == nil {
+				installedVersions = append(installedVersions, PluginInfo{pluginName, v, filepath.Join(pluginDir, file.Name())})
+			}
+		}
+	}
+	return installedVersions, nil
+}
+
 func getLatestOf(plugins []PluginInfo, latestVersion *version.Version) PluginInfo {
 	for _, v := range plugins {
 		if v.Path == latestVersion.String() {
@@ -431,6 +488,34 @@ func GetAllInstalledPluginsWithVersion() ([]PluginInfo, error) {
 	return sortPlugins(allPlugins), nil
 }
 
+// GetAllInstalledVersions returns every installed version of every plugin, sorted by plugin name and then by
+// version, latest first.
+func GetAllInstalledVersions() ([]PluginInfo, error) {
+	pluginInstallPrefixes, err := common.GetPluginInstallPrefixes()
+	if err != nil {
+		return nil, err
+	}
+	var allVersions []PluginInfo
+	for _, prefix := range pluginInstallPrefixes {
+		files, err := ioutil.ReadDir(prefix)
+		if err != nil {
+			return nil, err
+		}
+		for _, file := range files {
+			if !file.IsDir() {
+				continue
+			}
+			installedVersions, err := getInstalledVersions(filepath.Join(prefix, file.Name()))
+			if err != nil {
+				continue
+			}
+			allVersions = append(allVersions, installedVersions...)
+		}
+	}
+	sort.Sort(byNameAndVersion(allVersions))
+	return allVersions, nil
+}
+
 type PluginInfo struct {
 	Name    string
 	Version *version.Version
@@ -454,6 +539,20 @@ func sortPlugins(allPlugins map[string]PluginInfo) []PluginInfo {
 	return installedPlugins
 }
 
+type byNameAndVersion []PluginInfo
+
+func (a byNameAndVersion) Len() int      { return len(a) }
+func (a byNameAndVersion) Swap(i, j int) { a[i], a[j] = a[j], a[i] }
+func (a byNameAndVersion) Less(i, j int) bool {
+	if a[i].Name != a[j].Name {
+		return a[i].Name < a[j].Name
+	}
+	if !a[i].Version.IsEqualTo(a[j].Version) {
+		return a[i].Version.IsGreaterThan(a[j].Version)
+	}
+	return a[i].Path > a[j].Path
+}
+
 type byPath []PluginInfo
 
 func (a byPath) Len() int      { return len(a) }
@@ -492,7 +591,15 @@ func GetInstallDir(pluginName, version string) (string, error) {
 }
 
 func GetLanguageJSONFilePath(language string) (string, error) {
-	languageInstallDir, err := GetInstallDir(language, "")
+	lock, err := getProjectLock()
+	if err != nil {
+		lock = nil
+	}
+	languageVersion, err := getVersionToUse(language, "", lock)
+	if err != nil {
+		return "", fmt.Errorf("Failed to find the implementation for: %s. %s", language, err.Error())
+	}
+	languageInstallDir, err := GetInstallDir(language, languageVersion)
 	if err != nil {
 		return "", err
 	}
@@ -20,6 +20,7 @@ package plugin
 import (
 	"fmt"
 	"path/filepath"
+	"sort"
 	"testing"
 
 	"github.com/getgauge/gauge/version"
@@ -94,6 +95,37 @@ func (s *MySuite) TestGetLatestInstalledPluginIfNoPluginsFound(c *C) {
 	c.Assert(err.Error(), Equals, fmt.Sprintf("No valid versions of plugin %s found in %s", testData, path))
 }
 
+func (s *MySuite) TestGetLatestInstalledPluginSatisfyingConstraint(c *C) {
+	path, _ := filepath.Abs(filepath.Join("_testdata", "java"))
+	constraint, _ := version.ParseConstraint("~1.0")
+
+	latestPlugin, err := getLatestInstalledPluginSatisfying(path, constraint)
+
+	c.Assert(err, Equals, nil)
+	c.Assert(latestPlugin.Path, Equals, filepath.Join(path, "1.0.3"))
+}
+
+func (s *MySuite) TestGetLatestInstalledPluginIfNoVersionSatisfiesConstraint(c *C) {
+	path, _ := filepath.Abs(filepath.Join("_testdata", "java"))
+	constraint, _ := version.ParseConstraint("^2.0")
+
+	_, err := getLatestInstalledPluginSatisfying(path, constraint)
+
+	c.Assert(err.Error(), Equals, fmt.Sprintf("No versions of plugin java satisfying ^2.0 found in %s", path))
+}
+
+func (s *MySuite) TestGetInstalledVersions(c *C) {
+	path, _ := filepath.Abs(filepath.Join("_testdata", "java"))
+
+	installedVersions, err := getInstalledVersions(path)
+	sort.Sort(byNameAndVersion(installedVersions))
+
+	c.Assert(err, Equals, nil)
+	c.Assert(len(installedVersions), Equals, 2)
+	c.Assert(installedVersions[0].Path, Equals, filepath.Join(path, "1.2.0"))
+	c.Assert(installedVersions[1].Path, Equals, filepath.Join(path, "1.0.3"))
+}
+
 func (s *MySuite) TestGetPluginDescriptorFromJSON(c *C) {
 	testData := "_testdata"
 	path, _ := filepath.Abs(testData)
@@ -3,6 +3,7 @@ package projectInit
 import (
 	"encoding/json"
 	"fmt"
+	"io/ioutil"
 	"os"
 	"path"
 	"path/filepath"
@@ -25,47 +26,59 @@ const (
 	skelFileName      = "example.spec"
 	envDefaultDirName = "default"
 	metadataFileName  = "metadata.json"
+	templatesDirName  = "templates"
+	zipExt            = ".zip"
 )
 
 var defaultPlugins = []string{"html-report"}
 
+// templateMetadata is read from the metadata.json of a template. Language is the language runner of the template.
+// Only official templates can leave it out, it then defaults to the prefix of the template name, e.g. java for
+// java_maven.
 type templateMetadata struct {
 	Name           string
 	Description    string
 	Version        string
+	Language       string
 	PostInstallCmd string
+	Variables      []templateVariable
 }
 
-func initializeTemplate(templateName string) error {
-	tempDir := common.GetTempDir()
-	defer util.Remove(tempDir)
-	unzippedTemplate, err := util.DownloadAndUnzip(getTemplateURL(templateName), tempDir)
+func readTemplateMetadata(templateDir string) (*templateMetadata, error) {
+	metadataFile := filepath.Join(templateDir, metadataFileName)
+	metadata := &templateMetadata{}
+	if !common.FileExists(metadataFile) {
+		return metadata, nil
+	}
+	metadataContents, err := common.ReadFileContents(metadataFile)
 	if err != nil {
-		return err
+		return nil, fmt.Errorf("Failed to read file contents of %s: %s", metadataFile, err.Error())
+	}
+	if err = json.Unmarshal([]byte(metadataContents), metadata); err != nil {
+		return nil, fmt.Errorf("Failed to read %s: %s", metadataFile, err.Error())
 	}
+	return metadata, nil
+}
 
+func initializeTemplate(templateName, templateDir string, metadata *templateMetadata, templateArgs map[string]string) error {
 	wd := config.ProjectRoot
 
-	logger.Info("Copying Gauge template %s to current directory ...", templateName)
-	filesAdded, err := common.MirrorDir(filepath.Join(unzippedTemplate, templateName), wd)
+	values, err := templateVariableValues(metadata.Variables, templateArgs, wd)
 	if err != nil {
-		return fmt.Errorf("Failed to copy Gauge template: %s", err.Error())
+		return err
 	}
-
-	metadataFile := filepath.Join(wd, metadataFileName)
-	metadataContents, err := common.ReadFileContents(metadataFile)
-	if err != nil {
-		return fmt.Errorf("Failed to read file contents of %s: %s", metadataFile, err.Error())
+	if err := substituteVariables(templateDir, values); err != nil {
+		return fmt.Errorf("Failed to substitute template variables: %s", err.Error())
 	}
 
-	metadata := &templateMetadata{}
-	err = json.Unmarshal([]byte(metadataContents), metadata)
+	logger.Info("Copying Gauge template %s to current directory ...", templateName)
+	filesAdded, err := common.MirrorDir(templateDir, wd)
 	if err != nil {
-		return err
+		return fmt.Errorf("Failed to copy Gauge template: %s", err.Error())
 	}
 
 	if metadata.PostInstallCmd != "" {
-		command := strings.Fields(metadata.PostInstallCmd)
+		command := strings.Fields(substitute(metadata.PostInstallCmd, values))
 		cmd, err := common.ExecuteSystemCommand(command, wd, os.Stdout, os.Stderr)
 		cmd.Wait()
 		if err != nil {
@@ -77,32 +90,61 @@ func initializeTemplate(templateName string) error {
 		}
 	}
 
-	util.Remove(metadataFile)
+	util.Remove(filepath.Join(wd, metadataFileName))
 	return nil
 }
 
 func getTemplateURL(templateName string) string {
-	return config.GaugeTemplatesUrl() + "/" + templateName + ".zip"
+	return config.GaugeTemplatesUrl() + "/" + templateName + zipExt
 }
 
 func getTemplateLangauge(templateName string) string {
 	return strings.Split(templateName, "_")[0]
 }
 
-// InitializeProject initializes a Gauge project with specified template
-func InitializeProject(templateName string) {
+// templateLanguage returns the language runner of a template. The names of local and registered templates are
+// chosen by their authors, so they have to set the language in their metadata.
+func templateLanguage(templateName string, metadata *templateMetadata, official bool) (string, error) {
+	if metadata.Language != "" {
+		return metadata.Language, nil
+	}
+	if !official {
+		return "", fmt.Errorf("Template %s does not declare its language. Set the language runner of the template, e.g. \"language\": \"java\", in its %s.", templateName, metadataFileName)
+	}
+	return getTemplateLangauge(templateName), nil
+}
+
+// InitializeProject initializes a Gauge project with specified template. The template can be the name of a
+// template or the path of a local template directory or zip. Template arguments are comma separated values of the
+// variables declared by the template, e.g. packageName=com.example.
+func InitializeProject(template string, templateArgs string) {
 	wd, err := os.Getwd()
 	if err != nil {
 		logger.Fatalf("Failed to find working directory. %s", err.Error())
 	}
 	config.ProjectRoot = wd
 
-	exists, _ := common.UrlExists(getTemplateURL(templateName))
-
-	if exists {
-		err = initializeTemplate(templateName)
-	} else {
-		err = createProjectTemplate(templateName)
+	args, err := parseTemplateArgs(templateArgs)
+	if err != nil {
+		logger.Fatalf("Failed to initialize project. %s", err.Error())
+	}
+	tempDir := common.GetTempDir()
+	defer util.Remove(tempDir)
+	templateName := templateNameOf(template)
+	language := getTemplateLangauge(templateName)
+	templateDir, official, err := getTemplateDir(template, tempDir)
+	if err == nil && templateDir != "" {
+		var metadata *templateMetadata
+		if metadata, err = readTemplateMetadata(templateDir); err == nil {
+			if language, err = templateLanguage(templateName, metadata, official); err == nil {
+				err = initializeTemplate(templateName, templateDir, metadata, args)
+			}
+		}
+	} else if err == nil {
+		if len(args) > 0 {
+			logger.Warning("Template arguments are ignored, %s is not a template with variables.", template)
+		}
+		err = createProjectTemplate(language)
 	}
 
 	if err != nil {
@@ -110,7 +152,6 @@ func InitializeProject(templateName string) {
 	}
 	logger.Info("Successfully initialized the project. Run specifications with \"gauge specs/\"\n")
 
-	language := getTemplateLangauge(templateName)
 	if !install.IsCompatiblePluginInstalled(language, true) {
 		logger.Info("Compatible langauge plugin %s is not installed. Installing plugin...", language)
 
@@ -118,31 +159,71 @@ func InitializeProject(templateName string) {
 	}
 }
 
-// ListTemplates lists all the Gauge templates available in GaugeTemplatesURL
+// ListTemplates lists the Gauge templates available in GaugeTemplatesURL, the templates downloaded earlier and the
+// templates registered with --register-template.
 func ListTemplates() {
+	remote, err := getTemplates()
+	if err != nil {
+		logger.Warning(err.Error())
+	}
+	registered, err := getRegisteredTemplates()
+	if err != nil {
+		logger.Warning("Failed to read registered templates. %s", err.Error())
+	}
+	for _, template := range mergeTemplates(remote, getCachedTemplates(), registered) {
+		logger.Info(template.String())
+	}
+	logger.Info("\nRun `gauge --init <template_name>` to create a new Gauge project.")
+}
+
+// MirrorTemplates copies the template zips available in GaugeTemplatesURL to the templates directory of mirrorDir.
+// The directory can then be used as the templates URL through a file:// URL.
+func MirrorTemplates(mirrorDir string) error {
+	templates, err := getTemplates()
+	if err != nil {
+		return err
+	}
+	templatesDir := filepath.Join(mirrorDir, templatesDirName)
+	if err := os.MkdirAll(templatesDir, common.NewDirectoryPermissions); err != nil {
+		return err
+	}
+	for _, template := range templates {
+		if exists, _ := util.UrlExists(getTemplateURL(template)); !exists {
+			continue
+		}
+		if _, err := util.Download(getTemplateURL(template), templatesDir); err != nil {
+			return fmt.Errorf("Failed to download template %s. %s", template, err.Error())
+		}
+		logger.Info("Mirrored template %s", template)
+	}
+	return nil
+}
+
+func getTemplates() ([]string, error) {
 	templatesURL := config.GaugeTemplatesUrl()
+	if util.IsFileURL(templatesURL) {
+		templatesDir, err := util.FilePathOf(templatesURL)
+		if err != nil {
+			return nil, err
+		}
+		return getTemplateNamesIn(templatesDir)
+	}
 	_, err := common.UrlExists(templatesURL)
 	if err != nil {
-		logger.Fatalf("Gauge templates URL is not reachable: %s", err.Error())
+		return nil, fmt.Errorf("Gauge templates URL is not reachable: %s", err.Error())
 	}
 	tempDir := common.GetTempDir()
 	defer util.Remove(tempDir)
 	templatesPage, err := util.Download(templatesURL, tempDir)
 	if err != nil {
-		util.Remove(tempDir)
-		logger.Fatalf("Error occurred while downloading templates list: %s", err.Error())
+		return nil, fmt.Errorf("Error occurred while downloading templates list: %s", err.Error())
 	}
 
 	templatePageContents, err := common.ReadFileContents(templatesPage)
 	if err != nil {
-		util.Remove(tempDir)
-		logger.Fatalf("Failed to read contents of file %s: %s", templatesPage, err.Error())
+		return nil, fmt.Errorf("Failed to read contents of file %s: %s", templatesPage, err.Error())
 	}
-	templates := getTemplateNames(templatePageContents)
-	for _, template := range templates {
-		logger.Info(template)
-	}
-	logger.Info("\nRun `gauge --init <template_name>` to create a new Gauge project.")
+	return getTemplateNames(templatePageContents), nil
 }
 
 func getTemplateNames(text string) []string {
@@ -152,12 +233,23 @@ func getTemplateNames(text string) []string {
 	for _, match := range submatches {
 		matches = append(matches, match[1])
 	}
+	return withOtherTemplates(matches)
+}
+
+func getTemplateNamesIn(templatesDir string) ([]string, error) {
+	if _, err := ioutil.ReadDir(templatesDir); err != nil {
+		return nil, fmt.Errorf("Failed to read templates directory %s: %s", templatesDir, err.Error())
+	}
+	return withOtherTemplates(zipNamesIn(templatesDir)), nil
+}
+
+func withOtherTemplates(templates []string) []string {
 	// add other templates
-	matches = append(matches, "csharp")
-	matches = append(matches, "ruby")
+	templates = append(templates, "csharp")
+	templates = append(templates, "ruby")
 
-	sort.Strings(matches)
-	return matches
+	sort.Strings(templates)
+	return templates
 }
 
 func showMessage(action, filename string) {
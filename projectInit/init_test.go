@@ -18,6 +18,9 @@
 package projectInit
 
 import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
 	"testing"
 
 	. "gopkg.in/check.v1"
@@ -62,3 +65,30 @@ func (s *MySuite) TestGetTemplateLanguage(c *C) {
 	c.Assert(getTemplateLangauge("java_maven"), Equals, "java")
 	c.Assert(getTemplateLangauge("java_maven_selenium"), Equals, "java")
 }
+
+func (s *MySuite) TestTemplateLanguageIsRequiredForUnofficialTemplates(c *C) {
+	language, err := templateLanguage("my_template", &templateMetadata{Language: "ruby"}, false)
+	c.Assert(err, IsNil)
+	c.Assert(language, Equals, "ruby")
+
+	language, err = templateLanguage("java_maven", &templateMetadata{}, true)
+	c.Assert(err, IsNil)
+	c.Assert(language, Equals, "java")
+
+	_, err = templateLanguage("my_template", &templateMetadata{}, false)
+	c.Assert(err, NotNil)
+}
+
+func (s *MySuite) TestGetTemplateNamesInDirectory(c *C) {
+	templatesDir, err := ioutil.TempDir("", "gaugeTemplates")
+	c.Assert(err, IsNil)
+	defer os.RemoveAll(templatesDir)
+	for _, file := range []string{"java.zip", "java_maven.zip", "metadata.json"} {
+		c.Assert(ioutil.WriteFile(filepath.Join(templatesDir, file), []byte{}, 0644), IsNil)
+	}
+
+	names, err := getTemplateNamesIn(templatesDir)
+
+	c.Assert(err, IsNil)
+	c.Assert(names, DeepEquals, []string{"csharp", "java", "java_maven", "ruby"})
+}
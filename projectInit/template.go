@@ -0,0 +1,412 @@
+package projectInit
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/getgauge/common"
+	"github.com/getgauge/gauge/logger"
+	"github.com/getgauge/gauge/util"
+)
+
+const (
+	registryFileName   = "registry.json"
+	projectNameVar     = "projectName"
+	packageNameVar     = "packageName"
+	packagePathVar     = "packagePath"
+	templateArgsSep    = ","
+	templateArgsAssign = "="
+)
+
+// templateVariable is a placeholder of the form {{name}} in the file contents and paths of a template.
+type templateVariable struct {
+	Name        string
+	Description string
+	Default     string
+}
+
+// templateSource is a template which can be used for --init, with where it comes from.
+type templateSource struct {
+	name     string
+	location string
+	kind     string
+}
+
+func (t templateSource) String() string {
+	switch t.kind {
+	case "":
+		return t.name
+	case registeredTemplate:
+		return fmt.Sprintf("%s (%s, %s)", t.name, t.kind, t.location)
+	default:
+		return fmt.Sprintf("%s (%s)", t.name, t.kind)
+	}
+}
+
+const (
+	cachedTemplate     = "cached"
+	registeredTemplate = "registered"
+)
+
+type byTemplateName []templateSource
+
+func (s byTemplateName) Len() int           { return len(s) }
+func (s byTemplateName) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
+func (s byTemplateName) Less(i, j int) bool { return s[i].name < s[j].name }
+
+// parseTemplateArgs parses comma separated values of template variables, e.g. packageName=com.example,projectName=foo.
+func parseTemplateArgs(templateArgs string) (map[string]string, error) {
+	values := make(map[string]string)
+	if strings.TrimSpace(templateArgs) == "" {
+		return values, nil
+	}
+	for _, arg := range strings.Split(templateArgs, templateArgsSep) {
+		parts := strings.SplitN(arg, templateArgsAssign, 2)
+		if len(parts) != 2 || strings.TrimSpace(parts[0]) == "" {
+			return nil, fmt.Errorf("Invalid template argument %s. Template arguments should be of the form name=value", strings.TrimSpace(arg))
+		}
+		values[strings.TrimSpace(parts[0])] = strings.TrimSpace(parts[1])
+	}
+	return values, nil
+}
+
+// templateVariableValues returns the values of the variables declared by the template. Values given by the user take
+// precedence over the defaults of the template, which take precedence over the values derived from the project.
+func templateVariableValues(variables []templateVariable, args map[string]string, projectRoot string) (map[string]string, error) {
+	derived := map[string]string{projectNameVar: filepath.Base(projectRoot)}
+	values := make(map[string]string)
+	for _, variable := range variables {
+		if value, ok := args[variable.Name]; ok {
+			values[variable.Name] = value
+		} else if variable.Default != "" {
+			values[variable.Name] = variable.Default
+		}
+	}
+	for name := range args {
+		if _, ok := values[name]; !ok {
+			return nil, fmt.Errorf("Template does not declare the variable %s", name)
+		}
+	}
+	if _, ok := values[projectNameVar]; ok {
+		derived[projectNameVar] = values[projectNameVar]
+	}
+	derived[packageNameVar] = packageNameOf(derived[projectNameVar])
+	if _, ok := values[packageNameVar]; ok {
+		derived[packageNameVar] = values[packageNameVar]
+	}
+	derived[packagePathVar] = strings.Replace(derived[packageNameVar], ".", "/", -1)
+	for _, variable := range variables {
+		if _, ok := values[variable.Name]; ok {
+			continue
+		}
+		value, ok := derived[variable.Name]
+		if !ok {
+			return nil, fmt.Errorf("No value given for template variable %s. %s", variable.Name, variable.Description)
+		}
+		values[variable.Name] = value
+	}
+	return values, nil
+}
+
+// packageNameOf derives a package name from the project name by dropping characters which are not allowed in one.
+func packageNameOf(projectName string) string {
+	name := regexp.MustCompile(`[^a-z0-9_]`).ReplaceAllString(strings.ToLower(projectName), "")
+	if name == "" || (name[0] >= '0' && name[0] <= '9') {
+		name = "project" + name
+	}
+	return name
+}
+
+func substitute(text string, values map[string]string) string {
+	for name, value := range values {
+		text = strings.Replace(text, "{{"+name+"}}", value, -1)
+	}
+	return text
+}
+
+// substituteVariables replaces the variables in the paths and the text files of the template directory.
+func substituteVariables(templateDir string, values map[string]string) error {
+	if len(values) == 0 {
+		return nil
+	}
+	var paths []string
+	err := filepath.Walk(templateDir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if path != templateDir {
+			paths = append(paths, path)
+		}
+		if info.IsDir() {
+			return nil
+		}
+		contents, err := ioutil.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		if !utf8.Valid(contents) || !strings.Contains(string(contents), "{{") {
+			return nil
+		}
+		return ioutil.WriteFile(path, []byte(substitute(string(contents), values)), info.Mode())
+	})
+	if err != nil {
+		return err
+	}
+	// Rename the deepest paths first so that renaming a directory does not move paths still to be renamed.
+	for i := len(paths) - 1; i >= 0; i-- {
+		relPath, err := filepath.Rel(templateDir, paths[i])
+		if err != nil {
+			return err
+		}
+		newName := substitute(filepath.Base(relPath), values)
+		if newName == filepath.Base(relPath) {
+			continue
+		}
+		newPath := filepath.Join(filepath.Dir(paths[i]), filepath.FromSlash(newName))
+		if err := os.MkdirAll(filepath.Dir(newPath), common.NewDirectoryPermissions); err != nil {
+			return err
+		}
+		if err := os.Rename(paths[i], newPath); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func isLocalTemplate(source string) bool {
+	return common.DirExists(source) || (common.FileExists(source) && filepath.Ext(source) == zipExt)
+}
+
+func templateNameOf(source string) string {
+	return strings.TrimSuffix(filepath.Base(source), zipExt)
+}
+
+// unpackTemplate makes a copy of a local template directory or zip in tempDir, in which variables can be substituted.
+func unpackTemplate(source, tempDir string) (string, error) {
+	unpackedDir := filepath.Join(tempDir, templateNameOf(source))
+	if common.DirExists(source) {
+		if _, err := common.MirrorDir(source, unpackedDir); err != nil {
+			return "", fmt.Errorf("Failed to copy template %s: %s", source, err.Error())
+		}
+		return unpackedDir, nil
+	}
+	unzippedDir, err := common.UnzipArchive(source, tempDir)
+	if err != nil {
+		return "", fmt.Errorf("Failed to unzip template %s: %s", source, err.Error())
+	}
+	return findTemplateRoot(unzippedDir), nil
+}
+
+// findTemplateRoot returns the directory holding the template metadata. Template zips usually hold the template in a
+// directory named after the template.
+func findTemplateRoot(dir string) string {
+	if common.FileExists(filepath.Join(dir, metadataFileName)) {
+		return dir
+	}
+	files, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return dir
+	}
+	var subDirs []string
+	for _, file := range files {
+		if file.IsDir() {
+			subDirs = append(subDirs, filepath.Join(dir, file.Name()))
+		}
+	}
+	if len(subDirs) == 1 {
+		return subDirs[0]
+	}
+	return dir
+}
+
+// templatesCacheDir is where downloaded templates are kept, so that projects can be initialized offline.
+func templatesCacheDir() (string, error) {
+	gaugeHome, err := common.GetGaugeHomeDirectory()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(gaugeHome, templatesDirName), nil
+}
+
+func cacheTemplate(templateZip string) {
+	cacheDir, err := templatesCacheDir()
+	if err == nil {
+		err = os.MkdirAll(cacheDir, common.NewDirectoryPermissions)
+	}
+	if err == nil {
+		err = common.CopyFile(templateZip, filepath.Join(cacheDir, filepath.Base(templateZip)))
+	}
+	if err != nil {
+		logger.Debug("Failed to cache template %s. %s", filepath.Base(templateZip), err.Error())
+	}
+}
+
+func getCachedTemplate(templateName string) string {
+	cacheDir, err := templatesCacheDir()
+	if err != nil {
+		return ""
+	}
+	cachedZip := filepath.Join(cacheDir, templateName+zipExt)
+	if !common.FileExists(cachedZip) {
+		return ""
+	}
+	return cachedZip
+}
+
+func getCachedTemplates() []templateSource {
+	cacheDir, err := templatesCacheDir()
+	if err != nil {
+		return nil
+	}
+	var templates []templateSource
+	for _, name := range zipNamesIn(cacheDir) {
+		templates = append(templates, templateSource{name: name, location: filepath.Join(cacheDir, name+zipExt), kind: cachedTemplate})
+	}
+	return templates
+}
+
+func zipNamesIn(dir string) []string {
+	files, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return nil
+	}
+	var names []string
+	for _, file := range files {
+		if !file.IsDir() && filepath.Ext(file.Name()) == zipExt {
+			names = append(names, strings.TrimSuffix(file.Name(), zipExt))
+		}
+	}
+	return names
+}
+
+func registryFile() (string, error) {
+	cacheDir, err := templatesCacheDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(cacheDir, registryFileName), nil
+}
+
+// getRegisteredTemplates returns the templates registered by the user, keyed by name. A template is a local
+// directory, a local zip or the URL of a zip.
+func getRegisteredTemplates() (map[string]string, error) {
+	registered := make(map[string]string)
+	file, err := registryFile()
+	if err != nil || !common.FileExists(file) {
+		return registered, err
+	}
+	contents, err := common.ReadFileContents(file)
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal([]byte(contents), &registered); err != nil {
+		return nil, fmt.Errorf("Failed to read %s: %s", file, err.Error())
+	}
+	return registered, nil
+}
+
+// RegisterTemplate makes a local template directory or zip, or the URL of a template zip, available to --init and
+// --list-templates by the given name.
+func RegisterTemplate(name, source string) error {
+	if !isLocalTemplate(source) && !strings.Contains(source, "://") {
+		return fmt.Errorf("%s is neither a template directory, a zip file nor a URL", source)
+	}
+	if isLocalTemplate(source) {
+		absSource, err := filepath.Abs(source)
+		if err != nil {
+			return err
+		}
+		source = absSource
+	}
+	registered, err := getRegisteredTemplates()
+	if err != nil {
+		return err
+	}
+	registered[name] = source
+	file, err := registryFile()
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(file), common.NewDirectoryPermissions); err != nil {
+		return err
+	}
+	b, err := json.MarshalIndent(registered, "", "  ")
+	if err != nil {
+		return err
+	}
+	return common.SaveFile(file, string(b), false)
+}
+
+// mergeTemplates lists every template once, preferring registered templates over remote ones and remote templates
+// over cached ones.
+func mergeTemplates(remote []string, cached []templateSource, registered map[string]string) []templateSource {
+	templates := make(map[string]templateSource)
+	for _, t := range cached {
+		templates[t.name] = t
+	}
+	for _, name := range remote {
+		templates[name] = templateSource{name: name}
+	}
+	for name, location := range registered {
+		templates[name] = templateSource{name: name, location: location, kind: registeredTemplate}
+	}
+	var merged []templateSource
+	for _, t := range templates {
+		merged = append(merged, t)
+	}
+	sort.Sort(byTemplateName(merged))
+	return merged
+}
+
+// getTemplateDir finds the template for --init and unpacks it in tempDir. The template is looked up as a local
+// directory or zip, a registered template, a remote template and lastly a cached template. An empty directory is
+// returned if no template is found. official tells if the template came from the templates URL.
+func getTemplateDir(template, tempDir string) (dir string, official bool, err error) {
+	if isLocalTemplate(template) {
+		dir, err = unpackTemplate(template, tempDir)
+		return dir, false, err
+	}
+	registered, err := getRegisteredTemplates()
+	if err != nil {
+		logger.Warning("Failed to read registered templates. %s", err.Error())
+	}
+	if source, ok := registered[template]; ok {
+		if isLocalTemplate(source) {
+			dir, err = unpackTemplate(source, tempDir)
+		} else {
+			dir, err = downloadTemplate(source, tempDir, false)
+		}
+		return dir, false, err
+	}
+	if exists, _ := util.UrlExists(getTemplateURL(template)); exists {
+		dir, err = downloadTemplate(getTemplateURL(template), tempDir, true)
+		return dir, true, err
+	}
+	if cachedZip := getCachedTemplate(template); cachedZip != "" {
+		logger.Info("Using cached template %s", template)
+		dir, err = unpackTemplate(cachedZip, tempDir)
+		return dir, true, err
+	}
+	return "", false, nil
+}
+
+// downloadTemplate downloads and unpacks a template zip. Only official templates are cached, as cached templates are
+// looked up by name.
+func downloadTemplate(templateURL, tempDir string, cache bool) (string, error) {
+	logger.Info("Downloading %s", filepath.Base(templateURL))
+	templateZip, err := util.Download(templateURL, tempDir)
+	if err != nil {
+		return "", err
+	}
+	if cache {
+		cacheTemplate(templateZip)
+	}
+	return unpackTemplate(templateZip, filepath.Join(tempDir, "unzipped"))
+}
@@ -0,0 +1,101 @@
+package projectInit
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	. "gopkg.in/check.v1"
+)
+
+func (s *MySuite) TestParseTemplateArgs(c *C) {
+	args, err := parseTemplateArgs("packageName = com.example, projectName=foo")
+
+	c.Assert(err, IsNil)
+	c.Assert(args, DeepEquals, map[string]string{"packageName": "com.example", "projectName": "foo"})
+
+	_, err = parseTemplateArgs("packageName")
+	c.Assert(err, ErrorMatches, "Invalid template argument packageName.*")
+}
+
+func (s *MySuite) TestTemplateVariableValuesAreDerivedFromProject(c *C) {
+	variables := []templateVariable{{Name: projectNameVar}, {Name: packageNameVar}, {Name: packagePathVar}, {Name: "driver", Default: "chrome"}}
+
+	values, err := templateVariableValues(variables, map[string]string{}, filepath.Join("work", "My-Project"))
+
+	c.Assert(err, IsNil)
+	c.Assert(values, DeepEquals, map[string]string{projectNameVar: "My-Project", packageNameVar: "myproject", packagePathVar: "myproject", "driver": "chrome"})
+}
+
+func (s *MySuite) TestTemplateVariableValuesGivenByUser(c *C) {
+	variables := []templateVariable{{Name: packageNameVar}, {Name: packagePathVar}, {Name: "driver", Default: "chrome"}}
+
+	values, err := templateVariableValues(variables, map[string]string{packageNameVar: "com.example.tests", "driver": "firefox"}, "project")
+
+	c.Assert(err, IsNil)
+	c.Assert(values, DeepEquals, map[string]string{packageNameVar: "com.example.tests", packagePathVar: "com/example/tests", "driver": "firefox"})
+}
+
+func (s *MySuite) TestTemplateVariableValuesFailForUndeclaredOrMissingValues(c *C) {
+	_, err := templateVariableValues([]templateVariable{{Name: "driver"}}, map[string]string{"browser": "chrome"}, "project")
+	c.Assert(err, ErrorMatches, "Template does not declare the variable browser")
+
+	_, err = templateVariableValues([]templateVariable{{Name: "driver", Description: "Browser to run tests in."}}, map[string]string{}, "project")
+	c.Assert(err, ErrorMatches, "No value given for template variable driver. Browser to run tests in.")
+}
+
+func (s *MySuite) TestPackageNameOf(c *C) {
+	c.Assert(packageNameOf("Gauge Tests"), Equals, "gaugetests")
+	c.Assert(packageNameOf("2fa-specs"), Equals, "project2faspecs")
+}
+
+func (s *MySuite) TestSubstituteVariablesInContentsAndPaths(c *C) {
+	templateDir, err := ioutil.TempDir("", "gaugeTemplate")
+	c.Assert(err, IsNil)
+	defer os.RemoveAll(templateDir)
+	sourceDir := filepath.Join(templateDir, "src", "{{packagePath}}")
+	c.Assert(os.MkdirAll(sourceDir, 0755), IsNil)
+	c.Assert(ioutil.WriteFile(filepath.Join(sourceDir, "StepImplementation.java"), []byte("package {{packageName}};\n"), 0644), IsNil)
+	c.Assert(ioutil.WriteFile(filepath.Join(templateDir, "{{projectName}}.txt"), []byte("{{unknown}}"), 0644), IsNil)
+
+	err = substituteVariables(templateDir, map[string]string{projectNameVar: "tests", packageNameVar: "com.example", packagePathVar: "com/example"})
+
+	c.Assert(err, IsNil)
+	contents, err := ioutil.ReadFile(filepath.Join(templateDir, "src", "com", "example", "StepImplementation.java"))
+	c.Assert(err, IsNil)
+	c.Assert(string(contents), Equals, "package com.example;\n")
+	contents, err = ioutil.ReadFile(filepath.Join(templateDir, "tests.txt"))
+	c.Assert(err, IsNil)
+	c.Assert(string(contents), Equals, "{{unknown}}")
+}
+
+func (s *MySuite) TestFindTemplateRoot(c *C) {
+	unzippedDir, err := ioutil.TempDir("", "gaugeTemplate")
+	c.Assert(err, IsNil)
+	defer os.RemoveAll(unzippedDir)
+	c.Assert(os.Mkdir(filepath.Join(unzippedDir, "java_maven"), 0755), IsNil)
+
+	c.Assert(findTemplateRoot(unzippedDir), Equals, filepath.Join(unzippedDir, "java_maven"))
+
+	c.Assert(ioutil.WriteFile(filepath.Join(unzippedDir, metadataFileName), []byte("{}"), 0644), IsNil)
+	c.Assert(findTemplateRoot(unzippedDir), Equals, unzippedDir)
+}
+
+func (s *MySuite) TestMergeTemplates(c *C) {
+	cached := []templateSource{{name: "java", kind: cachedTemplate}, {name: "java_old", kind: cachedTemplate}}
+	registered := map[string]string{"company": "/templates/company.zip", "ruby": "/templates/ruby"}
+
+	templates := mergeTemplates([]string{"csharp", "java", "ruby"}, cached, registered)
+
+	var listed []string
+	for _, t := range templates {
+		listed = append(listed, t.String())
+	}
+	c.Assert(listed, DeepEquals, []string{
+		"company (registered, /templates/company.zip)",
+		"csharp",
+		"java",
+		"java_old (cached)",
+		"ruby (registered, /templates/ruby)",
+	})
+}
@@ -0,0 +1,206 @@
+// Copyright 2015 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+
+package refactor
+
+import (
+	"fmt"
+
+	"github.com/getgauge/gauge/gauge"
+	"github.com/getgauge/gauge/util"
+)
+
+type conceptInliner struct {
+	concept *gauge.Step
+}
+
+// PerformInlineConceptRefactoring replaces every usage of a concept with the steps of the concept and
+// removes the concept definition. Dynamic parameters of the concept are substituted with the arguments of each usage.
+func PerformInlineConceptRefactoring(conceptText string) *refactoringResult {
+	conceptStep, err := parseStepText(conceptText)
+	if err != nil {
+		return rephraseFailure(err.Error())
+	}
+	specs, conceptDictionary, result := parseSpecsAndConcepts()
+	if !result.Success {
+		return result
+	}
+	concept := conceptDictionary.Search(conceptStep.Value)
+	if concept == nil {
+		return rephraseFailure(fmt.Sprintf("Concept not found: %s", conceptText))
+	}
+	inliner := &conceptInliner{concept: concept.ConceptStep}
+	specsRefactored := make(map[*gauge.Specification]bool, 0)
+	for _, spec := range specs {
+		isRefactored, err := inliner.inlineInSpec(spec)
+		if err != nil {
+			return rephraseFailure(fmt.Sprintf("Cannot inline concept in %s: %s", spec.FileName, err.Error()))
+		}
+		specsRefactored[spec] = isRefactored
+	}
+	delete(conceptDictionary.ConceptsMap, concept.ConceptStep.Value)
+	conceptFilesRefactored := map[string]bool{concept.FileName: true}
+	for _, otherConcept := range conceptDictionary.ConceptsMap {
+		isRefactored, err := inliner.inlineInConcept(otherConcept.ConceptStep)
+		if err != nil {
+			return rephraseFailure(fmt.Sprintf("Cannot inline concept in %s: %s", otherConcept.FileName, err.Error()))
+		}
+		conceptFilesRefactored[otherConcept.FileName] = conceptFilesRefactored[otherConcept.FileName] || isRefactored
+	}
+
+	transaction := newFileTransaction()
+	specFiles, conceptFiles := stageConceptAndSpecFiles(transaction, specs, conceptDictionary, specsRefactored, conceptFilesRefactored)
+	if !util.ContainsFile(conceptFiles, concept.FileName) {
+		// The inlined concept was the only one in its file.
+		transaction.stageRemoval(concept.FileName)
+		conceptFiles = append(conceptFiles, concept.FileName)
+	}
+	return commitStagedFiles(transaction, result, specFiles, conceptFiles)
+}
+
+func (inliner *conceptInliner) inlineInSpec(spec *gauge.Specification) (bool, error) {
+	items, isRefactored, err := inliner.inlineInItems(spec.Items)
+	if err != nil {
+		return false, err
+	}
+	spec.Items = items
+	for _, scenario := range spec.Scenarios {
+		scenarioItems, isScenarioRefactored, err := inliner.inlineInItems(scenario.Items)
+		if err != nil {
+			return false, err
+		}
+		scenario.Items = scenarioItems
+		scenario.Steps = stepsIn(scenarioItems)
+		isRefactored = isRefactored || isScenarioRefactored
+	}
+	spec.Contexts, spec.TearDownSteps = nil, nil
+	isTearDown := false
+	for _, item := range spec.Items {
+		if item.Kind() == gauge.TearDownKind {
+			isTearDown = true
+		} else if item.Kind() == gauge.StepKind && isTearDown {
+			spec.TearDownSteps = append(spec.TearDownSteps, item.(*gauge.Step))
+		} else if item.Kind() == gauge.StepKind {
+			spec.Contexts = append(spec.Contexts, item.(*gauge.Step))
+		}
+	}
+	return isRefactored, nil
+}
+
+// inlineInConcept inlines usages in the steps of another concept. The first item of a concept is its heading.
+func (inliner *conceptInliner) inlineInConcept(conceptStep *gauge.Step) (bool, error) {
+	items, isRefactored, err := inliner.inlineInItems(conceptStep.Items[1:])
+	if err != nil {
+		return false, err
+	}
+	conceptStep.Items = append([]gauge.Item{conceptStep}, items...)
+	conceptStep.ConceptSteps = stepsIn(items)
+	return isRefactored, nil
+}
+
+func (inliner *conceptInliner) inlineInItems(items []gauge.Item) ([]gauge.Item, bool, error) {
+	inlinedItems := make([]gauge.Item, 0, len(items))
+	isRefactored := false
+	for _, item := range items {
+		step, ok := item.(*gauge.Step)
+		if !ok || step.Value != inliner.concept.Value {
+			inlinedItems = append(inlinedItems, item)
+			continue
+		}
+		steps, err := inliner.inlinedSteps(step)
+		if err != nil {
+			return nil, false, err
+		}
+		for _, inlinedStep := range steps {
+			inlinedItems = append(inlinedItems, inlinedStep)
+		}
+		isRefactored = true
+	}
+	return inlinedItems, isRefactored, nil
+}
+
+// inlinedSteps creates the steps of the concept with the arguments of the given usage.
+func (inliner *conceptInliner) inlinedSteps(usage *gauge.Step) ([]*gauge.Step, error) {
+	argValues := make(map[string]*gauge.StepArg, len(inliner.concept.Args))
+	for i, param := range inliner.concept.Args {
+		argValues[param.Value] = usage.Args[i]
+	}
+	steps := make([]*gauge.Step, 0, len(inliner.concept.ConceptSteps))
+	for _, conceptStep := range inliner.concept.ConceptSteps {
+		step := &gauge.Step{LineNo: usage.LineNo, Value: conceptStep.Value, LineText: conceptStep.LineText, IsConcept: conceptStep.IsConcept, HasInlineTable: conceptStep.HasInlineTable}
+		var table *gauge.Table
+		for _, arg := range conceptStep.Args {
+			if arg.ArgType == gauge.TableArg {
+				tableArg := &gauge.StepArg{ArgType: gauge.TableArg}
+				tableArg.Table.AddHeaders(arg.Table.Headers)
+				step.Args = append(step.Args, tableArg)
+				table = &arg.Table
+				continue
+			}
+			step.Args = append(step.Args, inlinedArg(arg, argValues))
+		}
+		step.PopulateFragments()
+		if table != nil {
+			if err := addInlinedRows(step, table, argValues); err != nil {
+				return nil, err
+			}
+		}
+		steps = append(steps, step)
+	}
+	return steps, nil
+}
+
+func inlinedArg(arg *gauge.StepArg, argValues map[string]*gauge.StepArg) *gauge.StepArg {
+	inlined := *arg
+	if value, ok := argValues[arg.Value]; ok && arg.ArgType == gauge.Dynamic {
+		inlined = *value
+	}
+	return &inlined
+}
+
+// addInlinedRows copies the rows of a concept step's inline table to the last argument of the given step.
+func addInlinedRows(step *gauge.Step, table *gauge.Table, argValues map[string]*gauge.StepArg) error {
+	for i := 0; i < table.GetRowCount(); i++ {
+		row := make([]gauge.TableCell, 0, len(table.Headers))
+		for j := range table.Headers {
+			cell := table.Columns[j][i]
+			if value, ok := argValues[cell.Value]; ok && cell.CellType == gauge.Dynamic {
+				if value.ArgType != gauge.Static && value.ArgType != gauge.Dynamic {
+					return fmt.Errorf("%s argument for <%s> cannot be used in a table cell", value.ArgType, cell.Value)
+				}
+				cell = gauge.TableCell{Value: value.Value, CellType: value.ArgType}
+			}
+			row = append(row, cell)
+		}
+		step.AddInlineTableRow(row)
+	}
+	return nil
+}
+
+func stepsIn(items []gauge.Item) []*gauge.Step {
+	steps := make([]*gauge.Step, 0)
+	for _, item := range items {
+		if item.Kind() == gauge.StepKind {
+			steps = append(steps, item.(*gauge.Step))
+		}
+	}
+	return steps
+}
+
+func InlineConcept(conceptText string) {
+	printRefactoringSummary(PerformInlineConceptRefactoring(conceptText))
+}
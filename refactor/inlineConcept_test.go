@@ -0,0 +1,68 @@
+// Copyright 2015 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+
+package refactor
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/getgauge/gauge/util"
+	. "gopkg.in/check.v1"
+)
+
+func (s *MySuite) TestInlineConceptReplacesUsagesWithConceptSteps(c *C) {
+	projectDir, specFile := createTestProject(c)
+	defer os.RemoveAll(projectDir)
+	conceptFile, err := util.CreateFileIn(filepath.Join(projectDir, "specs"), "concept.cpt", []byte("# login as <user> with <password>\n* enter <user>\n// comment inside concept\n* submit\n     |name  |password  |\n     |------|----------|\n     |<user>|<password>|\n"))
+	c.Assert(err, Equals, nil)
+	ioutil.WriteFile(specFile, []byte("Specification Heading\n=====================\nScenario 1\n----------\n* login as \"admin\" with \"secret\"\n* first step\n"), 0644)
+
+	result := PerformInlineConceptRefactoring("login as <user> with <password>")
+
+	c.Assert(result.Success, Equals, true)
+	c.Assert(result.specsChanged, DeepEquals, []string{specFile})
+	c.Assert(result.conceptsChanged, DeepEquals, []string{conceptFile})
+	c.Assert(readFile(c, specFile), Equals, "Specification Heading\n=====================\nScenario 1\n----------\n* enter \"admin\"\n* submit \n     |name |password|\n     |-----|--------|\n     |admin|secret  |\n* first step\n")
+	_, err = os.Stat(conceptFile)
+	c.Assert(os.IsNotExist(err), Equals, true)
+}
+
+func (s *MySuite) TestInlineConceptInsideAnotherConcept(c *C) {
+	projectDir, _ := createTestProject(c)
+	defer os.RemoveAll(projectDir)
+	conceptFile, err := util.CreateFileIn(filepath.Join(projectDir, "specs"), "concept.cpt", []byte("# login as <user>\n* enter <user>\n* submit\n# checkout as <customer>\n* login as <customer>\n* pay\n"))
+	c.Assert(err, Equals, nil)
+
+	result := PerformInlineConceptRefactoring("login as <user>")
+
+	c.Assert(result.Success, Equals, true)
+	c.Assert(len(result.specsChanged), Equals, 0)
+	c.Assert(readFile(c, conceptFile), Equals, "# checkout as <customer>\n* enter <customer>\n* submit\n* pay\n")
+}
+
+func (s *MySuite) TestInlineConceptFailsWhenConceptDoesNotExist(c *C) {
+	projectDir, specFile := createTestProject(c)
+	defer os.RemoveAll(projectDir)
+
+	result := PerformInlineConceptRefactoring("first step")
+
+	c.Assert(result.Success, Equals, false)
+	c.Assert(result.Errors[0], Equals, "Concept not found: first step")
+	c.Assert(readFile(c, specFile), Equals, originalSpec)
+}
@@ -26,14 +26,12 @@ import (
 
 	"github.com/getgauge/common"
 	"github.com/getgauge/gauge/config"
-	"github.com/getgauge/gauge/conn"
 	"github.com/getgauge/gauge/formatter"
 	"github.com/getgauge/gauge/gauge"
 	"github.com/getgauge/gauge/gauge_messages"
 	"github.com/getgauge/gauge/logger"
 	"github.com/getgauge/gauge/parser"
 	"github.com/getgauge/gauge/runner"
-	"github.com/getgauge/gauge/util"
 	"github.com/golang/protobuf/proto"
 )
 
@@ -73,15 +71,7 @@ func PerformRephraseRefactoring(oldStep, newStep string, startChan *runner.Start
 		return rephraseFailure(err.Error())
 	}
 
-	result := &refactoringResult{Success: true, Errors: make([]string, 0), warnings: make([]string, 0)}
-	specs, specParseResults := parser.FindSpecs(filepath.Join(config.ProjectRoot, common.SpecsDirectoryName), &gauge.ConceptDictionary{})
-	addErrorsAndWarningsToRefactoringResult(result, specParseResults...)
-	if !result.Success {
-		return result
-	}
-	conceptDictionary, parseResult := parser.CreateConceptsDictionary(false)
-
-	addErrorsAndWarningsToRefactoringResult(result, parseResult)
+	specs, conceptDictionary, result := parseSpecsAndConcepts()
 	if !result.Success {
 		return result
 	}
@@ -91,6 +81,18 @@ func PerformRephraseRefactoring(oldStep, newStep string, startChan *runner.Start
 	return refactorResult
 }
 
+func parseSpecsAndConcepts() ([]*gauge.Specification, *gauge.ConceptDictionary, *refactoringResult) {
+	result := &refactoringResult{Success: true, Errors: make([]string, 0), warnings: make([]string, 0)}
+	specs, specParseResults := parser.FindSpecs(filepath.Join(config.ProjectRoot, common.SpecsDirectoryName), &gauge.ConceptDictionary{})
+	addErrorsAndWarningsToRefactoringResult(result, specParseResults...)
+	if !result.Success {
+		return nil, nil, result
+	}
+	conceptDictionary, parseResult := parser.CreateConceptsDictionary(false)
+	addErrorsAndWarningsToRefactoringResult(result, parseResult)
+	return specs, conceptDictionary, result
+}
+
 func killRunner(startChan *runner.StartChannels) {
 	startChan.KillChan <- true
 }
@@ -113,7 +115,17 @@ func (agent *rephraseRefactorer) performRefactoringOn(specs []*gauge.Specificati
 	specsRefactored, conceptFilesRefactored := agent.rephraseInSpecsAndConcepts(&specs, conceptDictionary)
 
 	result := &refactoringResult{Success: false, Errors: make([]string, 0), warnings: make([]string, 0)}
+	transaction := newFileTransaction()
+	specFiles, conceptFiles := stageConceptAndSpecFiles(transaction, specs, conceptDictionary, specsRefactored, conceptFilesRefactored)
+	if err := transaction.verify(); err != nil {
+		result.Errors = append(result.Errors, fmt.Sprintf("Cannot perform refactoring: %s", err))
+		return result
+	}
 	if !agent.isConcept {
+		if agent.startChan == nil {
+			result.Errors = append(result.Errors, "Cannot perform refactoring: No runner to refactor the step implementation.")
+			return result
+		}
 		var runner *runner.TestRunner
 		select {
 		case runner = <-agent.startChan.RunnerChan:
@@ -128,9 +140,15 @@ func (agent *rephraseRefactorer) performRefactoringOn(specs []*gauge.Specificati
 			return result
 		}
 		if warning == nil {
+			if err := transaction.snapshotProjectFiles(); err != nil {
+				result.Errors = append(result.Errors, fmt.Sprintf("Cannot perform refactoring: Failed to backup project files. %s", err))
+				return result
+			}
 			runnerFilesChanged, err := agent.requestRunnerForRefactoring(runner, stepName)
+			transaction.addRunnerFiles(runnerFilesChanged)
 			if err != nil {
 				result.Errors = append(result.Errors, fmt.Sprintf("Cannot perform refactoring: %s", err))
+				result.Errors = append(result.Errors, transaction.rollback()...)
 				return result
 			}
 			result.runnerFilesChanged = runnerFilesChanged
@@ -138,7 +156,11 @@ func (agent *rephraseRefactorer) performRefactoringOn(specs []*gauge.Specificati
 			result.warnings = append(result.warnings, warning.Message)
 		}
 	}
-	specFiles, conceptFiles := writeToConceptAndSpecFiles(specs, conceptDictionary, specsRefactored, conceptFilesRefactored)
+	if err := transaction.commit(); err != nil {
+		result.Errors = append(result.Errors, fmt.Sprintf("Cannot perform refactoring: %s", err))
+		result.runnerFilesChanged = nil
+		return result
+	}
 	result.specsChanged = specFiles
 	result.Success = true
 	result.conceptsChanged = conceptFiles
@@ -202,6 +224,21 @@ func getRefactorAgent(oldStepText, newStepText string, startChan *runner.StartCh
 	return &rephraseRefactorer{oldStep: steps[0], newStep: steps[1], startChan: startChan}, nil
 }
 
+func parseStepText(stepText string) (*gauge.Step, error) {
+	stepTokens, err := new(parser.SpecParser).GenerateTokens("* " + stepText)
+	if err != nil {
+		return nil, err
+	}
+	if len(stepTokens) != 1 || stepTokens[0].Kind != gauge.StepKind {
+		return nil, fmt.Errorf("Invalid step: %s", stepText)
+	}
+	step, parseDetails := parser.CreateStepUsingLookup(stepTokens[0], nil)
+	if parseDetails != nil && parseDetails.Error != nil {
+		return nil, parseDetails.Error
+	}
+	return step, nil
+}
+
 func (agent *rephraseRefactorer) requestRunnerForRefactoring(testRunner *runner.TestRunner, stepName string) ([]string, error) {
 	refactorRequest, err := agent.createRefactorRequest(testRunner, stepName)
 	if err != nil {
@@ -217,7 +254,7 @@ func (agent *rephraseRefactorer) requestRunnerForRefactoring(testRunner *runner.
 }
 
 func (agent *rephraseRefactorer) sendRefactorRequest(testRunner *runner.TestRunner, refactorRequest *gauge_messages.Message) *gauge_messages.RefactorResponse {
-	response, err := conn.GetResponseForMessageWithTimeout(refactorRequest, testRunner.Connection, config.RefactorTimeout())
+	response, err := testRunner.Connection.GetResponseWithTimeout(refactorRequest, config.RefactorTimeout())
 	if err != nil {
 		return &gauge_messages.RefactorResponse{Success: proto.Bool(false), Error: proto.String(err.Error())}
 	}
@@ -257,7 +294,7 @@ func (agent *rephraseRefactorer) generateNewStepName(args []string, orderMap map
 
 func (agent *rephraseRefactorer) getStepNameFromRunner(runner *runner.TestRunner) (string, error, *parser.Warning) {
 	stepNameMessage := &gauge_messages.Message{MessageType: gauge_messages.Message_StepNameRequest.Enum(), StepNameRequest: &gauge_messages.StepNameRequest{StepValue: proto.String(agent.oldStep.Value)}}
-	responseMessage, err := conn.GetResponseForMessageWithTimeout(stepNameMessage, runner.Connection, config.RunnerRequestTimeout())
+	responseMessage, err := runner.Connection.GetResponseWithTimeout(stepNameMessage, config.RunnerRequestTimeout())
 	if err != nil {
 		return "", err, nil
 	}
@@ -282,26 +319,43 @@ func (agent *rephraseRefactorer) getStepValueFor(step *gauge.Step, stepName stri
 	return parser.ExtractStepValueAndParams(stepName, false)
 }
 
-func writeToConceptAndSpecFiles(specs []*gauge.Specification, conceptDictionary *gauge.ConceptDictionary, specsRefactored map[*gauge.Specification]bool, conceptFilesRefactored map[string]bool) ([]string, []string) {
+func stageConceptAndSpecFiles(transaction *fileTransaction, specs []*gauge.Specification, conceptDictionary *gauge.ConceptDictionary, specsRefactored map[*gauge.Specification]bool, conceptFilesRefactored map[string]bool) ([]string, []string) {
 	specFiles := make([]string, 0)
 	conceptFiles := make([]string, 0)
+	c := formatter.ProjectConfigOrDefault()
 	for _, spec := range specs {
 		if specsRefactored[spec] {
 			specFiles = append(specFiles, spec.FileName)
-			formatted := formatter.FormatSpecification(spec)
-			util.SaveFile(spec.FileName, formatted, true)
+			transaction.stage(spec.FileName, formatter.FormatSpecification(spec, c))
 		}
 	}
-	conceptMap := formatter.FormatConcepts(conceptDictionary)
+	conceptMap := formatter.FormatConcepts(conceptDictionary, c)
 	for fileName, concept := range conceptMap {
 		if conceptFilesRefactored[fileName] {
 			conceptFiles = append(conceptFiles, fileName)
-			util.SaveFile(fileName, concept, true)
+			transaction.stage(fileName, concept)
 		}
 	}
 	return specFiles, conceptFiles
 }
 
+// commitStagedFiles verifies and writes the files staged by a refactoring which does not involve the runner.
+func commitStagedFiles(transaction *fileTransaction, result *refactoringResult, specFiles, conceptFiles []string) *refactoringResult {
+	if err := transaction.verify(); err != nil {
+		result.Success = false
+		result.Errors = append(result.Errors, fmt.Sprintf("Cannot perform refactoring: %s", err))
+		return result
+	}
+	if err := transaction.commit(); err != nil {
+		result.Success = false
+		result.Errors = append(result.Errors, fmt.Sprintf("Cannot perform refactoring: %s", err))
+		return result
+	}
+	result.specsChanged = specFiles
+	result.conceptsChanged = conceptFiles
+	return result
+}
+
 func (refactoringResult *refactoringResult) appendWarnings(warnings []*parser.Warning) {
 	if refactoringResult.warnings == nil {
 		refactoringResult.warnings = make([]string, 0)
@@ -18,6 +18,7 @@
 package refactor
 
 import (
+	"os"
 	"testing"
 
 	"github.com/getgauge/gauge/gauge"
@@ -447,3 +448,18 @@ func (s *MySuite) TestGenerateNewStepNameWhenParametersAreUnchanged(c *C) {
 
 	c.Assert(linetext, Equals, "make comment <a>")
 }
+
+func (s *MySuite) TestRefactoringStepsWithoutRunnerFails(c *C) {
+	projectDir, specFile := createTestProject(c)
+	defer os.RemoveAll(projectDir)
+	agent, err := getRefactorAgent("first step", "second step", nil)
+	c.Assert(err, Equals, nil)
+	specs, conceptDictionary, parseResult := parseSpecsAndConcepts()
+	c.Assert(parseResult.Success, Equals, true)
+
+	result := agent.performRefactoringOn(specs, conceptDictionary)
+
+	c.Assert(result.Success, Equals, false)
+	c.Assert(result.Errors[0], Equals, "Cannot perform refactoring: No runner to refactor the step implementation.")
+	c.Assert(readFile(c, specFile), Equals, originalSpec)
+}
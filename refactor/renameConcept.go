@@ -0,0 +1,58 @@
+// Copyright 2015 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+
+package refactor
+
+import (
+	"fmt"
+
+	"github.com/getgauge/gauge/gauge"
+)
+
+// PerformRenameConceptRefactoring renames the heading of a concept and every step that uses it.
+// Concepts have no implementation, so the runner is not involved.
+func PerformRenameConceptRefactoring(oldConcept, newConcept string) *refactoringResult {
+	agent, err := getRefactorAgent(oldConcept, newConcept, nil)
+	if err != nil {
+		return rephraseFailure(err.Error())
+	}
+	if agent.oldStep.LineText == agent.newStep.LineText {
+		return &refactoringResult{Success: true}
+	}
+	specs, conceptDictionary, result := parseSpecsAndConcepts()
+	if !result.Success {
+		return result
+	}
+	if conceptDictionary.Search(agent.oldStep.Value) == nil {
+		return rephraseFailure(fmt.Sprintf("Concept not found: %s", oldConcept))
+	}
+	if agent.oldStep.Value != agent.newStep.Value && conceptDictionary.Search(agent.newStep.Value) != nil {
+		return rephraseFailure(fmt.Sprintf("Concept already exists: %s", newConcept))
+	}
+	for _, arg := range agent.newStep.Args {
+		if arg.ArgType != gauge.Dynamic {
+			return rephraseFailure("Concept heading can have only Dynamic Parameters")
+		}
+	}
+	refactorResult := agent.performRefactoringOn(specs, conceptDictionary)
+	refactorResult.warnings = append(refactorResult.warnings, result.warnings...)
+	return refactorResult
+}
+
+func RenameConcept(oldConcept, newConcept string) {
+	printRefactoringSummary(PerformRenameConceptRefactoring(oldConcept, newConcept))
+}
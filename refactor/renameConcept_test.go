@@ -0,0 +1,65 @@
+// Copyright 2015 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+
+package refactor
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/getgauge/gauge/util"
+	. "gopkg.in/check.v1"
+)
+
+func (s *MySuite) TestRenameConceptRenamesHeadingAndUsages(c *C) {
+	projectDir, specFile := createTestProject(c)
+	defer os.RemoveAll(projectDir)
+	conceptFile, err := util.CreateFileIn(filepath.Join(projectDir, "specs"), "concept.cpt", []byte("# login as <user>\n* enter <user>\n"))
+	c.Assert(err, Equals, nil)
+	ioutil.WriteFile(specFile, []byte("Specification Heading\n=====================\nScenario 1\n----------\n* login as \"admin\"\n"), 0644)
+
+	result := PerformRenameConceptRefactoring("login as <user>", "sign in as <user>")
+
+	c.Assert(result.Success, Equals, true)
+	c.Assert(result.specsChanged, DeepEquals, []string{specFile})
+	c.Assert(result.conceptsChanged, DeepEquals, []string{conceptFile})
+	c.Assert(readFile(c, conceptFile), Equals, "# sign in as <user>\n* enter <user>\n")
+	c.Assert(readFile(c, specFile), Equals, "Specification Heading\n=====================\nScenario 1\n----------\n* sign in as \"admin\"\n")
+}
+
+func (s *MySuite) TestRenameConceptFailsWhenConceptDoesNotExist(c *C) {
+	projectDir, specFile := createTestProject(c)
+	defer os.RemoveAll(projectDir)
+
+	result := PerformRenameConceptRefactoring("first step", "second step")
+
+	c.Assert(result.Success, Equals, false)
+	c.Assert(result.Errors[0], Equals, "Concept not found: first step")
+	c.Assert(readFile(c, specFile), Equals, originalSpec)
+}
+
+func (s *MySuite) TestRenameConceptFailsWhenNewConceptAlreadyExists(c *C) {
+	projectDir, _ := createTestProject(c)
+	defer os.RemoveAll(projectDir)
+	util.CreateFileIn(filepath.Join(projectDir, "specs"), "concept.cpt", []byte("# first concept\n* first step\n# second concept\n* second step\n"))
+
+	result := PerformRenameConceptRefactoring("first concept", "second concept")
+
+	c.Assert(result.Success, Equals, false)
+	c.Assert(result.Errors[0], Equals, "Concept already exists: second concept")
+}
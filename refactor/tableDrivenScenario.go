@@ -0,0 +1,207 @@
+// Copyright 2015 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+
+package refactor
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+
+	"github.com/getgauge/gauge/formatter"
+	"github.com/getgauge/gauge/gauge"
+	"github.com/getgauge/gauge/parser"
+	"github.com/getgauge/gauge/util"
+)
+
+// tableColumn is a static parameter of a step which has different values across scenarios.
+type tableColumn struct {
+	stepIndex int
+	argIndex  int
+	values    []string
+}
+
+// PerformTableDrivenRefactoring converts the scenarios of a specification which differ only in the values
+// of their static parameters into a single scenario driven by a data table.
+func PerformTableDrivenRefactoring(specFile string) *refactoringResult {
+	specFile = util.GetPathToFile(specFile)
+	result := &refactoringResult{Success: true, Errors: make([]string, 0), warnings: make([]string, 0)}
+	specs, specParseResults := parser.ParseSpecFiles([]string{specFile}, &gauge.ConceptDictionary{})
+	addErrorsAndWarningsToRefactoringResult(result, specParseResults...)
+	if !result.Success {
+		return result
+	}
+	spec := specs[0]
+	warnings, err := convertToTableDrivenScenario(spec)
+	if err != nil {
+		return rephraseFailure(fmt.Sprintf("Cannot convert %s to a table driven scenario: %s", specFile, err.Error()))
+	}
+	result.warnings = append(result.warnings, warnings...)
+	transaction := newFileTransaction()
+	transaction.stage(spec.FileName, formatter.FormatSpecification(spec, formatter.ProjectConfigOrDefault()))
+	return commitStagedFiles(transaction, result, []string{spec.FileName}, make([]string, 0))
+}
+
+func convertToTableDrivenScenario(spec *gauge.Specification) ([]string, error) {
+	if spec.DataTable.IsInitialized() || spec.DataTable.IsExternal {
+		return nil, fmt.Errorf("specification already has a data table")
+	}
+	if len(spec.Scenarios) < 2 {
+		return nil, fmt.Errorf("specification should have at least two scenarios")
+	}
+	columns, err := findTableColumns(spec.Scenarios)
+	if err != nil {
+		return nil, err
+	}
+	scenario := spec.Scenarios[0]
+	warnings := make([]string, 0)
+	for _, dropped := range spec.Scenarios[1:] {
+		if dropped.Heading.Value != scenario.Heading.Value {
+			warnings = append(warnings, fmt.Sprintf("Heading of scenario '%s' is removed", dropped.Heading.Value))
+		}
+		for _, comment := range dropped.Comments {
+			if strings.TrimSpace(comment.Value) != "" {
+				warnings = append(warnings, fmt.Sprintf("Comments in scenario '%s' are removed", dropped.Heading.Value))
+				break
+			}
+		}
+	}
+
+	headers := columnHeaders(scenario.Steps, columns)
+	table := &gauge.Table{}
+	table.AddHeaders(headers)
+	for row := range spec.Scenarios {
+		rowValues := make([]string, len(columns))
+		for i, column := range columns {
+			rowValues[i] = column.values[row]
+		}
+		table.AddRowValues(rowValues)
+	}
+
+	for i, column := range columns {
+		step := scenario.Steps[column.stepIndex]
+		step.Args[column.argIndex] = &gauge.StepArg{Value: headers[i], ArgType: gauge.Dynamic}
+		step.PopulateFragments()
+	}
+	spec.DataTable.Table = *table
+	items := make([]gauge.Item, 0, len(spec.Items))
+	isTableAdded := false
+	for _, item := range spec.Items {
+		// Data table has to be defined before the context steps.
+		if !isTableAdded && (item.Kind() == gauge.StepKind || item.Kind() == gauge.ScenarioKind || item.Kind() == gauge.TearDownKind) {
+			items = append(items, &spec.DataTable, &gauge.Comment{Value: "\n"})
+			isTableAdded = true
+		}
+		if item.Kind() != gauge.ScenarioKind || item.(*gauge.Scenario) == scenario {
+			items = append(items, item)
+		}
+	}
+	spec.Items = items
+	spec.Scenarios = []*gauge.Scenario{scenario}
+	return warnings, nil
+}
+
+// findTableColumns checks that all scenarios have the same steps and returns the static parameters whose values differ.
+func findTableColumns(scenarios []*gauge.Scenario) ([]*tableColumn, error) {
+	first := scenarios[0]
+	for _, scenario := range scenarios[1:] {
+		if len(scenario.Steps) != len(first.Steps) {
+			return nil, fmt.Errorf("scenarios '%s' and '%s' have different steps", first.Heading.Value, scenario.Heading.Value)
+		}
+		if formatter.FormatTags(scenario.Tags) != formatter.FormatTags(first.Tags) {
+			return nil, fmt.Errorf("scenarios '%s' and '%s' have different tags", first.Heading.Value, scenario.Heading.Value)
+		}
+		for i, step := range scenario.Steps {
+			if step.Value != first.Steps[i].Value {
+				return nil, fmt.Errorf("scenarios '%s' and '%s' have different steps", first.Heading.Value, scenario.Heading.Value)
+			}
+		}
+	}
+	columns := make([]*tableColumn, 0)
+	for i, step := range first.Steps {
+		for j := range step.Args {
+			column := &tableColumn{stepIndex: i, argIndex: j}
+			isColumn := false
+			for _, scenario := range scenarios {
+				arg := scenario.Steps[i].Args[j]
+				if arg.ArgType == gauge.Static && step.Args[j].ArgType == gauge.Static {
+					column.values = append(column.values, arg.Value)
+					isColumn = isColumn || arg.Value != step.Args[j].Value
+				} else if !arg.IsSameAs(step.Args[j]) {
+					return nil, fmt.Errorf("parameters of step '%s' differ in more than their values", step.LineText)
+				}
+			}
+			if !isColumn {
+				continue
+			}
+			for _, value := range column.values {
+				if !isValidTableCell(value) {
+					return nil, fmt.Errorf("'%s' cannot be used as a table cell", value)
+				}
+			}
+			columns = append(columns, column)
+		}
+	}
+	if len(columns) == 0 {
+		return nil, fmt.Errorf("scenarios do not differ in their parameters")
+	}
+	return columns, nil
+}
+
+// columnHeaders names each column after the first word of its step. The position of the parameter in the step is added
+// when the step has more than one column, and a number when the name is still taken.
+func columnHeaders(steps []*gauge.Step, columns []*tableColumn) []string {
+	columnsOfStep := make(map[int]int)
+	for _, column := range columns {
+		columnsOfStep[column.stepIndex]++
+	}
+	headers := make([]string, len(columns))
+	taken := make(map[string]bool)
+	for i, column := range columns {
+		header := stepName(steps[column.stepIndex])
+		if columnsOfStep[column.stepIndex] > 1 {
+			header = fmt.Sprintf("%s %d", header, column.argIndex+1)
+		}
+		for name, n := header, 2; taken[header]; n++ {
+			header = fmt.Sprintf("%s %d", name, n)
+		}
+		taken[header] = true
+		headers[i] = header
+	}
+	return headers
+}
+
+func stepName(step *gauge.Step) string {
+	words := strings.Fields(step.Value)
+	if len(words) > 0 {
+		if name := strings.TrimFunc(words[0], func(r rune) bool { return !unicode.IsLetter(r) && !unicode.IsDigit(r) }); name != "" {
+			return name
+		}
+	}
+	return "param"
+}
+
+func isValidTableCell(value string) bool {
+	if strings.ContainsAny(value, "|\n") || strings.TrimSpace(value) != value {
+		return false
+	}
+	return !(strings.HasPrefix(value, "<") && strings.HasSuffix(value, ">"))
+}
+
+func ConvertToTableDrivenScenario(specFile string) {
+	printRefactoringSummary(PerformTableDrivenRefactoring(specFile))
+}
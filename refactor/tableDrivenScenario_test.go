@@ -0,0 +1,95 @@
+// Copyright 2015 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+
+package refactor
+
+import (
+	"io/ioutil"
+	"os"
+
+	"github.com/getgauge/gauge/gauge"
+	. "gopkg.in/check.v1"
+)
+
+func (s *MySuite) TestConvertScenariosToTableDrivenScenario(c *C) {
+	projectDir, specFile := createTestProject(c)
+	defer os.RemoveAll(projectDir)
+	ioutil.WriteFile(specFile, []byte(`Specification Heading
+=====================
+* context step
+Login as admin
+--------------
+* login as "admin" with "secret"
+* open "home" page
+
+Login as guest
+--------------
+* login as "guest" with "password"
+* open "home" page
+`), 0644)
+
+	result := PerformTableDrivenRefactoring(specFile)
+
+	c.Assert(result.Success, Equals, true)
+	c.Assert(result.specsChanged, DeepEquals, []string{specFile})
+	c.Assert(result.warnings, DeepEquals, []string{"Heading of scenario 'Login as guest' is removed"})
+	c.Assert(readFile(c, specFile), Equals, `Specification Heading
+=====================
+     |login 1|login 2 |
+     |-------|--------|
+     |admin  |secret  |
+     |guest  |password|
+
+* context step
+Login as admin
+--------------
+* login as <login 1> with <login 2>
+* open "home" page
+
+`)
+}
+
+func (s *MySuite) TestConvertToTableDrivenScenarioFailsWhenStepsDiffer(c *C) {
+	projectDir, specFile := createTestProject(c)
+	defer os.RemoveAll(projectDir)
+	spec := "Specification Heading\n=====================\nScenario 1\n----------\n* first step\nScenario 2\n----------\n* second step\n"
+	ioutil.WriteFile(specFile, []byte(spec), 0644)
+
+	result := PerformTableDrivenRefactoring(specFile)
+
+	c.Assert(result.Success, Equals, false)
+	c.Assert(readFile(c, specFile), Equals, spec)
+}
+
+func (s *MySuite) TestConvertToTableDrivenScenarioFailsWhenSpecHasDataTable(c *C) {
+	projectDir, specFile := createTestProject(c)
+	defer os.RemoveAll(projectDir)
+	spec := "Specification Heading\n=====================\n     |id|\n     |--|\n     |1 |\nScenario 1\n----------\n* step \"a\"\nScenario 2\n----------\n* step \"b\"\n"
+	ioutil.WriteFile(specFile, []byte(spec), 0644)
+
+	result := PerformTableDrivenRefactoring(specFile)
+
+	c.Assert(result.Success, Equals, false)
+	c.Assert(readFile(c, specFile), Equals, spec)
+}
+
+func (s *MySuite) TestTableColumnsAreNamedAfterTheirSteps(c *C) {
+	steps := []*gauge.Step{{Value: "login as {} with {}"}, {Value: "open {} page"}, {Value: "open {} tab"}, {Value: "{} items"}}
+	columns := []*tableColumn{{stepIndex: 0, argIndex: 0}, {stepIndex: 0, argIndex: 1}, {stepIndex: 1}, {stepIndex: 2}, {stepIndex: 3}}
+
+	c.Assert(columnHeaders(steps, columns), DeepEquals, []string{"login 1", "login 2", "open", "open 2", "param"})
+}
@@ -0,0 +1,403 @@
+// Copyright 2015 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+
+package refactor
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/getgauge/common"
+	"github.com/getgauge/gauge/config"
+	"github.com/getgauge/gauge/gauge"
+	"github.com/getgauge/gauge/logger"
+	"github.com/getgauge/gauge/parser"
+	"github.com/getgauge/gauge/util"
+)
+
+const (
+	stagedFileSuffix = ".gauge-refactor"
+	// Files bigger than this are not source files a runner would refactor, so they are not snapshotted.
+	maxSnapshotFileSize = 1024 * 1024
+)
+
+type originalFile struct {
+	content string
+	mode    os.FileMode
+	existed bool
+	// untracked files were present but not snapshotted, so they cannot be restored.
+	untracked bool
+}
+
+// fileTransaction stages the file writes of a refactoring so that they can be verified and
+// committed together. Original contents of every touched file are kept so that the project
+// can be restored if any step of the refactoring fails.
+type fileTransaction struct {
+	stagedFiles  []string
+	staged       map[string]string
+	removedFiles []string
+	originals    map[string]*originalFile
+	runnerFiles  []string
+	snapshotted  bool
+	ignored      []string
+}
+
+func newFileTransaction() *fileTransaction {
+	return &fileTransaction{staged: make(map[string]string), originals: make(map[string]*originalFile)}
+}
+
+// SaveFiles writes the given contents of files together. Specs and concepts are verified to parse before anything is
+// written, and all files are restored if writing any of them fails.
+func SaveFiles(contents map[string]string) error {
+	fileNames := make([]string, 0, len(contents))
+	for fileName := range contents {
+		fileNames = append(fileNames, fileName)
+	}
+	sort.Strings(fileNames)
+	transaction := newFileTransaction()
+	for _, fileName := range fileNames {
+		transaction.stage(fileName, contents[fileName])
+	}
+	if err := transaction.verify(); err != nil {
+		return err
+	}
+	return transaction.commit()
+}
+
+// stage records the new content of a file. Nothing is written to disk until commit.
+func (t *fileTransaction) stage(fileName, content string) {
+	if _, ok := t.staged[fileName]; !ok {
+		t.stagedFiles = append(t.stagedFiles, fileName)
+	}
+	t.staged[fileName] = content
+}
+
+// stageRemoval records that a file has to be deleted on commit.
+func (t *fileTransaction) stageRemoval(fileName string) {
+	if !util.ContainsFile(t.removedFiles, fileName) {
+		t.removedFiles = append(t.removedFiles, fileName)
+	}
+}
+
+// verify reparses all staged specs and concepts, using the staged content of concept files
+// in place of the ones on disk.
+func (t *fileTransaction) verify() error {
+	conceptDictionary := gauge.NewConceptDictionary()
+	conceptFiles := util.FindConceptFilesIn(filepath.Join(config.ProjectRoot, common.SpecsDirectoryName))
+	for _, fileName := range t.stagedFiles {
+		if util.IsConcept(fileName) && !util.ContainsFile(conceptFiles, fileName) {
+			conceptFiles = append(conceptFiles, fileName)
+		}
+	}
+	for _, conceptFile := range conceptFiles {
+		if util.ContainsFile(t.removedFiles, conceptFile) {
+			continue
+		}
+		content, err := t.contentOf(conceptFile)
+		if err != nil {
+			return err
+		}
+		if err := parser.AddConceptsFromText(content, conceptFile, conceptDictionary); err != nil {
+			return fmt.Errorf("Refactored concept file %s does not parse: %s", conceptFile, err.Error())
+		}
+	}
+	for _, fileName := range t.stagedFiles {
+		if !util.IsSpec(fileName) {
+			continue
+		}
+		_, parseResult := new(parser.SpecParser).Parse(t.staged[fileName], conceptDictionary)
+		if !parseResult.Ok {
+			return fmt.Errorf("Refactored spec file %s does not parse: %s", fileName, parseResult.Error())
+		}
+	}
+	return nil
+}
+
+func (t *fileTransaction) contentOf(fileName string) (string, error) {
+	if content, ok := t.staged[fileName]; ok {
+		return content, nil
+	}
+	return common.ReadFileContents(fileName)
+}
+
+// snapshotProjectFiles keeps the contents of the project's source files so that files changed
+// by the runner can be restored. Specs, env, hidden, dependency and build output directories,
+// paths ignored by the project's .gitignore and binary files are left out.
+func (t *fileTransaction) snapshotProjectFiles() error {
+	t.snapshotted = true
+	t.ignored = readIgnorePatterns(filepath.Join(config.ProjectRoot, ".gitignore"))
+	return filepath.Walk(config.ProjectRoot, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if path == config.ProjectRoot {
+			return nil
+		}
+		if info.IsDir() {
+			if isSkippedInSnapshot(path) || t.isIgnored(path, true) {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if t.isIgnored(path, false) {
+			return nil
+		}
+		if !info.Mode().IsRegular() || info.Size() > maxSnapshotFileSize || isBinaryFile(path) {
+			t.originals[path] = &originalFile{existed: true, untracked: true}
+			return nil
+		}
+		return t.snapshot(path)
+	})
+}
+
+// Directories holding dependencies or build output, which runners never refactor.
+var snapshotSkippedDirs = map[string]bool{
+	"node_modules": true,
+	"vendor":       true,
+	"bin":          true,
+	"obj":          true,
+	"build":        true,
+	"target":       true,
+	"out":          true,
+	"dist":         true,
+	"gauge_bin":    true,
+}
+
+func isSkippedInSnapshot(dir string) bool {
+	base := filepath.Base(dir)
+	if snapshotSkippedDirs[base] || strings.HasPrefix(base, ".") {
+		return true
+	}
+	for _, skipped := range []string{common.SpecsDirectoryName, common.EnvDirectoryName, os.Getenv("gauge_reports_dir"), os.Getenv("logs_directory")} {
+		if skipped != "" && dir == projectPath(skipped) {
+			return true
+		}
+	}
+	return false
+}
+
+func projectPath(path string) string {
+	if filepath.IsAbs(path) {
+		return filepath.Clean(path)
+	}
+	return filepath.Join(config.ProjectRoot, path)
+}
+
+// readIgnorePatterns reads the patterns of a .gitignore file. Negated patterns are left out, so a file they would
+// bring back is not snapshotted and cannot be restored.
+func readIgnorePatterns(file string) []string {
+	content, err := common.ReadFileContents(file)
+	if err != nil {
+		return nil
+	}
+	patterns := make([]string, 0)
+	for _, line := range strings.Split(content, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") || strings.HasPrefix(line, "!") {
+			continue
+		}
+		patterns = append(patterns, line)
+	}
+	return patterns
+}
+
+// isIgnored matches a path against the .gitignore patterns. Patterns without a slash match the name of the file or
+// directory, the other ones its path from the project root.
+func (t *fileTransaction) isIgnored(path string, isDir bool) bool {
+	rel, err := filepath.Rel(config.ProjectRoot, path)
+	if err != nil {
+		return false
+	}
+	rel = filepath.ToSlash(rel)
+	for _, pattern := range t.ignored {
+		if strings.HasSuffix(pattern, "/") {
+			if !isDir {
+				continue
+			}
+			pattern = strings.TrimSuffix(pattern, "/")
+		}
+		name := filepath.Base(path)
+		if strings.Contains(pattern, "/") {
+			pattern = strings.TrimPrefix(pattern, "/")
+			name = rel
+		}
+		if matched, _ := filepath.Match(pattern, name); matched {
+			return true
+		}
+	}
+	return false
+}
+
+// isBinaryFile tells if the start of the file has a NUL byte, which source files never have.
+func isBinaryFile(fileName string) bool {
+	f, err := os.Open(fileName)
+	if err != nil {
+		return false
+	}
+	defer f.Close()
+	start := make([]byte, 8000)
+	n, _ := f.Read(start)
+	return bytes.IndexByte(start[:n], 0) >= 0
+}
+
+// inSnapshotScope tells if the walk of snapshotProjectFiles would have snapshotted the file, had it existed then.
+func (t *fileTransaction) inSnapshotScope(fileName string) bool {
+	rel, err := filepath.Rel(config.ProjectRoot, fileName)
+	if err != nil || strings.HasPrefix(rel, "..") || t.isIgnored(fileName, false) {
+		return false
+	}
+	for dir := filepath.Dir(fileName); dir != config.ProjectRoot && dir != filepath.Dir(dir); dir = filepath.Dir(dir) {
+		if isSkippedInSnapshot(dir) || t.isIgnored(dir, true) {
+			return false
+		}
+	}
+	return true
+}
+
+func (t *fileTransaction) snapshot(fileName string) error {
+	if _, ok := t.originals[fileName]; ok {
+		return nil
+	}
+	info, err := os.Stat(fileName)
+	if os.IsNotExist(err) {
+		t.originals[fileName] = &originalFile{existed: false}
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	content, err := common.ReadFileContents(fileName)
+	if err != nil {
+		return err
+	}
+	t.originals[fileName] = &originalFile{content: content, mode: info.Mode(), existed: true}
+	return nil
+}
+
+// addRunnerFiles records the files the runner reports as changed, so that they are restored on rollback.
+func (t *fileTransaction) addRunnerFiles(files []string) {
+	for _, file := range files {
+		fileName := util.GetPathToFile(file)
+		if _, ok := t.originals[fileName]; !ok {
+			if t.snapshotted {
+				// Not seen while taking the snapshot, so either the runner created it or it is outside the snapshot.
+				t.originals[fileName] = &originalFile{existed: false, untracked: common.FileExists(fileName) && !t.inSnapshotScope(fileName)}
+			} else {
+				t.originals[fileName] = &originalFile{existed: true, untracked: true}
+			}
+		}
+		t.runnerFiles = append(t.runnerFiles, fileName)
+	}
+}
+
+// commit writes every staged file next to its target, moves all of them in place and then
+// deletes the removed files. If any write fails, every file touched by the transaction is restored.
+func (t *fileTransaction) commit() error {
+	for _, fileName := range t.touchedFiles() {
+		if err := t.snapshot(fileName); err != nil {
+			return t.abort(fmt.Errorf("Failed to read %s: %s", fileName, err.Error()))
+		}
+	}
+	for _, fileName := range t.stagedFiles {
+		if err := ioutil.WriteFile(fileName+stagedFileSuffix, []byte(t.staged[fileName]), t.modeOf(fileName)); err != nil {
+			return t.abort(fmt.Errorf("Failed to write %s: %s", fileName, err.Error()))
+		}
+	}
+	for _, fileName := range t.stagedFiles {
+		if err := os.Rename(fileName+stagedFileSuffix, fileName); err != nil {
+			return t.abort(fmt.Errorf("Failed to write %s: %s", fileName, err.Error()))
+		}
+	}
+	for _, fileName := range t.removedFiles {
+		if err := os.Remove(fileName); err != nil {
+			return t.abort(fmt.Errorf("Failed to remove %s: %s", fileName, err.Error()))
+		}
+	}
+	return nil
+}
+
+func (t *fileTransaction) touchedFiles() []string {
+	files := make([]string, 0, len(t.stagedFiles)+len(t.removedFiles))
+	return append(append(files, t.stagedFiles...), t.removedFiles...)
+}
+
+func (t *fileTransaction) modeOf(fileName string) os.FileMode {
+	if original, ok := t.originals[fileName]; ok && original.existed {
+		return original.mode
+	}
+	return common.NewFilePermissions
+}
+
+func (t *fileTransaction) abort(err error) error {
+	if errs := t.rollback(); len(errs) > 0 {
+		return fmt.Errorf("%s. Rollback failed: %s", err.Error(), strings.Join(errs, ", "))
+	}
+	return err
+}
+
+// rollback restores the original contents of all staged, removed and runner changed files, and of every snapshotted
+// file which differs from its snapshot, since a runner which failed or timed out may not have reported its changes.
+func (t *fileTransaction) rollback() []string {
+	errs := make([]string, 0)
+	for _, fileName := range t.stagedFiles {
+		os.Remove(fileName + stagedFileSuffix)
+	}
+	reported := append(t.touchedFiles(), t.runnerFiles...)
+	files := append([]string{}, reported...)
+	snapshotted := make([]string, 0, len(t.originals))
+	for fileName, original := range t.originals {
+		if !original.untracked && !util.ContainsFile(reported, fileName) {
+			snapshotted = append(snapshotted, fileName)
+		}
+	}
+	sort.Strings(snapshotted)
+	for _, fileName := range append(files, snapshotted...) {
+		if err := t.restore(fileName); err != nil {
+			errs = append(errs, err.Error())
+		}
+	}
+	return errs
+}
+
+func (t *fileTransaction) restore(fileName string) error {
+	original, ok := t.originals[fileName]
+	if !ok {
+		return nil
+	}
+	if original.untracked {
+		return fmt.Errorf("Cannot restore %s, it was not backed up", fileName)
+	}
+	if !original.existed {
+		if err := os.Remove(fileName); err != nil && !os.IsNotExist(err) {
+			return fmt.Errorf("Failed to remove %s: %s", fileName, err.Error())
+		}
+		return nil
+	}
+	if current, err := common.ReadFileContents(fileName); err == nil && current == original.content {
+		return nil
+	}
+	if err := ioutil.WriteFile(fileName, []byte(original.content), original.mode); err != nil {
+		return fmt.Errorf("Failed to restore %s: %s", fileName, err.Error())
+	}
+	logger.APILog.Info("Restored %s", fileName)
+	return nil
+}
@@ -0,0 +1,177 @@
+// Copyright 2015 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+
+package refactor
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/getgauge/gauge/config"
+	"github.com/getgauge/gauge/util"
+	. "gopkg.in/check.v1"
+)
+
+const originalSpec = `Specification Heading
+=====================
+Scenario 1
+----------
+* first step
+`
+
+func createTestProject(c *C) (string, string) {
+	projectDir, err := ioutil.TempDir("", "gaugeTest")
+	c.Assert(err, Equals, nil)
+	config.ProjectRoot = projectDir
+	specFile, err := util.CreateFileIn(filepath.Join(projectDir, "specs"), "spec1.spec", []byte(originalSpec))
+	c.Assert(err, Equals, nil)
+	return projectDir, specFile
+}
+
+func readFile(c *C, fileName string) string {
+	content, err := ioutil.ReadFile(fileName)
+	c.Assert(err, Equals, nil)
+	return string(content)
+}
+
+func (s *MySuite) TestCommitWritesStagedFiles(c *C) {
+	projectDir, specFile := createTestProject(c)
+	defer os.RemoveAll(projectDir)
+	newSpec := "Specification Heading\n=====================\nScenario 1\n----------\n* second step\n"
+
+	transaction := newFileTransaction()
+	transaction.stage(specFile, newSpec)
+
+	c.Assert(readFile(c, specFile), Equals, originalSpec)
+	c.Assert(transaction.verify(), Equals, nil)
+	c.Assert(transaction.commit(), Equals, nil)
+	c.Assert(readFile(c, specFile), Equals, newSpec)
+	_, err := os.Stat(specFile + stagedFileSuffix)
+	c.Assert(os.IsNotExist(err), Equals, true)
+}
+
+func (s *MySuite) TestVerifyFailsWhenStagedSpecDoesNotParse(c *C) {
+	projectDir, specFile := createTestProject(c)
+	defer os.RemoveAll(projectDir)
+
+	transaction := newFileTransaction()
+	transaction.stage(specFile, "* step without a spec heading\n")
+
+	c.Assert(transaction.verify(), NotNil)
+	c.Assert(readFile(c, specFile), Equals, originalSpec)
+}
+
+func (s *MySuite) TestVerifyUsesStagedConcepts(c *C) {
+	projectDir, _ := createTestProject(c)
+	defer os.RemoveAll(projectDir)
+	conceptFile, err := util.CreateFileIn(filepath.Join(projectDir, "specs"), "concept.cpt", []byte("# concept\n* first step\n"))
+	c.Assert(err, Equals, nil)
+
+	transaction := newFileTransaction()
+	transaction.stage(conceptFile, "# concept\n")
+
+	c.Assert(transaction.verify(), NotNil)
+}
+
+func (s *MySuite) TestRollbackRestoresRunnerFiles(c *C) {
+	projectDir, _ := createTestProject(c)
+	defer os.RemoveAll(projectDir)
+	sourceFile, err := util.CreateFileIn(filepath.Join(projectDir, "src"), "StepImpl.java", []byte("original source"))
+	c.Assert(err, Equals, nil)
+
+	transaction := newFileTransaction()
+	c.Assert(transaction.snapshotProjectFiles(), Equals, nil)
+	ioutil.WriteFile(sourceFile, []byte("refactored source"), 0644)
+	newFile, _ := util.CreateFileIn(filepath.Join(projectDir, "src"), "NewFile.java", []byte("new"))
+	transaction.addRunnerFiles([]string{sourceFile, newFile})
+
+	c.Assert(len(transaction.rollback()), Equals, 0)
+	c.Assert(readFile(c, sourceFile), Equals, "original source")
+	_, err = os.Stat(newFile)
+	c.Assert(os.IsNotExist(err), Equals, true)
+}
+
+func (s *MySuite) TestCommitFailureRestoresAllFiles(c *C) {
+	projectDir, specFile := createTestProject(c)
+	defer os.RemoveAll(projectDir)
+	sourceFile, err := util.CreateFileIn(filepath.Join(projectDir, "src"), "StepImpl.java", []byte("original source"))
+	c.Assert(err, Equals, nil)
+
+	transaction := newFileTransaction()
+	transaction.stage(specFile, "Specification Heading\n=====================\nScenario 1\n----------\n* second step\n")
+	transaction.stage(filepath.Join(projectDir, "missing", "spec2.spec"), originalSpec)
+	c.Assert(transaction.snapshotProjectFiles(), Equals, nil)
+	ioutil.WriteFile(sourceFile, []byte("refactored source"), 0644)
+	transaction.addRunnerFiles([]string{sourceFile})
+
+	c.Assert(transaction.commit(), NotNil)
+	c.Assert(readFile(c, specFile), Equals, originalSpec)
+	c.Assert(readFile(c, sourceFile), Equals, "original source")
+	_, err = os.Stat(specFile + stagedFileSuffix)
+	c.Assert(os.IsNotExist(err), Equals, true)
+}
+
+func (s *MySuite) TestRollbackRestoresFilesTheRunnerDidNotReport(c *C) {
+	projectDir, _ := createTestProject(c)
+	defer os.RemoveAll(projectDir)
+	sourceFile, err := util.CreateFileIn(filepath.Join(projectDir, "src"), "StepImpl.java", []byte("original source"))
+	c.Assert(err, Equals, nil)
+
+	transaction := newFileTransaction()
+	c.Assert(transaction.snapshotProjectFiles(), Equals, nil)
+	ioutil.WriteFile(sourceFile, []byte("half refactored source"), 0644)
+
+	c.Assert(len(transaction.rollback()), Equals, 0)
+	c.Assert(readFile(c, sourceFile), Equals, "original source")
+}
+
+func (s *MySuite) TestSnapshotSkipsIgnoredDependencyAndBinaryFiles(c *C) {
+	projectDir, _ := createTestProject(c)
+	defer os.RemoveAll(projectDir)
+	util.CreateFileIn(projectDir, ".gitignore", []byte("# build output\n*.log\n/generated/\n"))
+	sourceFile, _ := util.CreateFileIn(filepath.Join(projectDir, "src"), "StepImpl.java", []byte("source"))
+	dependency, _ := util.CreateFileIn(filepath.Join(projectDir, "node_modules", "lib"), "index.js", []byte("dependency"))
+	logFile, _ := util.CreateFileIn(filepath.Join(projectDir, "src"), "run.log", []byte("log"))
+	generated, _ := util.CreateFileIn(filepath.Join(projectDir, "generated"), "Steps.java", []byte("generated"))
+	binary, _ := util.CreateFileIn(filepath.Join(projectDir, "libs"), "steps.jar", []byte("PK\x03\x04\x00\x00"))
+
+	transaction := newFileTransaction()
+	c.Assert(transaction.snapshotProjectFiles(), Equals, nil)
+
+	c.Assert(transaction.originals[sourceFile].content, Equals, "source")
+	for _, skipped := range []string{dependency, logFile, generated} {
+		_, ok := transaction.originals[skipped]
+		c.Assert(ok, Equals, false)
+	}
+	c.Assert(transaction.originals[binary].untracked, Equals, true)
+	c.Assert(transaction.originals[binary].content, Equals, "")
+}
+
+func (s *MySuite) TestRollbackKeepsIgnoredFilesReportedByTheRunner(c *C) {
+	projectDir, _ := createTestProject(c)
+	defer os.RemoveAll(projectDir)
+	util.CreateFileIn(projectDir, ".gitignore", []byte("*.log\n"))
+	logFile, _ := util.CreateFileIn(filepath.Join(projectDir, "src"), "run.log", []byte("log"))
+
+	transaction := newFileTransaction()
+	c.Assert(transaction.snapshotProjectFiles(), Equals, nil)
+	transaction.addRunnerFiles([]string{logFile})
+
+	c.Assert(len(transaction.rollback()), Equals, 1)
+	c.Assert(readFile(c, logFile), Equals, "log")
+}
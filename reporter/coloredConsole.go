@@ -84,6 +84,10 @@ func (c *coloredConsole) StepStart(stepText string) {
 	}
 }
 
+// StepFailureOutput shows nothing, the output of the runner is already shown below the step it was written in.
+func (c *coloredConsole) StepFailureOutput(stdout, stderr string) {
+}
+
 func (c *coloredConsole) StepEnd(failed bool) {
 	if Verbose {
 		c.writer.Clear()
@@ -34,12 +34,14 @@ const newline = "\n"
 // Reporter reports the progress of spec execution. It reports
 // 1. Which spec / scenarion / step (if verbose) is currently executing.
 // 2. Status (pass/fail) of the spec / scenario / step (if verbose) once its executed.
+// 3. What the runner wrote to stdout and stderr during a failed step.
 type Reporter interface {
 	SpecStart(string)
 	SpecEnd()
 	ScenarioStart(string)
 	ScenarioEnd(bool)
 	StepStart(string)
+	StepFailureOutput(string, string)
 	StepEnd(bool)
 	ConceptStart(string)
 	ConceptEnd(bool)
@@ -66,14 +68,15 @@ func Current() Reporter {
 
 type parallelReportWriter struct {
 	nRunner int
+	out     io.Writer
 }
 
 func (p *parallelReportWriter) Write(b []byte) (int, error) {
-	return fmt.Printf("[runner: %d] %s", p.nRunner, string(b))
+	return fmt.Fprintf(p.out, "[runner: %d] %s", p.nRunner, string(b))
 }
 
 // NewParallelConsole returns the instance of parallel console reporter
 func NewParallelConsole(n int) Reporter {
-	writer := &parallelReportWriter{nRunner: n}
+	writer := &parallelReportWriter{nRunner: n, out: os.Stdout}
 	return newSimpleConsole(writer)
 }
@@ -75,6 +75,20 @@ func (sc *simpleConsole) StepStart(stepText string) {
 	}
 }
 
+// StepFailureOutput shows the output of a failed step again, below its error, as output streamed while the step ran may
+// be interleaved with the output of other steps in parallel runs.
+func (sc *simpleConsole) StepFailureOutput(stdout, stderr string) {
+	sc.mu.Lock()
+	defer sc.mu.Unlock()
+	for _, output := range []struct{ title, text string }{{"Output", stdout}, {"Error output", stderr}} {
+		if strings.TrimSpace(output.text) == "" {
+			continue
+		}
+		message := fmt.Sprintf("%s: \n%s", output.title, strings.TrimRight(output.text, newline))
+		fmt.Fprint(sc.writer, fmt.Sprintf("%s%s", indent(message, sc.indentation+errorIndentation), newline))
+	}
+}
+
 func (sc *simpleConsole) StepEnd(failed bool) {
 	sc.mu.Lock()
 	defer sc.mu.Unlock()
@@ -183,6 +183,25 @@ func (s *MySuite) TestError_SimpleConsole(c *C) {
 	c.Assert(dw.output, Equals, fmt.Sprintf("%sFailed network error\n", spaces(sc.indentation+errorIndentation)))
 }
 
+func (s *MySuite) TestStepFailureOutput_SimpleConsole(c *C) {
+	dw, sc := setupSimpleConsole()
+	sc.indentation = 6
+
+	sc.StepFailureOutput("connecting\nconnected\n", "")
+
+	prefix := spaces(sc.indentation + errorIndentation)
+	c.Assert(dw.output, Equals, prefix+"Output: \n"+prefix+"connecting\n"+prefix+"connected\n")
+}
+
+func (s *MySuite) TestStepFailureOutputOfParallelConsoleShowsStderr(c *C) {
+	dw := newDummyWriter()
+	sc := newSimpleConsole(&parallelReportWriter{nRunner: 2, out: dw})
+
+	sc.StepFailureOutput("", "stack trace\n")
+
+	c.Assert(dw.output, Equals, "[runner: 2] "+spaces(errorIndentation)+"Error output: \n"+spaces(errorIndentation)+"stack trace\n")
+}
+
 func (s *MySuite) TestWrite_VerboseSimpleConsole(c *C) {
 	dw, sc := setupSimpleConsole()
 	sc.indentation = 6
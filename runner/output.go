@@ -0,0 +1,90 @@
+// Copyright 2015 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+
+package runner
+
+import (
+	"bytes"
+	"sync"
+	"time"
+)
+
+const (
+	// maxCapturedOutput is the number of bytes of each of stdout and stderr kept until the output is taken. Older
+	// output is dropped, it can still be found in the gauge log.
+	maxCapturedOutput = 1024 * 1024
+	// The output comes through a pipe which is copied independently of the responses of the runner, so it is taken
+	// only once none has arrived for outputSettleTime, waiting at most maxOutputWait.
+	outputSettleTime = 10 * time.Millisecond
+	maxOutputWait    = 100 * time.Millisecond
+)
+
+// capturedOutput collects what the runner writes to stdout and stderr until it is taken
+type capturedOutput struct {
+	mutex     sync.Mutex
+	stdout    bytes.Buffer
+	stderr    bytes.Buffer
+	lastWrite time.Time
+}
+
+func (o *capturedOutput) stdoutWriter() *captureWriter {
+	return &captureWriter{output: o, buffer: &o.stdout}
+}
+
+func (o *capturedOutput) stderrWriter() *captureWriter {
+	return &captureWriter{output: o, buffer: &o.stderr}
+}
+
+// take returns the output written since it was last taken, once the output still being copied has arrived
+func (o *capturedOutput) take() (string, string) {
+	o.waitForQuiet()
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+	stdout, stderr := o.stdout.String(), o.stderr.String()
+	o.stdout.Reset()
+	o.stderr.Reset()
+	return stdout, stderr
+}
+
+func (o *capturedOutput) waitForQuiet() {
+	deadline := time.Now().Add(maxOutputWait)
+	for {
+		o.mutex.Lock()
+		wait := outputSettleTime - time.Since(o.lastWrite)
+		o.mutex.Unlock()
+		if wait <= 0 || time.Now().After(deadline) {
+			return
+		}
+		time.Sleep(wait)
+	}
+}
+
+type captureWriter struct {
+	output *capturedOutput
+	buffer *bytes.Buffer
+}
+
+func (w *captureWriter) Write(b []byte) (int, error) {
+	w.output.mutex.Lock()
+	defer w.output.mutex.Unlock()
+	w.output.lastWrite = time.Now()
+	w.buffer.Write(b)
+	if w.buffer.Len() > maxCapturedOutput {
+		w.buffer.Next(w.buffer.Len() - maxCapturedOutput)
+	}
+	return len(b), nil
+}
@@ -20,7 +20,7 @@ package runner
 import (
 	"encoding/json"
 	"fmt"
-	"net"
+	"io"
 	"os"
 	"os/exec"
 	"path/filepath"
@@ -44,8 +44,12 @@ import (
 type TestRunner struct {
 	mutex        *sync.Mutex
 	Cmd          *exec.Cmd
-	Connection   net.Conn
+	Connection   *conn.MessageConnection
 	ErrorChannel chan error
+	framing      *conn.Framing
+	stdout       *logger.Writer
+	stderr       *logger.Writer
+	output       *capturedOutput
 }
 
 type Runner struct {
@@ -65,6 +69,8 @@ type Runner struct {
 	}
 	Lib                 string
 	GaugeVersionSupport version.VersionSupport
+	Framing             []string
+	Transports          []string
 }
 
 func ExecuteInitHookForRunner(language string) error {
@@ -117,6 +123,34 @@ func GetRunnerInfo(language string) (*Runner, error) {
 	}
 	return runnerInfo, nil
 }
+
+// SetLogContext sets the fields, such as the spec, scenario and step being executed, with which the output of the runner
+// is logged from now on.
+func (testRunner *TestRunner) SetLogContext(fields logger.Fields) {
+	if testRunner.stdout != nil {
+		testRunner.stdout.SetContext(fields)
+	}
+	if testRunner.stderr != nil {
+		testRunner.stderr.SetContext(fields)
+	}
+}
+
+// CaptureOutput returns the writers for the stdout and stderr of the runner, whose output is kept until it is taken.
+func (testRunner *TestRunner) CaptureOutput() (io.Writer, io.Writer) {
+	testRunner.output = &capturedOutput{}
+	return testRunner.output.stdoutWriter(), testRunner.output.stderrWriter()
+}
+
+// TakeOutput returns what the runner wrote to stdout and stderr since the output was last taken, so that it can be
+// attributed to the step or scenario being executed. Output still arriving is waited for briefly, but output the
+// runner writes after its response, or which is held up in the pipe for longer, is attributed to what comes next.
+func (testRunner *TestRunner) TakeOutput() (string, string) {
+	if testRunner.output == nil {
+		return "", ""
+	}
+	return testRunner.output.take()
+}
+
 func (testRunner *TestRunner) IsProcessRunning() bool {
 	testRunner.mutex.Lock()
 	ps := testRunner.Cmd.ProcessState
@@ -127,7 +161,7 @@ func (testRunner *TestRunner) IsProcessRunning() bool {
 func (testRunner *TestRunner) Kill() error {
 	if testRunner.IsProcessRunning() {
 		defer testRunner.Connection.Close()
-		conn.SendProcessKillMessage(testRunner.Connection)
+		testRunner.Connection.SendProcessKillMessage()
 
 		exited := make(chan bool, 1)
 		go func() {
@@ -160,22 +194,32 @@ func (testRunner *TestRunner) killRunner() error {
 
 // Looks for a runner configuration inside the runner directory
 // finds the runner configuration matching to the manifest and executes the commands for the current OS
-func startRunner(manifest *manifest.Manifest, port string, reporter reporter.Reporter, killChannel chan bool) (*TestRunner, error) {
-	var r Runner
-	runnerDir, err := getLanguageJSONFilePath(manifest, &r)
-	if err != nil {
-		return nil, err
-	}
+// The output of the runner goes to the reporter, is captured, and is logged with the stream number, if any, and the pid
+// of the runner.
+func startRunner(r Runner, runnerDir string, connectionHandler *conn.GaugeConnectionHandler, reporter reporter.Reporter, stream int, killChannel chan bool) (*TestRunner, error) {
 	compatibilityErr := version.CheckCompatibility(version.CurrentGaugeVersion, &r.GaugeVersionSupport)
 	if compatibilityErr != nil {
 		return nil, fmt.Errorf("Compatibility error. %s", compatibilityErr.Error())
 	}
 	command := getOsSpecificCommand(r)
-	env := getCleanEnv(port, os.Environ())
-	cmd, err := common.ExecuteCommandWithEnv(command, runnerDir, reporter, reporter, env)
+	framing := conn.Negotiate(conn.OfferedFraming(), r.Framing)
+	env := getConnectionEnv(connectionHandler, os.Environ())
+	for name, value := range framing.Env() {
+		env = setEnv(env, name, value)
+	}
+	log := logger.WithFields(streamFields(stream))
+	stdout, stderr := log.InfoWriter(), log.ErrorWriter()
+	errChannel := make(chan error)
+	testRunner := &TestRunner{ErrorChannel: errChannel, mutex: &sync.Mutex{}, framing: framing, stdout: stdout, stderr: stderr}
+	capturedStdout, capturedStderr := testRunner.CaptureOutput()
+	cmd, err := common.ExecuteCommandWithEnv(command, runnerDir, io.MultiWriter(reporter, stdout, capturedStdout), io.MultiWriter(reporter, stderr, capturedStderr), env)
 	if err != nil {
 		return nil, err
 	}
+	testRunner.Cmd = cmd
+	pid := logger.Fields{logger.RunnerPIDField: cmd.Process.Pid}
+	stdout.AddFields(pid)
+	stderr.AddFields(pid)
 	go func() {
 		select {
 		case <-killChannel:
@@ -183,8 +227,6 @@ func startRunner(manifest *manifest.Manifest, port string, reporter reporter.Rep
 		}
 	}()
 	// Wait for the process to exit so we will get a detailed error message
-	errChannel := make(chan error)
-	testRunner := &TestRunner{Cmd: cmd, ErrorChannel: errChannel, mutex: &sync.Mutex{}}
 	testRunner.waitAndGetErrorMessage()
 	return testRunner, nil
 }
@@ -211,6 +253,8 @@ func (t *TestRunner) waitAndGetErrorMessage() {
 		t.mutex.Lock()
 		t.Cmd.ProcessState = pState
 		t.mutex.Unlock()
+		t.stdout.Flush()
+		t.stderr.Flush()
 		if err != nil {
 			logger.Debug("Runner exited with error: %s", err)
 			t.ErrorChannel <- fmt.Errorf("Runner exited with error: %s\n", err.Error())
@@ -218,17 +262,36 @@ func (t *TestRunner) waitAndGetErrorMessage() {
 	}()
 }
 
+func streamFields(stream int) logger.Fields {
+	if stream > 0 {
+		return logger.Fields{logger.StreamField: stream}
+	}
+	return logger.Fields{}
+}
+
+// getConnectionEnv sets the port or the socket path, whichever the runner should connect to, and clears the other one.
+func getConnectionEnv(connectionHandler *conn.GaugeConnectionHandler, env []string) []string {
+	if socketPath := connectionHandler.SocketPath(); socketPath != "" {
+		return setEnv(getCleanEnv("", env), conn.InternalSocketEnv, socketPath)
+	}
+	return setEnv(getCleanEnv(strconv.Itoa(connectionHandler.ConnectionPortNumber()), env), conn.InternalSocketEnv, "")
+}
+
 func getCleanEnv(port string, env []string) []string {
 	//clear environment variable common.GaugeInternalPortEnvName
+	return setEnv(env, common.GaugeInternalPortEnvName, port)
+}
+
+func setEnv(env []string, name, value string) []string {
 	isPresent := false
 	for i, k := range env {
-		if strings.TrimSpace(strings.Split(k, "=")[0]) == common.GaugeInternalPortEnvName {
+		if strings.TrimSpace(strings.Split(k, "=")[0]) == name {
 			isPresent = true
-			env[i] = common.GaugeInternalPortEnvName + "=" + port
+			env[i] = name + "=" + value
 		}
 	}
 	if !isPresent {
-		env = append(env, common.GaugeInternalPortEnvName+"="+port)
+		env = append(env, name+"="+value)
 	}
 	return env
 }
@@ -258,22 +321,26 @@ type StartChannels struct {
 	KillChan chan bool
 }
 
-func StartRunnerAndMakeConnection(manifest *manifest.Manifest, reporter reporter.Reporter, killChannel chan bool) (*TestRunner, error) {
-	port, err := conn.GetPortFromEnvironmentVariable(common.GaugePortEnvName)
+// StartRunnerAndMakeConnection starts the runner of the project and waits for it to connect. stream is the number of the
+// parallel execution stream the runner is started for, 0 if it is not started for one.
+func StartRunnerAndMakeConnection(manifest *manifest.Manifest, reporter reporter.Reporter, stream int, killChannel chan bool) (*TestRunner, error) {
+	var r Runner
+	runnerDir, err := getLanguageJSONFilePath(manifest, &r)
 	if err != nil {
-		port = 0
+		return nil, err
 	}
-	gaugeConnectionHandler, connHandlerErr := conn.NewGaugeConnectionHandler(port, nil)
+	gaugeConnectionHandler, connHandlerErr := newConnectionHandler(r)
 	if connHandlerErr != nil {
 		return nil, connHandlerErr
 	}
-	testRunner, err := startRunner(manifest, strconv.Itoa(gaugeConnectionHandler.ConnectionPortNumber()), reporter, killChannel)
+	defer gaugeConnectionHandler.Close()
+	testRunner, err := startRunner(r, runnerDir, gaugeConnectionHandler, reporter, stream, killChannel)
 	if err != nil {
 		return nil, err
 	}
 
+	gaugeConnectionHandler.UseFraming(testRunner.framing)
 	runnerConnection, connectionError := gaugeConnectionHandler.AcceptConnection(config.RunnerConnectionTimeout(), testRunner.ErrorChannel)
-	testRunner.Connection = runnerConnection
 	if connectionError != nil {
 		logger.Debug("Runner connection error: %s", connectionError)
 		err := testRunner.killRunner()
@@ -282,5 +349,19 @@ func StartRunnerAndMakeConnection(manifest *manifest.Manifest, reporter reporter
 		}
 		return nil, connectionError
 	}
+	testRunner.Connection = conn.NewMessageConnection(runnerConnection)
 	return testRunner, nil
 }
+
+// newConnectionHandler listens on the port set in common.GaugePortEnvName if any, else on a unix domain socket if the
+// runner supports it and it is configured, else on a free port.
+func newConnectionHandler(r Runner) (*conn.GaugeConnectionHandler, error) {
+	port, err := conn.GetPortFromEnvironmentVariable(common.GaugePortEnvName)
+	if err != nil {
+		if conn.UseUnixSocket(r.Transports) {
+			return conn.NewGaugeSocketConnectionHandler("", nil)
+		}
+		port = 0
+	}
+	return conn.NewGaugeConnectionHandler(port, nil)
+}
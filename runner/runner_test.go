@@ -18,9 +18,14 @@
 package runner
 
 import (
+	"net"
+	"strconv"
+	"testing"
+	"time"
+
 	"github.com/getgauge/common"
+	"github.com/getgauge/gauge/conn"
 	. "gopkg.in/check.v1"
-	"testing"
 )
 
 func Test(t *testing.T) { TestingT(t) }
@@ -43,3 +48,80 @@ func (s *MySuite) TestGetCleanEnvGivesRemovesGAUGE_INTERNAL_PORTAndSetsPortNumbe
 	c.Assert(env[3], Equals, portVariable)
 	c.Assert(env[4], Equals, PORT_NAME_WITH_EXTRA_WORD)
 }
+
+func (s *MySuite) TestSetEnvReplacesOrAddsVariable(c *C) {
+	env := setEnv([]string{"HELLO=WORLD", conn.FramingEnv + "=chunking"}, conn.FramingEnv, "compression")
+	env = setEnv(env, conn.MaxFrameSizeEnv, "1024")
+
+	c.Assert(env, DeepEquals, []string{"HELLO=WORLD", conn.FramingEnv + "=compression", conn.MaxFrameSizeEnv + "=1024"})
+}
+
+func (s *MySuite) TestConnectionEnvForUnixSocket(c *C) {
+	handler, err := conn.NewGaugeSocketConnectionHandler("", nil)
+	c.Assert(err, IsNil)
+	socketPath := handler.SocketPath()
+	defer handler.Close()
+
+	env := getConnectionEnv(handler, []string{common.GaugeInternalPortEnvName + "=1234"})
+
+	c.Assert(env, DeepEquals, []string{common.GaugeInternalPortEnvName + "=", conn.InternalSocketEnv + "=" + socketPath})
+	connection, err := net.Dial("unix", socketPath)
+	c.Assert(err, IsNil)
+	connection.Close()
+}
+
+func (s *MySuite) TestConnectionEnvForPort(c *C) {
+	handler, err := conn.NewGaugeConnectionHandler(0, nil)
+	c.Assert(err, IsNil)
+	defer handler.Close()
+
+	env := getConnectionEnv(handler, []string{conn.InternalSocketEnv + "=/tmp/gauge.sock"})
+
+	c.Assert(env, DeepEquals, []string{conn.InternalSocketEnv + "=", common.GaugeInternalPortEnvName + "=" + strconv.Itoa(handler.ConnectionPortNumber())})
+}
+
+func (s *MySuite) TestTakeOutputReturnsOutputWrittenSinceLastTaken(c *C) {
+	output := &capturedOutput{}
+	testRunner := &TestRunner{output: output}
+	output.stdoutWriter().Write([]byte("hello "))
+	output.stderrWriter().Write([]byte("oops\n"))
+	output.stdoutWriter().Write([]byte("world\n"))
+
+	stdout, stderr := testRunner.TakeOutput()
+	c.Assert(stdout, Equals, "hello world\n")
+	c.Assert(stderr, Equals, "oops\n")
+
+	stdout, stderr = testRunner.TakeOutput()
+	c.Assert(stdout, Equals, "")
+	c.Assert(stderr, Equals, "")
+}
+
+func (s *MySuite) TestCapturedOutputKeepsTheLatestOutput(c *C) {
+	output := &capturedOutput{}
+	output.stdoutWriter().Write(make([]byte, maxCapturedOutput))
+	output.stdoutWriter().Write([]byte("last"))
+
+	stdout, _ := output.take()
+	c.Assert(len(stdout), Equals, maxCapturedOutput)
+	c.Assert(stdout[len(stdout)-4:], Equals, "last")
+}
+
+func (s *MySuite) TestTakeOutputWaitsForOutputStillArriving(c *C) {
+	testRunner := &TestRunner{}
+	stdout, _ := testRunner.CaptureOutput()
+	stdout.Write([]byte("first line\n"))
+	go func() {
+		time.Sleep(outputSettleTime / 5)
+		stdout.Write([]byte("last line\n"))
+	}()
+
+	output, _ := testRunner.TakeOutput()
+	c.Assert(output, Equals, "first line\nlast line\n")
+}
+
+func (s *MySuite) TestTakeOutputWithoutCapture(c *C) {
+	stdout, stderr := (&TestRunner{}).TakeOutput()
+
+	c.Assert(stdout, Equals, "")
+	c.Assert(stderr, Equals, "")
+}
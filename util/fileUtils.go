@@ -114,6 +114,18 @@ func GetSpecFiles(specSource string) []string {
 	return specFiles
 }
 
+// GetConceptFiles returns the concept files in the given directory, or the given file if it is a concept file.
+func GetConceptFiles(conceptSource string) []string {
+	var conceptFiles []string
+	if common.DirExists(conceptSource) {
+		conceptFiles = append(conceptFiles, FindConceptFilesIn(conceptSource)...)
+	} else if common.FileExists(conceptSource) && IsValidConceptExtension(conceptSource) {
+		conceptFile, _ := filepath.Abs(conceptSource)
+		conceptFiles = append(conceptFiles, conceptFile)
+	}
+	return conceptFiles
+}
+
 func SaveFile(fileName string, content string, backup bool) {
 	err := common.SaveFile(fileName, content, backup)
 	if err != nil {
@@ -121,6 +133,16 @@ func SaveFile(fileName string, content string, backup bool) {
 	}
 }
 
+// ContainsFile tells if the file is in the list of files
+func ContainsFile(files []string, fileName string) bool {
+	for _, file := range files {
+		if file == fileName {
+			return true
+		}
+	}
+	return false
+}
+
 func GetPathToFile(path string) string {
 	if filepath.IsAbs(path) {
 		return path
@@ -21,12 +21,17 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
 	"os"
 	"path/filepath"
+	"runtime"
+	"strings"
 
 	"github.com/getgauge/common"
 )
 
+const fileURLScheme = "file://"
+
 // progressReader is for indicating the download / upload progress on the console
 type progressReader struct {
 	io.Reader
@@ -50,12 +55,20 @@ func (w *progressReader) Read(p []byte) (int, error) {
 	return n, err
 }
 
-// Download fires a HTTP GET request to download a resource to target directory
+// Download fires a HTTP GET request to download a resource to target directory. Resources
+// with a file:// URL are copied from the file system.
 func Download(url, targetDir string) (string, error) {
 	if !common.DirExists(targetDir) {
 		return "", fmt.Errorf("Error downloading file: %s\nTarget dir %s doesn't exists.", url, targetDir)
 	}
 	targetFile := filepath.Join(targetDir, filepath.Base(url))
+	if IsFileURL(url) {
+		path, err := FilePathOf(url)
+		if err != nil {
+			return "", err
+		}
+		return targetFile, copyFile(path, targetFile)
+	}
 
 	resp, err := http.Get(url)
 	if err != nil {
@@ -77,3 +90,70 @@ func Download(url, targetDir string) (string, error) {
 	fmt.Println()
 	return targetFile, err
 }
+
+// IsFileURL checks if the given URL points to the local file system
+func IsFileURL(url string) bool {
+	return strings.HasPrefix(url, fileURLScheme)
+}
+
+// FilePathOf returns the file system path of a file:// URL, percent-decoded. A URL naming a host other than localhost
+// is a UNC path on Windows, and is rejected on other platforms.
+func FilePathOf(fileURL string) (string, error) {
+	u, err := url.Parse(fileURL)
+	if err != nil {
+		return "", fmt.Errorf("Cannot read %s. %s", fileURL, err.Error())
+	}
+	path := u.Path
+	if path == "" {
+		path = "/"
+	}
+	if u.Host != "" && !strings.EqualFold(u.Host, "localhost") {
+		if runtime.GOOS == "windows" {
+			return `\\` + u.Host + filepath.FromSlash(path), nil
+		}
+		return "", fmt.Errorf("Cannot read %s. File URLs of other hosts are not supported.", fileURL)
+	}
+	if runtime.GOOS == "windows" && len(path) > 2 && path[0] == '/' && path[2] == ':' {
+		path = path[1:]
+	}
+	return filepath.FromSlash(path), nil
+}
+
+// FileURLOf returns the file:// URL of the given file system path, with the path percent-encoded
+func FileURLOf(path string) string {
+	path = filepath.ToSlash(path)
+	if !strings.HasPrefix(path, "/") {
+		path = "/" + path
+	}
+	return fileURLScheme + (&url.URL{Path: path}).EscapedPath()
+}
+
+// UrlExists checks if the resource at the given HTTP or file:// URL exists
+func UrlExists(url string) (bool, error) {
+	if IsFileURL(url) {
+		path, err := FilePathOf(url)
+		if err != nil {
+			return false, err
+		}
+		if _, err := os.Stat(path); err != nil {
+			return false, fmt.Errorf("%s does not exist. %s", url, err.Error())
+		}
+		return true, nil
+	}
+	return common.UrlExists(url)
+}
+
+func copyFile(source, target string) error {
+	in, err := os.Open(source)
+	if err != nil {
+		return fmt.Errorf("Error downloading file: %s", err.Error())
+	}
+	defer in.Close()
+	out, err := os.Create(target)
+	if err != nil {
+		return err
+	}
+	defer out.Close()
+	_, err = io.Copy(out, in)
+	return err
+}
@@ -19,10 +19,12 @@ package util
 
 import (
 	"fmt"
+	"io/ioutil"
 	"net/http"
 	"net/http/httptest"
 	"os"
 	"path/filepath"
+	"runtime"
 	"strings"
 
 	"github.com/getgauge/common"
@@ -107,3 +109,68 @@ func (s *MySuite) TestDownloadSuccess(c *C) {
 	c.Assert(err, Equals, nil)
 	c.Assert(actualFileContents, Equals, expectedFileContents)
 }
+
+func (s *MySuite) TestDownloadFromFileURL(c *C) {
+	sourceDir, _ := ioutil.TempDir("", "gaugeSource")
+	targetDir, _ := ioutil.TempDir("", "gaugeTarget")
+	defer os.RemoveAll(sourceDir)
+	defer os.RemoveAll(targetDir)
+	sourceFile := filepath.Join(sourceDir, "plugin.zip")
+	ioutil.WriteFile(sourceFile, []byte("plugin"), 0644)
+
+	downloadedFile, err := Download(FileURLOf(sourceFile), targetDir)
+
+	c.Assert(err, Equals, nil)
+	c.Assert(downloadedFile, Equals, filepath.Join(targetDir, "plugin.zip"))
+	contents, _ := ioutil.ReadFile(downloadedFile)
+	c.Assert(string(contents), Equals, "plugin")
+}
+
+func (s *MySuite) TestUrlExistsForFileURL(c *C) {
+	dir, _ := ioutil.TempDir("", "gaugeSource")
+	defer os.RemoveAll(dir)
+
+	exists, err := UrlExists(FileURLOf(dir))
+	c.Assert(exists, Equals, true)
+	c.Assert(err, Equals, nil)
+
+	exists, err = UrlExists(FileURLOf(filepath.Join(dir, "missing.zip")))
+	c.Assert(exists, Equals, false)
+	c.Assert(err, NotNil)
+}
+
+func (s *MySuite) TestFilePathOfFileURL(c *C) {
+	path, _ := filepath.Abs(filepath.Join("mirror", "templates"))
+
+	c.Assert(IsFileURL(FileURLOf(path)), Equals, true)
+	filePath, err := FilePathOf(FileURLOf(path))
+	c.Assert(err, Equals, nil)
+	c.Assert(filePath, Equals, path)
+}
+
+func (s *MySuite) TestFileURLOfPathWithSpaces(c *C) {
+	path, _ := filepath.Abs(filepath.Join("mirror with space", "templates"))
+
+	c.Assert(strings.Contains(FileURLOf(path), "/mirror%20with%20space/templates"), Equals, true)
+	filePath, err := FilePathOf(FileURLOf(path))
+	c.Assert(err, Equals, nil)
+	c.Assert(filePath, Equals, path)
+
+	filePath, err = FilePathOf("file:///path%20with%20space/templates")
+	c.Assert(err, Equals, nil)
+	c.Assert(filePath, Equals, filepath.FromSlash("/path with space/templates"))
+}
+
+func (s *MySuite) TestFilePathOfFileURLWithHost(c *C) {
+	path, err := FilePathOf("file://localhost/mirror/templates")
+	c.Assert(err, Equals, nil)
+	c.Assert(path, Equals, filepath.FromSlash("/mirror/templates"))
+
+	path, err = FilePathOf("file://server/mirror/templates")
+	if runtime.GOOS == "windows" {
+		c.Assert(err, Equals, nil)
+		c.Assert(path, Equals, `\\server\mirror\templates`)
+	} else {
+		c.Assert(err, ErrorMatches, "Cannot read file://server/mirror/templates. File URLs of other hosts are not supported.")
+	}
+}
@@ -0,0 +1,158 @@
+// Copyright 2015 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+
+package version
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+type comparison struct {
+	operator string
+	version  *Version
+}
+
+// Constraint restricts the versions of a plugin used by a project. It is a space or comma separated list of
+// comparisons which all have to be satisfied, e.g. `>=1.2.0 <2.0.0`. Besides the `=`, `>`, `>=`, `<` and `<=`
+// operators a comparison can be `^1.2` (1.2.0 or later, below 2.0.0), `~1.2.3` (1.2.3 or later, below 1.3.0),
+// a partial version like `1.2` (any 1.2.x) or `*` (any version).
+type Constraint struct {
+	text        string
+	comparisons []comparison
+}
+
+// ParseConstraint parses the given version constraint. An empty constraint is satisfied by any version.
+func ParseConstraint(text string) (*Constraint, error) {
+	constraint := &Constraint{text: strings.TrimSpace(text)}
+	terms := strings.FieldsFunc(text, func(r rune) bool { return r == ',' || r == ' ' || r == '\t' })
+	for i := 0; i < len(terms); i++ {
+		term := terms[i]
+		// An operator can be separated from its version by whitespace, e.g. `>= 1.2`
+		if isConstraintOperator(term) && i+1 < len(terms) {
+			term += terms[i+1]
+			i++
+		}
+		comparisons, err := parseConstraintTerm(term)
+		if err != nil {
+			return nil, fmt.Errorf("Invalid version constraint %s. %s", text, err.Error())
+		}
+		constraint.comparisons = append(constraint.comparisons, comparisons...)
+	}
+	return constraint, nil
+}
+
+func isConstraintOperator(term string) bool {
+	switch term {
+	case ">=", "<=", ">", "<", "=", "^", "~":
+		return true
+	}
+	return false
+}
+
+func parseConstraintTerm(term string) ([]comparison, error) {
+	if term == "*" {
+		return nil, nil
+	}
+	for _, operator := range []string{">=", "<=", ">", "<", "="} {
+		if strings.HasPrefix(term, operator) {
+			v, _, err := parsePartialVersion(strings.TrimPrefix(term, operator))
+			if err != nil {
+				return nil, err
+			}
+			return []comparison{{operator, v}}, nil
+		}
+	}
+	if strings.HasPrefix(term, "^") {
+		v, parts, err := parsePartialVersion(term[1:])
+		if err != nil {
+			return nil, err
+		}
+		upper := &Version{v.Major + 1, 0, 0}
+		if v.Major == 0 && parts > 1 {
+			upper = &Version{0, v.Minor + 1, 0}
+			if v.Minor == 0 && parts > 2 {
+				upper = &Version{0, 0, v.Patch + 1}
+			}
+		}
+		return []comparison{{">=", v}, {"<", upper}}, nil
+	}
+	tilde := strings.HasPrefix(term, "~")
+	v, parts, err := parsePartialVersion(strings.TrimPrefix(term, "~"))
+	if err != nil {
+		return nil, err
+	}
+	if parts == 3 && !tilde {
+		return []comparison{{"=", v}}, nil
+	}
+	if parts == 1 {
+		return []comparison{{">=", v}, {"<", &Version{v.Major + 1, 0, 0}}}, nil
+	}
+	return []comparison{{">=", v}, {"<", &Version{v.Major, v.Minor + 1, 0}}}, nil
+}
+
+// parsePartialVersion parses versions like 1, 1.2 or 1.2.3, filling the missing parts with zero. It also returns
+// the number of parts given.
+func parsePartialVersion(text string) (*Version, int, error) {
+	splits := strings.Split(strings.TrimSpace(text), ".")
+	if len(splits) > 3 {
+		return nil, 0, fmt.Errorf("Version %s should be in the form 1.5.7", text)
+	}
+	parts := make([]int, 3)
+	for i, split := range splits {
+		part, err := strconv.Atoi(split)
+		if err != nil || part < 0 {
+			return nil, 0, fmt.Errorf("Version %s should be in the form 1.5.7", text)
+		}
+		parts[i] = part
+	}
+	return &Version{parts[0], parts[1], parts[2]}, len(splits), nil
+}
+
+// IsSatisfiedBy checks if the version satisfies all the comparisons of the constraint.
+func (constraint *Constraint) IsSatisfiedBy(v *Version) bool {
+	if constraint == nil {
+		return true
+	}
+	for _, c := range constraint.comparisons {
+		var satisfied bool
+		switch c.operator {
+		case ">=":
+			satisfied = v.IsGreaterThanEqualTo(c.version)
+		case "<=":
+			satisfied = v.IsLesserThanEqualTo(c.version)
+		case ">":
+			satisfied = v.IsGreaterThan(c.version)
+		case "<":
+			satisfied = v.IsLesserThan(c.version)
+		default:
+			satisfied = v.IsEqualTo(c.version)
+		}
+		if !satisfied {
+			return false
+		}
+	}
+	return true
+}
+
+func (constraint *Constraint) String() string {
+	if constraint == nil || constraint.text == "" {
+		return "*"
+	}
+	return constraint.text
+}
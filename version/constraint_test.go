@@ -0,0 +1,69 @@
+// Copyright 2015 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+
+package version
+
+import . "gopkg.in/check.v1"
+
+func satisfies(c *C, constraint string, versions ...string) []bool {
+	parsed, err := ParseConstraint(constraint)
+	c.Assert(err, Equals, nil)
+	results := make([]bool, 0)
+	for _, v := range versions {
+		version, err := ParseVersion(v)
+		c.Assert(err, Equals, nil)
+		results = append(results, parsed.IsSatisfiedBy(version))
+	}
+	return results
+}
+
+func (s *MySuite) TestCaretConstraint(c *C) {
+	c.Assert(satisfies(c, "^2.1", "2.0.9", "2.1.0", "2.9.3", "3.0.0"), DeepEquals, []bool{false, true, true, false})
+	c.Assert(satisfies(c, "^0.3.1", "0.3.0", "0.3.1", "0.3.9", "0.4.0"), DeepEquals, []bool{false, true, true, false})
+	c.Assert(satisfies(c, "^0.0.3", "0.0.3", "0.0.4"), DeepEquals, []bool{true, false})
+}
+
+func (s *MySuite) TestTildeAndPartialConstraints(c *C) {
+	c.Assert(satisfies(c, "~2.1.3", "2.1.2", "2.1.3", "2.1.9", "2.2.0"), DeepEquals, []bool{false, true, true, false})
+	c.Assert(satisfies(c, "2.1", "2.0.9", "2.1.5", "2.2.0"), DeepEquals, []bool{false, true, false})
+	c.Assert(satisfies(c, "2", "1.9.9", "2.7.0", "3.0.0"), DeepEquals, []bool{false, true, false})
+}
+
+func (s *MySuite) TestExactAndComparisonConstraints(c *C) {
+	c.Assert(satisfies(c, "2.1.3", "2.1.3", "2.1.4"), DeepEquals, []bool{true, false})
+	c.Assert(satisfies(c, ">=1.2.0, <2", "1.1.9", "1.2.0", "1.9.9", "2.0.0"), DeepEquals, []bool{false, true, true, false})
+	c.Assert(satisfies(c, ">1.0.0 <=1.2", "1.0.0", "1.0.1", "1.2.0", "1.2.1"), DeepEquals, []bool{false, true, true, false})
+}
+
+func (s *MySuite) TestEmptyConstraintIsSatisfiedByAnyVersion(c *C) {
+	c.Assert(satisfies(c, "", "0.0.1", "9.9.9"), DeepEquals, []bool{true, true})
+	c.Assert(satisfies(c, "*", "0.0.1"), DeepEquals, []bool{true})
+}
+
+func (s *MySuite) TestInvalidConstraint(c *C) {
+	_, err := ParseConstraint("^2.x")
+	c.Assert(err, ErrorMatches, "Invalid version constraint \\^2.x. Version 2.x should be in the form 1.5.7")
+	_, err = ParseConstraint(">=1.2.3.4")
+	c.Assert(err, NotNil)
+}
+
+func (s *MySuite) TestConstraintWithSpaceAfterOperator(c *C) {
+	c.Assert(satisfies(c, ">= 1.2, < 2", "1.1.9", "1.2.0", "2.0.0"), DeepEquals, []bool{false, true, false})
+	c.Assert(satisfies(c, "^ 2.1", "2.0.9", "2.1.0"), DeepEquals, []bool{false, true})
+	_, err := ParseConstraint(">=")
+	c.Assert(err, NotNil)
+}